@@ -4,23 +4,24 @@ import (
 	"flag"
 	"fmt"
 
-	"github.com/gidyon/micro/v2/pkg/conn"
 	"github.com/gidyon/micro/v2/utils/errs"
 	"github.com/gidyon/services/internal/account"
+	"github.com/gidyon/services/internal/pkg/sqldb"
 )
 
 var (
-	dbHost     = flag.String("db-address", "localhost:3306", "MYSQL database address")
-	dbUser     = flag.String("db-user", "root", "MYSQL database user")
-	dbPassword = flag.String("db-password", "hakty11", "MYSQL database password")
-	dbSchema   = flag.String("db-schema", "", "MYSQL database schema")
+	dbDialect  = flag.String("db-dialect", "mysql", "Database dialect; mysql, postgres or sqlite")
+	dbHost     = flag.String("db-address", "localhost:3306", "Database address")
+	dbUser     = flag.String("db-user", "root", "Database user")
+	dbPassword = flag.String("db-password", "hakty11", "Database password")
+	dbSchema   = flag.String("db-schema", "", "Database schema or SQLite file")
 )
 
 func main() {
 	flag.Parse()
 
-	db, err := conn.OpenGormConn(&conn.DBOptions{
-		Dialect:  "mysql",
+	db, err := sqldb.Open(&sqldb.Options{
+		Dialect:  *dbDialect,
 		Address:  *dbHost,
 		User:     *dbUser,
 		Password: *dbPassword,
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/internal/account"
+	"github.com/gidyon/services/internal/pkg/e164"
+	"github.com/gidyon/services/internal/pkg/sqldb"
+)
+
+var (
+	dbDialect   = flag.String("db-dialect", "mysql", "Database dialect; mysql, postgres or sqlite")
+	dbHost      = flag.String("db-address", "localhost:3306", "Database address")
+	dbUser      = flag.String("db-user", "root", "Database user")
+	dbPassword  = flag.String("db-password", "hakty11", "Database password")
+	dbSchema    = flag.String("db-schema", "", "Database schema or SQLite file")
+	regionsFile = flag.String("regions-file", "", "JSON file with the default phone region of projects")
+	dryRun      = flag.Bool("dry-run", false, "Report changes without updating accounts")
+)
+
+func main() {
+	flag.Parse()
+
+	var (
+		regions e164.Regions
+		err     error
+	)
+	if *regionsFile != "" {
+		regions, err = e164.LoadRegions(*regionsFile)
+		errs.Panic(err)
+	}
+
+	db, err := sqldb.Open(&sqldb.Options{
+		Dialect:  *dbDialect,
+		Address:  *dbHost,
+		User:     *dbUser,
+		Password: *dbPassword,
+		Schema:   *dbSchema,
+	})
+	errs.Panic(err)
+
+	fmt.Println("NORMALISING PHONES TO E.164")
+	if *dryRun {
+		fmt.Println("dry run; no accounts will be updated")
+	}
+
+	report, err := account.NormalisePhones(context.Background(), db, regions, *dryRun)
+	errs.Panic(err)
+
+	fmt.Printf("scanned=%d normalised=%d unchanged=%d invalid=%d collisions=%d\n",
+		report.Scanned, report.Normalised, report.Unchanged, len(report.Invalid), len(report.Collisions))
+
+	for _, invalid := range report.Invalid {
+		fmt.Printf("INVALID project=%s account=%d phone=%q\n", invalid.ProjectID, invalid.AccountID, invalid.Phone)
+	}
+
+	for _, collision := range report.Collisions {
+		accounts := make([]string, 0, len(collision.AccountIDs))
+		for i, accountID := range collision.AccountIDs {
+			accounts = append(accounts, fmt.Sprintf("%d(%s)", accountID, collision.Phones[i]))
+		}
+		fmt.Printf("COLLISION project=%s phone=%s accounts=%s\n",
+			collision.ProjectID, collision.Phone, strings.Join(accounts, ","))
+	}
+
+	// Collisions must be resolved by hand before the phones can be normalised
+	if len(report.Collisions) > 0 {
+		os.Exit(1)
+	}
+}
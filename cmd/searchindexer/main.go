@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/internal/account"
+	"github.com/gidyon/services/internal/channel"
+	"github.com/gidyon/services/internal/pkg/search"
+	"github.com/gidyon/services/internal/pkg/sqldb"
+	"gorm.io/gorm"
+)
+
+var (
+	dbDialect  = flag.String("db-dialect", "mysql", "Database dialect; mysql, postgres or sqlite")
+	dbHost     = flag.String("db-address", "localhost:3306", "Database address")
+	dbUser     = flag.String("db-user", "root", "Database user")
+	dbPassword = flag.String("db-password", "hakty11", "Database password")
+	dbSchema   = flag.String("db-schema", "", "Database schema or SQLite file")
+	indexDir   = flag.String("index-dir", "", "Directory of the search indexes")
+	target     = flag.String("target", "accounts", "Records to reindex; accounts or channels")
+)
+
+// The index is locked by the process that opens it so the service using it must be stopped first
+func main() {
+	flag.Parse()
+
+	var reindex func(context.Context, *gorm.DB, search.Index) (int, error)
+	switch *target {
+	case "accounts":
+		reindex = account.ReindexAccounts
+	case "channels":
+		reindex = channel.ReindexChannels
+	default:
+		errs.Panic(fmt.Errorf("unknown target %q", *target))
+	}
+
+	db, err := sqldb.Open(&sqldb.Options{
+		Dialect:  *dbDialect,
+		Address:  *dbHost,
+		User:     *dbUser,
+		Password: *dbPassword,
+		Schema:   *dbSchema,
+	})
+	errs.Panic(err)
+
+	index, err := search.Open(search.BackendBleve, *indexDir, *target)
+	errs.Panic(err)
+	defer index.Close()
+
+	fmt.Printf("REINDEXING %s\n", *target)
+
+	indexed, err := reindex(context.Background(), db, index)
+	errs.Panic(err)
+
+	fmt.Printf("indexed=%d\n", indexed)
+}
@@ -14,7 +14,6 @@ import (
 	"google.golang.org/api/option"
 	"google.golang.org/grpc/resolver"
 	"google.golang.org/protobuf/encoding/protojson"
-	"gorm.io/gorm"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 
@@ -24,6 +23,7 @@ import (
 
 	"github.com/gidyon/micro/v2/pkg/healthcheck"
 	"github.com/gidyon/services/internal/pkg/fauth"
+	"github.com/gidyon/services/internal/pkg/sqldb"
 
 	account_app "github.com/gidyon/services/internal/account"
 
@@ -182,6 +182,15 @@ func main() {
 			errs.Panic(err)
 		}
 
+		// SQL databases
+		sqlWrites, err := sqldb.ServiceDB(app, cfg, "sqlWrites")
+		errs.Panic(err)
+		sqlReads, err := sqldb.ServiceDB(app, cfg, "sqlReads")
+		errs.Panic(err)
+		if os.Getenv("DB_DEBUG") != "" {
+			sqlWrites, sqlReads = sqlWrites.Debug(), sqlReads.Debug()
+		}
+
 		// Pagination hasher
 		paginationHasher, err := encryption.NewHasher(string(jwtKey))
 		errs.Panic(err)
@@ -195,23 +204,14 @@ func main() {
 			ActivationURL:      os.Getenv("ACTIVATION_URL"),
 			AuthAPI:            authAPI,
 			PaginationHasher:   paginationHasher,
-			SQLDBWrites: func() *gorm.DB {
-				if os.Getenv("DB_DEBUG") != "" {
-					return app.GormDBByName("sqlWrites").Debug()
-				}
-				return app.GormDBByName("sqlWrites")
-			}(),
-			SQLDBReads: func() *gorm.DB {
-				if os.Getenv("DB_DEBUG") != "" {
-					return app.GormDBByName("sqlReads").Debug()
-				}
-				return app.GormDBByName("sqlReads")
-			}(),
-			RedisDBWrites:   app.RedisClientByName("redisWrites"),
-			RedisDBReads:    app.RedisClientByName("redisReads"),
-			Logger:          app.Logger(),
-			MessagingClient: messaging.NewMessagingClient(messagingCC),
-			FirebaseAuth:    firebaseAuth,
+			SQLDBWrites:        sqlWrites,
+			SQLDBReads:         sqlReads,
+			RedisDBWrites:      app.RedisClientByName("redisWrites"),
+			RedisDBReads:       app.RedisClientByName("redisReads"),
+			Logger:             app.Logger(),
+			MessagingClient:    messaging.NewMessagingClient(messagingCC),
+			FirebaseAuth:       firebaseAuth,
+			TokenSigningKey:    jwtKey,
 		})
 		errs.Panic(err)
 
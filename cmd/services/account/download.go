@@ -14,6 +14,7 @@ import (
 	"github.com/360EntSecGroup-Skylar/excelize"
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
 	"github.com/gidyon/services/pkg/api/account"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/jszwec/csvutil"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -21,6 +22,16 @@ import (
 
 const expectedScheme = "Bearer"
 
+// httpBodyMarshaler writes chunks of streamed HttpBody responses without delimiters so that exported files download intact
+type httpBodyMarshaler struct {
+	runtime.HTTPBodyMarshaler
+}
+
+// Delimiter is written between chunks of a stream
+func (*httpBodyMarshaler) Delimiter() []byte {
+	return nil
+}
+
 func getContextFromRequest(r *http.Request, authAPI auth.API) (context.Context, error) {
 	var token string
 	jwtBearer := r.Header.Get("authorization")
@@ -286,3 +297,285 @@ func downloadUsersHandler(accountAPI account.AccountAPIServer, authAPI auth.API)
 		}
 	}
 }
+
+type auditEventsFilter struct {
+	StartTime  string
+	EndTime    string
+	ProjectId  string
+	AdminId    string
+	AccountId  string
+	Operations []string
+}
+
+type auditEventInfo struct {
+	EventId     string
+	ProjectId   string
+	AdminId     string
+	AccountId   string
+	Operation   string
+	Reason      string
+	PayloadDiff string
+	IpAddress   string
+	UserAgent   string
+	Device      string
+	CreatedAt   string
+}
+
+func auditEventRow(eventPB *account.AuditEvent) *auditEventInfo {
+	return &auditEventInfo{
+		EventId:     eventPB.EventId,
+		ProjectId:   eventPB.ProjectId,
+		AdminId:     eventPB.AdminId,
+		AccountId:   eventPB.AccountId,
+		Operation:   eventPB.Operation.String(),
+		Reason:      eventPB.Reason,
+		PayloadDiff: eventPB.PayloadDiff,
+		IpAddress:   eventPB.IpAddress,
+		UserAgent:   eventPB.UserAgent,
+		Device:      eventPB.Device,
+		CreatedAt:   time.Unix(eventPB.CreatedAt, 0).UTC().Format(time.RFC3339),
+	}
+}
+
+func downloadAuditEventsHandler(accountAPI account.AccountAPIServer, authAPI auth.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Method must be POST
+		if r.Method != http.MethodPost {
+			http.Error(w, "only POST method allowed", http.StatusBadRequest)
+			return
+		}
+
+		downloadFilter := &auditEventsFilter{}
+		err := json.NewDecoder(r.Body).Decode(downloadFilter)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to decode filter in request body: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		ctx, err := getContextFromRequest(r, authAPI)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// Must have role of administrator
+		_, err = authAPI.AuthorizeGroup(ctx, authAPI.AdminGroups()...)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		startTime, endTime, err := getTimeRange(downloadFilter.StartTime, downloadFilter.EndTime)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		operations := make([]account.UpdateOperation, 0, len(downloadFilter.Operations))
+		for _, op := range downloadFilter.Operations {
+			v, ok := account.UpdateOperation_value[strings.ToUpper(op)]
+			if !ok {
+				http.Error(w, fmt.Sprintf("unknown operation %q", op), http.StatusBadRequest)
+				return
+			}
+			operations = append(operations, account.UpdateOperation(v))
+		}
+
+		var (
+			pageToken string
+			pageSize  int32 = 100
+			next            = true
+		)
+
+		// Calls fn with every page of audit events matching the filter
+		forEachPage := func(fn func([]*account.AuditEvent) error) error {
+			for next {
+				listRes, err := accountAPI.ListAuditEvents(ctx, &account.ListAuditEventsRequest{
+					PageToken:    pageToken,
+					PageSize:     pageSize,
+					ProjectId:    downloadFilter.ProjectId,
+					AdminId:      downloadFilter.AdminId,
+					AccountId:    downloadFilter.AccountId,
+					Operations:   operations,
+					CreatedFrom:  startTime.Seconds,
+					CreatedUntil: endTime.Seconds,
+				})
+				if err != nil {
+					return err
+				}
+
+				pageToken = listRes.NextPageToken
+				if listRes.NextPageToken == "" {
+					next = false
+				}
+
+				err = fn(listRes.Events)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		format := strings.ToLower(r.URL.Query().Get("format"))
+
+		switch format {
+		case "excel", "xlsx":
+			// Excel file
+			xlsxFile := excelize.NewFile()
+
+			sheetName := xlsxFile.GetSheetName(xlsxFile.GetActiveSheetIndex())
+
+			xlsxFile.SetSheetRow(sheetName, "A1", &[]interface{}{
+				"EventId", "ProjectId", "AdminId", "AccountId", "Operation", "Reason",
+				"PayloadDiff", "IpAddress", "UserAgent", "Device", "CreatedAt",
+			})
+
+			row := 1
+
+			err = forEachPage(func(events []*account.AuditEvent) error {
+				for _, eventPB := range events {
+					row++
+					info := auditEventRow(eventPB)
+					xlsxFile.SetSheetRow(sheetName, fmt.Sprintf("A%d", row), &[]interface{}{
+						info.EventId,
+						info.ProjectId,
+						info.AdminId,
+						info.AccountId,
+						info.Operation,
+						info.Reason,
+						info.PayloadDiff,
+						info.IpAddress,
+						info.UserAgent,
+						info.Device,
+						info.CreatedAt,
+					})
+				}
+				return nil
+			})
+			if err != nil {
+				http.Error(w, fmt.Sprintf("failed to list audit events: %v", err), http.StatusServiceUnavailable)
+				return
+			}
+
+			// Set appropriate content type
+			w.Header().Set("content-type", "text/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+			w.Header().Set("Content-Disposition", "attachment; filename=audit-events.xlsx")
+
+			err = xlsxFile.Write(w)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("failed to create xlsx: %v", err), http.StatusInternalServerError)
+				return
+			}
+		default:
+			// Collect rows before writing so that failures are reported with a proper status
+			rows := make([]*auditEventInfo, 0)
+
+			err = forEachPage(func(events []*account.AuditEvent) error {
+				for _, eventPB := range events {
+					rows = append(rows, auditEventRow(eventPB))
+				}
+				return nil
+			})
+			if err != nil {
+				http.Error(w, fmt.Sprintf("failed to list audit events: %v", err), http.StatusServiceUnavailable)
+				return
+			}
+
+			bs, err := csvutil.Marshal(rows)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("failed to encode audit events to csv: %v", err), http.StatusInternalServerError)
+				return
+			}
+
+			// Set appropriate content type
+			w.Header().Set("content-type", "text/csv")
+			w.Header().Set("Content-Disposition", "attachment; filename=audit-events.csv")
+
+			_, err = w.Write(bs)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("failed to write csv: %v", err), http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+}
+
+type importRowErrorInfo struct {
+	Row     int64
+	Field   string
+	Message string
+}
+
+func downloadImportReportHandler(accountAPI account.AccountAPIServer, authAPI auth.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Method must be GET
+		if r.Method != http.MethodGet {
+			http.Error(w, "only GET method allowed", http.StatusBadRequest)
+			return
+		}
+
+		ctx, err := getContextFromRequest(r, authAPI)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		report, err := accountAPI.GetImportReport(ctx, &account.GetImportReportRequest{
+			OperationId: r.URL.Query().Get("operation_id"),
+		})
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to get import report: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		format := strings.ToLower(r.URL.Query().Get("format"))
+
+		switch format {
+		case "excel", "xlsx":
+			// Excel file
+			xlsxFile := excelize.NewFile()
+
+			sheetName := xlsxFile.GetSheetName(xlsxFile.GetActiveSheetIndex())
+
+			xlsxFile.SetSheetRow(sheetName, "A1", &[]interface{}{"Row", "Field", "Message"})
+
+			for i, rowErr := range report.Errors {
+				xlsxFile.SetSheetRow(sheetName, fmt.Sprintf("A%d", i+2), &[]interface{}{
+					rowErr.Row, rowErr.Field, rowErr.Message,
+				})
+			}
+
+			// Set appropriate content type
+			w.Header().Set("content-type", "text/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+			w.Header().Set("Content-Disposition", "attachment; filename=import-report.xlsx")
+
+			err = xlsxFile.Write(w)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("failed to create xlsx: %v", err), http.StatusInternalServerError)
+				return
+			}
+		default:
+			rows := make([]*importRowErrorInfo, 0, len(report.Errors))
+			for _, rowErr := range report.Errors {
+				rows = append(rows, &importRowErrorInfo{Row: rowErr.Row, Field: rowErr.Field, Message: rowErr.Message})
+			}
+
+			bs, err := csvutil.Marshal(rows)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("failed to encode import report to csv: %v", err), http.StatusInternalServerError)
+				return
+			}
+
+			// Set appropriate content type
+			w.Header().Set("content-type", "text/csv")
+			w.Header().Set("Content-Disposition", "attachment; filename=import-report.csv")
+
+			_, err = w.Write(bs)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("failed to write csv: %v", err), http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	firebase "firebase.google.com/go"
@@ -16,7 +17,6 @@ import (
 	"google.golang.org/api/option"
 	"google.golang.org/grpc/resolver"
 	"google.golang.org/protobuf/encoding/protojson"
-	"gorm.io/gorm"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 
@@ -25,14 +25,26 @@ import (
 	"github.com/gidyon/micro/v2/utils/encryption"
 
 	"github.com/gidyon/micro/v2/pkg/healthcheck"
+	"github.com/gidyon/services/internal/pkg/attributes"
+	"github.com/gidyon/services/internal/pkg/e164"
+	"github.com/gidyon/services/internal/pkg/eventbus"
 	"github.com/gidyon/services/internal/pkg/fauth"
+	"github.com/gidyon/services/internal/pkg/idp"
+	"github.com/gidyon/services/internal/pkg/otp"
+	"github.com/gidyon/services/internal/pkg/password"
+	"github.com/gidyon/services/internal/pkg/retention"
+	"github.com/gidyon/services/internal/pkg/search"
+	"github.com/gidyon/services/internal/pkg/sqldb"
 
 	account_app "github.com/gidyon/services/internal/account"
 
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
 	"github.com/gidyon/micro/v2/utils/errs"
 	"github.com/gidyon/services/pkg/api/account"
+	"github.com/gidyon/services/pkg/api/longrunning"
 	"github.com/gidyon/services/pkg/api/messaging"
+	"github.com/gidyon/services/pkg/api/settings"
+	"github.com/gidyon/services/pkg/api/subscriber"
 
 	"github.com/gidyon/micro/v2/pkg/config"
 	app_grpc_middleware "github.com/gidyon/micro/v2/pkg/middleware/grpc"
@@ -158,10 +170,14 @@ func main() {
 		}
 	})
 
-	// Servemux option for JSON Marshaling
-	app.AddServeMuxOptions(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
-		MarshalOptions: protojson.MarshalOptions{
-			EmitUnpopulated: true,
+	// Servemux option for JSON Marshaling. HttpBody responses such as account exports are written as is.
+	app.AddServeMuxOptions(runtime.WithMarshalerOption(runtime.MIMEWildcard, &httpBodyMarshaler{
+		HTTPBodyMarshaler: runtime.HTTPBodyMarshaler{
+			Marshaler: &runtime.JSONPb{
+				MarshalOptions: protojson.MarshalOptions{
+					EmitUnpopulated: true,
+				},
+			},
 		},
 	}))
 
@@ -200,7 +216,7 @@ func main() {
 	app.AddServeMuxOptions(
 		runtime.WithOutgoingHeaderMatcher(func(key string) (string, bool) {
 			switch key {
-			case "set-cookie", "access-control-expose-headers":
+			case "set-cookie", "access-control-expose-headers", "content-disposition":
 				return key, true
 			default:
 				return runtime.DefaultHeaderMatcher(key)
@@ -214,6 +230,23 @@ func main() {
 		messagingCC, err := app.ExternalServiceConn("messaging")
 		errs.Panic(err)
 
+		// Long running operations are optional and used by account imports and personal data requests
+		var operationsClient longrunning.OperationAPIClient
+		if longrunningCC, err := app.ExternalServiceConn("longrunning"); err == nil {
+			operationsClient = longrunning.NewOperationAPIClient(longrunningCC)
+		}
+
+		// Subscriber and settings hold personal data that is exported and erased when deployed
+		var subscriberClient subscriber.SubscriberAPIClient
+		if subscriberCC, err := app.ExternalServiceConn("subscriber"); err == nil {
+			subscriberClient = subscriber.NewSubscriberAPIClient(subscriberCC)
+		}
+
+		var settingsClient settings.SettingsAPIClient
+		if settingsCC, err := app.ExternalServiceConn("settings"); err == nil {
+			settingsClient = settings.NewSettingsAPIClient(settingsCC)
+		}
+
 		var firebaseAuth fauth.FirebaseAuthClient
 
 		// Firebase app
@@ -228,6 +261,123 @@ func main() {
 			errs.Panic(err)
 		}
 
+		var encryptionAPI encryption.API
+
+		// Encryption of account secrets such as two-factor authentication keys
+		secretsKey := os.Getenv("SECRETS_ENCRYPTION_KEY")
+		if secretsKey != "" {
+			key, err := encryption.ParseKey([]byte(secretsKey))
+			errs.Panic(err)
+
+			encryptionAPI, err = encryption.NewAPI(key)
+			errs.Panic(err)
+		}
+
+		// Failed sign in lockout
+		lockout := &account_app.LockoutOptions{
+			NotifyOnLock: os.Getenv("SIGNIN_NOTIFY_ON_LOCK") != "",
+		}
+		if v, err := strconv.Atoi(os.Getenv("SIGNIN_MAX_FAILED_ATTEMPTS")); err == nil {
+			lockout.MaxFailedAttempts = v
+		}
+		if v, err := strconv.Atoi(os.Getenv("SIGNIN_LOCK_MINUTES")); err == nil {
+			lockout.LockDuration = time.Duration(v) * time.Minute
+		}
+
+		// Password hashing policy
+		envInt := func(key string) int {
+			v, _ := strconv.Atoi(os.Getenv(key))
+			return v
+		}
+		passwordHasher, err := password.NewHasher(&password.Options{
+			Algorithm: os.Getenv("PASSWORD_HASH_ALGORITHM"),
+			Argon2id: password.Argon2idParams{
+				Memory:      uint32(envInt("ARGON2ID_MEMORY_KIB")),
+				Iterations:  uint32(envInt("ARGON2ID_ITERATIONS")),
+				Parallelism: uint8(envInt("ARGON2ID_PARALLELISM")),
+			},
+			BcryptCost: envInt("BCRYPT_COST"),
+		})
+		errs.Panic(err)
+
+		// Limits on sending OTPs
+		otpLimits := &account_app.OTPLimitOptions{
+			Window:         time.Duration(envInt("OTP_LIMIT_WINDOW_MINUTES")) * time.Minute,
+			MaxPerAccount:  envInt("OTP_MAX_PER_ACCOUNT"),
+			MaxPerPhone:    envInt("OTP_MAX_PER_PHONE"),
+			MaxPerIP:       envInt("OTP_MAX_PER_IP"),
+			ResendCooldown: time.Duration(envInt("OTP_RESEND_COOLDOWN_SECONDS")) * time.Second,
+		}
+		if budgetsFile := os.Getenv("OTP_BUDGETS_FILE"); budgetsFile != "" {
+			otpLimits.DailyBudgets, err = account_app.LoadOTPBudgets(budgetsFile)
+			errs.Panic(err)
+		}
+
+		// Password policies per project
+		var passwordPolicies map[string]*password.Policy
+		if policiesFile := os.Getenv("PASSWORD_POLICIES_FILE"); policiesFile != "" {
+			passwordPolicies, err = password.LoadPolicies(policiesFile)
+			errs.Panic(err)
+		}
+
+		// OTP generation and delivery per project
+		var otpPolicies map[string]*otp.Policy
+		if policiesFile := os.Getenv("OTP_POLICIES_FILE"); policiesFile != "" {
+			otpPolicies, err = otp.LoadPolicies(policiesFile)
+			errs.Panic(err)
+		}
+
+		// External identity providers per project
+		var identityProviders *idp.Registry
+		if providersFile := os.Getenv("IDENTITY_PROVIDERS_FILE"); providersFile != "" {
+			identityProviders, err = idp.LoadRegistry(providersFile)
+			errs.Panic(err)
+		}
+
+		// Purging of soft deleted accounts
+		retentionOpts := &account_app.RetentionOptions{
+			Interval:  time.Duration(envInt("RETENTION_INTERVAL_MINUTES")) * time.Minute,
+			BatchSize: envInt("RETENTION_BATCH_SIZE"),
+		}
+		if policiesFile := os.Getenv("RETENTION_POLICIES_FILE"); policiesFile != "" {
+			retentionOpts.Policies, err = retention.LoadPolicies(policiesFile)
+			errs.Panic(err)
+		}
+
+		// Default phone regions per project
+		var phoneRegions e164.Regions
+		if regionsFile := os.Getenv("PHONE_REGIONS_FILE"); regionsFile != "" {
+			phoneRegions, err = e164.LoadRegions(regionsFile)
+			errs.Panic(err)
+		}
+
+		// Custom attribute schemas per project
+		var attributeSchemas attributes.Schemas
+		if schemasFile := os.Getenv("ATTRIBUTE_SCHEMAS_FILE"); schemasFile != "" {
+			attributeSchemas, err = attributes.LoadSchemas(schemasFile)
+			errs.Panic(err)
+		}
+
+		// Search index; full text search of the database when unset
+		searchIndex, err := search.Open(os.Getenv("SEARCH_BACKEND"), os.Getenv("SEARCH_INDEX_DIR"), "accounts")
+		errs.Panic(err)
+
+		// Event bus of account events; events are not emitted when unset
+		var eventBus eventbus.Bus
+		if backend := os.Getenv("EVENT_BUS"); backend != "" {
+			eventBus, err = eventbus.Open(backend, app.RedisClientByName("redisWrites"))
+			errs.Panic(err)
+		}
+
+		// SQL databases
+		sqlWrites, err := sqldb.ServiceDB(app, cfg, "sqlWrites")
+		errs.Panic(err)
+		sqlReads, err := sqldb.ServiceDB(app, cfg, "sqlReads")
+		errs.Panic(err)
+		if os.Getenv("DB_DEBUG") != "" {
+			sqlWrites, sqlReads = sqlWrites.Debug(), sqlReads.Debug()
+		}
+
 		// Pagination hasher
 		paginationHasher, err := encryption.NewHasher(string(jwtKey))
 		errs.Panic(err)
@@ -239,26 +389,35 @@ func main() {
 			DefaultEmailSender: os.Getenv("DEFAULT_EMAIL_SENDER"),
 			TemplatesDir:       os.Getenv("TEMPLATES_DIR"),
 			ActivationURL:      os.Getenv("ACTIVATION_URL"),
+			InvitationURL:      os.Getenv("INVITATION_URL"),
+			MagicLinkURL:       os.Getenv("MAGIC_LINK_URL"),
+			TrustedProxies:     envInt("TRUSTED_PROXIES"),
 			PaginationHasher:   paginationHasher,
 			AuthAPI:            authAPI,
-			SQLDBWrites: func() *gorm.DB {
-				if os.Getenv("DB_DEBUG") != "" {
-					return app.GormDBByName("sqlWrites").Debug()
-				}
-				return app.GormDBByName("sqlWrites")
-			}(),
-			SQLDBReads: func() *gorm.DB {
-				if os.Getenv("DB_DEBUG") != "" {
-					return app.GormDBByName("sqlReads").Debug()
-				}
-				return app.GormDBByName("sqlReads")
-			}(),
-			RedisDBWrites:   app.RedisClientByName("redisWrites"),
-			RedisDBReads:    app.RedisClientByName("redisReads"),
-			SecureCookie:    sc,
-			Logger:          app.Logger(),
-			MessagingClient: messaging.NewMessagingClient(messagingCC),
-			FirebaseAuth:    firebaseAuth,
+			SQLDBWrites:        sqlWrites,
+			SQLDBReads:         sqlReads,
+			RedisDBWrites:      app.RedisClientByName("redisWrites"),
+			RedisDBReads:       app.RedisClientByName("redisReads"),
+			SecureCookie:       sc,
+			Logger:             app.Logger(),
+			MessagingClient:    messaging.NewMessagingClient(messagingCC),
+			FirebaseAuth:       firebaseAuth,
+			EncryptionAPI:      encryptionAPI,
+			SignInLockout:      lockout,
+			OTPLimits:          otpLimits,
+			PasswordHasher:     passwordHasher,
+			PasswordPolicies:   passwordPolicies,
+			OTPPolicies:        otpPolicies,
+			IdentityProviders:  identityProviders,
+			OperationsClient:   operationsClient,
+			SubscriberClient:   subscriberClient,
+			SettingsClient:     settingsClient,
+			Retention:          retentionOpts,
+			PhoneRegions:       phoneRegions,
+			AttributeSchemas:   attributeSchemas,
+			SearchIndex:        searchIndex,
+			EventBus:           eventBus,
+			TokenSigningKey:    jwtKey,
 		})
 		errs.Panic(err)
 
@@ -267,6 +426,28 @@ func main() {
 
 		// Downloading users API
 		app.AddEndpointFunc("/api/accounts/downloads/users", downloadUsersHandler(accountAPI, authAPI))
+		app.AddEndpointFunc("/api/accounts/downloads/audit-events", downloadAuditEventsHandler(accountAPI, authAPI))
+		app.AddEndpointFunc("/api/accounts/downloads/import-report", downloadImportReportHandler(accountAPI, authAPI))
+
+		// Uploading users API
+		app.AddEndpointFunc("/api/accounts/uploads/users", uploadUsersHandler(accountAPI, authAPI))
+
+		// OpenID Connect provider
+		if issuer := os.Getenv("OIDC_ISSUER"); issuer != "" {
+			signingKey, err := oidcSigningKey(os.Getenv("OIDC_SIGNING_KEY_FILE"))
+			errs.Panic(err)
+
+			oidcHandler, err := account_app.NewOIDCProvider(accountAPI, &account_app.OIDCOptions{
+				Issuer:     issuer,
+				SigningKey: signingKey,
+				LoginURL:   os.Getenv("OIDC_LOGIN_URL"),
+			})
+			errs.Panic(err)
+
+			for _, path := range account_app.OIDCPaths {
+				app.AddEndpoint(path, oidcHandler)
+			}
+		}
 
 		return nil
 	})
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+)
+
+// reads the RSA key that signs OpenID Connect tokens. The key is required since tokens
+// signed by a key generated at startup stop verifying on restart and differ between replicas.
+func oidcSigningKey(file string) (*rsa.PrivateKey, error) {
+	if file == "" {
+		return nil, errors.New("OIDC_SIGNING_KEY_FILE is required when OIDC_ISSUER is set")
+	}
+
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read oidc signing key: %w", err)
+	}
+
+	block, _ := pem.Decode(bs)
+	if block == nil {
+		return nil, errors.New("oidc signing key is not PEM encoded")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		rsaKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, errors.New("oidc signing key is not an RSA key")
+		}
+		return rsaKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported oidc signing key type %s", block.Type)
+	}
+}
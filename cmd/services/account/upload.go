@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/services/pkg/api/account"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const maxUploadSize = 32 << 20
+
+func uploadUsersHandler(accountAPI account.AccountAPIServer, authAPI auth.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Method must be POST
+		if r.Method != http.MethodPost {
+			http.Error(w, "only POST method allowed", http.StatusBadRequest)
+			return
+		}
+
+		ctx, err := getContextFromRequest(r, authAPI)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+
+		err = r.ParseMultipartForm(maxUploadSize)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to parse multipart form: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		file, fileHeader, err := r.FormFile("file")
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to read file in form: %v", err), http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+
+		bs, err := ioutil.ReadAll(file)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to read file: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		// Column mapping is a JSON object of column names to account fields
+		columnMapping := map[string]string{}
+		if mapping := r.FormValue("column_mapping"); mapping != "" {
+			err = json.Unmarshal([]byte(mapping), &columnMapping)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("failed to decode column mapping: %v", err), http.StatusBadRequest)
+				return
+			}
+		}
+
+		var dryRun bool
+		if v := r.FormValue("dry_run"); v != "" {
+			dryRun, err = strconv.ParseBool(v)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("failed to parse dry run: %v", err), http.StatusBadRequest)
+				return
+			}
+		}
+
+		importRes, err := accountAPI.ImportAccounts(ctx, &account.ImportAccountsRequest{
+			ProjectId:     r.FormValue("project_id"),
+			File:          bs,
+			FileName:      fileHeader.Filename,
+			Format:        r.FormValue("format"),
+			ColumnMapping: columnMapping,
+			DefaultGroup:  r.FormValue("default_group"),
+			DryRun:        dryRun,
+		})
+		if err != nil {
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			return
+		}
+
+		bs, err = protojson.Marshal(importRes)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to marshal response: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		w.Write(bs)
+	}
+}
@@ -14,6 +14,8 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 
 	channel_app "github.com/gidyon/services/internal/channel"
+	"github.com/gidyon/services/internal/pkg/search"
+	"github.com/gidyon/services/internal/pkg/sqldb"
 
 	app_grpc_middleware "github.com/gidyon/micro/v2/pkg/middleware/grpc"
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
@@ -99,17 +101,28 @@ func main() {
 	}))
 
 	app.Start(ctx, func() error {
+		// SQL databases
+		sqlWrites, err := sqldb.ServiceDB(app, cfg, "sqlWrites")
+		errs.Panic(err)
+		sqlReads, err := sqldb.ServiceDB(app, cfg, "sqlReads")
+		errs.Panic(err)
+
 		// Pagination hasher
 		paginationHasher, err := encryption.NewHasher(string(jwtKey))
 		errs.Panic(err)
 
+		// Search index; full text search of the database when unset
+		searchIndex, err := search.Open(os.Getenv("SEARCH_BACKEND"), os.Getenv("SEARCH_INDEX_DIR"), "channels")
+		errs.Panic(err)
+
 		// Create channel tracing instance
 		channelAPI, err := channel_app.NewChannelAPIServer(ctx, &channel_app.Options{
-			SQLDBWrites:      app.GormDBByName("sqlWrites"),
-			SQLDBReads:       app.GormDBByName("sqlReads"),
+			SQLDBWrites:      sqlWrites,
+			SQLDBReads:       sqlReads,
 			Logger:           app.Logger(),
 			AuthAPI:          authAPI,
 			PaginationHasher: paginationHasher,
+			SearchIndex:      searchIndex,
 		})
 		errs.Panic(err)
 
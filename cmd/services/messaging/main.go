@@ -12,6 +12,7 @@ import (
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/zaplogger"
 	"github.com/gidyon/micro/v2/utils/encryption"
 	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/internal/pkg/sqldb"
 	"github.com/gidyon/services/pkg/api/messaging/call"
 	"github.com/gidyon/services/pkg/api/subscriber"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -131,14 +132,20 @@ func main() {
 
 		app.Logger().Infoln("connected to all services")
 
+		// SQL databases
+		sqlWrites, err := sqldb.ServiceDB(app, cfg, "sqlWrites")
+		errs.Panic(err)
+		sqlReads, err := sqldb.ServiceDB(app, cfg, "sqlReads")
+		errs.Panic(err)
+
 		// Pagination hasher
 		paginationHasher, err := encryption.NewHasher(string(jwtKey))
 		errs.Panic(err)
 
 		// Create messaging API instance
 		messagingAPI, err := messaging_app.NewMessagingServer(ctx, &messaging_app.Options{
-			SQLDBWrites:      app.GormDBByName("sqlWrites"),
-			SQLDBReads:       app.GormDBByName("sqlReads"),
+			SQLDBWrites:      sqlWrites,
+			SQLDBReads:       sqlReads,
 			Logger:           app.Logger(),
 			EmailSender:      os.Getenv("SENDER_EMAIL_ADDRESS"),
 			EmailClient:      emailing.NewEmailingClient(emailConn),
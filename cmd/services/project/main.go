@@ -8,7 +8,6 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/resolver"
 	"google.golang.org/protobuf/encoding/protojson"
-	"gorm.io/gorm"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 
@@ -17,6 +16,7 @@ import (
 
 	"github.com/gidyon/micro/v2/pkg/healthcheck"
 
+	"github.com/gidyon/services/internal/pkg/sqldb"
 	project_app_v1 "github.com/gidyon/services/internal/project/v1"
 
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
@@ -99,15 +99,16 @@ func main() {
 
 	// Bootstrapping service
 	app.Start(ctx, func() error {
+		db, err := sqldb.ServiceDB(app, cfg, "sqlWrites")
+		errs.Panic(err)
+		if os.Getenv("DB_DEBUG") != "" {
+			db = db.Debug()
+		}
+
 		projectAPI, err := project_app_v1.NewProjectAPI(ctx, &project_app_v1.Options{
 			AuthAPI: authAPI,
-			SqlDb: func() *gorm.DB {
-				if os.Getenv("DB_DEBUG") != "" {
-					return app.GormDBByName("sqlWrites").Debug()
-				}
-				return app.GormDBByName("sqlWrites")
-			}(),
-			Logger: app.Logger(),
+			SqlDb:   db,
+			Logger:  app.Logger(),
 		})
 		errs.Panic(err)
 
@@ -21,6 +21,7 @@ import (
 	sms_app "github.com/gidyon/services/internal/messaging/sms"
 
 	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/internal/pkg/sqldb"
 	"github.com/gidyon/services/pkg/api/messaging/sms"
 
 	"github.com/gidyon/micro/v2/pkg/config"
@@ -111,10 +112,13 @@ func main() {
 			Timeout: 15 * time.Second,
 		}
 
+		db, err := sqldb.ServiceDB(app, cfg, "mysql")
+		errs.Panic(err)
+
 		// Create sms API instance
 		smsAPI, err := sms_app.NewSMSAPIServer(ctx, &sms_app.Options{
 			Logger:     app.Logger(),
-			SQLDB:      app.GormDB(),
+			SQLDB:      db,
 			AuthAPI:    authAPI,
 			HTTPClient: httpClient,
 			SendSMSUrl: os.Getenv("SMS_API_URL"),
@@ -17,6 +17,7 @@ import (
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/zaplogger"
 	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/internal/pkg/sqldb"
 	"github.com/gidyon/services/pkg/api/account"
 	"github.com/gidyon/services/pkg/api/channel"
 	"github.com/gidyon/services/pkg/api/subscriber"
@@ -105,7 +106,8 @@ func main() {
 
 		app.Logger().Infoln("connected to all services")
 
-		db := app.GormDBByName("sqlWrites")
+		db, err := sqldb.ServiceDB(app, cfg, "sqlWrites")
+		errs.Panic(err)
 		if os.Getenv("DB_DEBUG") != "" {
 			db = db.Debug()
 		}
@@ -11,6 +11,8 @@ require (
 	github.com/Pallinder/go-randomdata v1.2.0
 	github.com/RediSearch/redisearch-go v1.1.0 // indirect
 	github.com/appleboy/go-fcm v0.1.5
+	github.com/blevesearch/bleve/v2 v2.0.3
+	github.com/dgrijalva/jwt-go v3.2.0+incompatible
 	github.com/gidyon/micro v1.12.0
 	github.com/gidyon/micro/v2 v2.4.8
 	github.com/go-redis/redis v6.15.9+incompatible
@@ -22,7 +24,8 @@ require (
 	github.com/grpc-ecosystem/go-grpc-middleware v1.2.2
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.1.0
 	github.com/jszwec/csvutil v1.5.0
-	github.com/mattn/go-sqlite3 v1.14.6 // indirect
+	github.com/mattn/go-sqlite3 v1.14.16 // indirect
+	github.com/nyaruka/phonenumbers v1.0.67
 	github.com/onsi/ginkgo v1.15.0
 	github.com/onsi/gomega v1.10.5
 	github.com/pjebs/optimus-go v1.0.0
@@ -40,8 +43,9 @@ require (
 	google.golang.org/protobuf v1.25.0
 	gopkg.in/alexcesaro/quotedprintable.v3 v3.0.0-20150716171945-2caba252f4dc // indirect
 	gopkg.in/gomail.v2 v2.0.0-20160411212932-81ebce5c23df
-	gorm.io/driver/mysql v1.0.4 // indirect
-	gorm.io/driver/postgres v1.0.8 // indirect
+	gorm.io/driver/mysql v1.0.4
+	gorm.io/driver/postgres v1.0.8
+	gorm.io/driver/sqlite v1.1.4
 	gorm.io/gorm v1.20.12
 	gorm.io/hints v0.0.0-20210202060412-ba9ac1027f89
 	honnef.co/go/tools v0.1.1 // indirect
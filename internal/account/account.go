@@ -9,25 +9,35 @@ import (
 	"os"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
 	"gorm.io/gorm"
-	"gorm.io/hints"
 
 	"google.golang.org/grpc/grpclog"
 
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
-	"github.com/gidyon/micro/v2/utils/dbutil"
+	"github.com/gidyon/micro/v2/utils/encryption"
 	"github.com/gidyon/micro/v2/utils/errs"
 	"github.com/gidyon/micro/v2/utils/mdutil"
 	"github.com/gidyon/micro/v2/utils/templateutil"
+	"github.com/gidyon/services/internal/pkg/attributes"
+	"github.com/gidyon/services/internal/pkg/e164"
+	"github.com/gidyon/services/internal/pkg/eventbus"
 	"github.com/gidyon/services/internal/pkg/fauth"
+	"github.com/gidyon/services/internal/pkg/idp"
+	"github.com/gidyon/services/internal/pkg/otp"
+	"github.com/gidyon/services/internal/pkg/outbox"
+	"github.com/gidyon/services/internal/pkg/password"
+	"github.com/gidyon/services/internal/pkg/search"
+	"github.com/gidyon/services/internal/pkg/sqldb"
 	"github.com/gidyon/services/pkg/api/account"
+	"github.com/gidyon/services/pkg/api/longrunning"
 	"github.com/gidyon/services/pkg/api/messaging"
+	"github.com/gidyon/services/pkg/api/settings"
+	"github.com/gidyon/services/pkg/api/subscriber"
 	"github.com/gidyon/services/pkg/utils/timeutil"
 	"github.com/gorilla/securecookie"
 
@@ -46,10 +56,15 @@ type cookier interface {
 
 type accountAPIServer struct {
 	account.UnsafeAccountAPIServer
-	activationURL string
-	tpl           *template.Template
-	cookier       cookier
-	setCookie     func(context.Context, string) error
+	activationURL  string
+	tpl            *template.Template
+	cookier        cookier
+	setCookie      func(context.Context, string) error
+	lockout        *LockoutOptions
+	otpLimits      *OTPLimitOptions
+	retention      *RetentionOptions
+	passwordHasher password.Hasher
+	trustedProxies int
 	*Options
 }
 
@@ -70,6 +85,30 @@ type Options struct {
 	Logger             grpclog.LoggerV2
 	MessagingClient    messaging.MessagingClient
 	FirebaseAuth       fauth.FirebaseAuthClient
+	EncryptionAPI      encryption.API
+	SignInLockout      *LockoutOptions
+	OTPLimits          *OTPLimitOptions
+	PasswordHasher     password.Hasher
+	PasswordPolicies   map[string]*password.Policy
+	OTPPolicies        map[string]*otp.Policy
+	IdentityProviders  *idp.Registry
+	OperationsClient   longrunning.OperationAPIClient
+	SubscriberClient   subscriber.SubscriberAPIClient
+	SettingsClient     settings.SettingsAPIClient
+	Retention          *RetentionOptions
+	PhoneRegions       e164.Regions
+	AttributeSchemas   attributes.Schemas
+	SearchIndex        search.Index
+	EventBus           eventbus.Bus
+	// Signing key of AuthAPI. It keys stored OTP hashes and signs magic links.
+	TokenSigningKey []byte
+	// Page where invited users accept invitations; the invitation code is added as the code query parameter
+	InvitationURL string
+	// Page where magic links are opened; the link token is added as the token query parameter
+	MagicLinkURL string
+	// Proxies in front of the service, including the gateway, that append to x-forwarded-for. The client
+	// address is the hop added by the outermost of them. Defaults to 1; negative values use the address of the peer.
+	TrustedProxies int
 }
 
 // NewAccountAPI creates an account API singleton
@@ -105,6 +144,8 @@ func NewAccountAPI(ctx context.Context, opt *Options) (account.AccountAPIServer,
 		err = errs.NilObject("Logger")
 	case opt.MessagingClient == nil:
 		err = errs.NilObject("messaging client")
+	case len(opt.TokenSigningKey) == 0:
+		err = errs.MissingField("token signing key")
 		// case opt.FirebaseAuth == nil:
 		// 	err = errs.NilObject("firebase auth")
 	}
@@ -112,11 +153,31 @@ func NewAccountAPI(ctx context.Context, opt *Options) (account.AccountAPIServer,
 		return nil, err
 	}
 
+	// Password hasher defaults to argon2id
+	passwordHasher := opt.PasswordHasher
+	if passwordHasher == nil {
+		passwordHasher, err = password.NewHasher(nil)
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to create password hasher")
+		}
+	}
+
+	// The gateway appends the address of its peer
+	trustedProxies := opt.TrustedProxies
+	if trustedProxies == 0 {
+		trustedProxies = 1
+	}
+
 	// Account API
 	accountAPI := &accountAPIServer{
-		activationURL: opt.ActivationURL,
-		Options:       opt,
-		cookier:       opt.SecureCookie,
+		activationURL:  opt.ActivationURL,
+		lockout:        opt.SignInLockout.withDefaults(),
+		otpLimits:      opt.OTPLimits.withDefaults(),
+		retention:      opt.Retention.withDefaults(),
+		passwordHasher: passwordHasher,
+		trustedProxies: trustedProxies,
+		Options:        opt,
+		cookier:        opt.SecureCookie,
 		setCookie: func(ctx context.Context, cookie string) error {
 			err := grpc.SetHeader(ctx, metadata.Pairs("set-cookie", cookie))
 			if err != nil {
@@ -152,20 +213,120 @@ func NewAccountAPI(ctx context.Context, opt *Options) (account.AccountAPIServer,
 		}
 	}
 
-	if !accountAPI.SQLDBWrites.Migrator().HasIndex(&Account{}, dbutil.FullTextIndex) {
-		// Create a full text search index
-		err = dbutil.CreateFullTextIndex(accountAPI.SQLDBWrites, accountsTable, "names", "email", "phone", "linked_accounts")
+	if !accountAPI.SQLDBWrites.Migrator().HasTable(mfaTable) {
+		err = accountAPI.SQLDBWrites.AutoMigrate(&MFA{})
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to automigrate mfa table")
+		}
+	}
+
+	if !accountAPI.SQLDBWrites.Migrator().HasColumn(&Account{}, "password_changed_at") {
+		err = accountAPI.SQLDBWrites.Migrator().AddColumn(&Account{}, "PasswordChangedAt")
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to add password changed column")
+		}
+	}
+
+	if !accountAPI.SQLDBWrites.Migrator().HasColumn(&Account{}, "purged_at") {
+		err = accountAPI.SQLDBWrites.Migrator().AddColumn(&Account{}, "PurgedAt")
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to add purged at column")
+		}
+	}
+
+	if !accountAPI.SQLDBWrites.Migrator().HasColumn(&Account{}, "attributes") {
+		err = accountAPI.SQLDBWrites.Migrator().AddColumn(&Account{}, "Attributes")
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to add attributes column")
+		}
+	}
+
+	if !accountAPI.SQLDBWrites.Migrator().HasTable(passwordHistoryTable) {
+		err = accountAPI.SQLDBWrites.AutoMigrate(&PasswordHistory{})
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to automigrate password history table")
+		}
+	}
+
+	if !accountAPI.SQLDBWrites.Migrator().HasTable(oidcClientsTable) {
+		err = accountAPI.SQLDBWrites.AutoMigrate(&OIDCClient{})
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to automigrate oidc clients table")
+		}
+	}
+
+	if !accountAPI.SQLDBWrites.Migrator().HasTable(identitiesTable) {
+		err = accountAPI.SQLDBWrites.AutoMigrate(&LinkedIdentity{})
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to automigrate linked identities table")
+		}
+	}
+
+	if !accountAPI.SQLDBWrites.Migrator().HasTable(auditEventsTable) {
+		err = accountAPI.SQLDBWrites.AutoMigrate(&AuditEvent{})
 		if err != nil {
-			return nil, errs.WrapErrorWithMsg(err, "failed to create full text index")
+			return nil, errs.WrapErrorWithMsg(err, "failed to automigrate audit events table")
 		}
 	}
 
+	if !accountAPI.SQLDBWrites.Migrator().HasTable(signInEventsTable) {
+		err = accountAPI.SQLDBWrites.AutoMigrate(&SignInEvent{})
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to automigrate sign in events table")
+		}
+	}
+
+	if !accountAPI.SQLDBWrites.Migrator().HasTable(purgeRunsTable) {
+		err = accountAPI.SQLDBWrites.AutoMigrate(&PurgeRun{})
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to automigrate purge runs table")
+		}
+	}
+
+	if !accountAPI.SQLDBWrites.Migrator().HasTable(invitationsTable) {
+		err = accountAPI.SQLDBWrites.AutoMigrate(&Invitation{})
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to automigrate invitations table")
+		}
+	}
+
+	if accountAPI.EventBus != nil {
+		err = outbox.Migrate(accountAPI.SQLDBWrites)
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to automigrate outbox table")
+		}
+	}
+
+	// Create a full text search index
+	err = sqldb.CreateFullTextIndex(accountAPI.SQLDBWrites, accountsTable, "names", "email", "phone", "linked_accounts")
+	if err != nil {
+		return nil, errs.WrapErrorWithMsg(err, "failed to create full text index")
+	}
+
+	// Publish account events
+	if accountAPI.EventBus != nil {
+		err = accountAPI.startEventRelay(ctx)
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to start event relay")
+		}
+	}
+
+	// Purge soft deleted accounts past their grace period
+	if accountAPI.retention.Interval > 0 {
+		go accountAPI.startRetentionWorker(ctx)
+	}
+
 	return accountAPI, nil
 }
 
 func (accountAPI *accountAPIServer) SignInExternal(
 	ctx context.Context, signInReq *account.SignInExternalRequest,
 ) (*account.SignInResponse, error) {
+	// Identity providers other than firebase
+	if provider := signInReq.GetProvider(); provider != "" && provider != idp.Firebase {
+		return accountAPI.signInIdentityProvider(ctx, signInReq)
+	}
+
 	if accountAPI.FirebaseAuth == nil {
 		return nil, errs.WrapMessage(codes.Unavailable, "firebase auth not available")
 	}
@@ -191,39 +352,77 @@ func (accountAPI *accountAPIServer) SignInExternal(
 	}
 
 	// Verify ID token
-	_, err = accountAPI.FirebaseAuth.VerifyIDToken(ctx, signInReq.AuthToken)
+	identity, err := accountAPI.verifyIdentity(ctx, signInReq.ProjectId, idp.Firebase, signInReq.AuthToken)
 	if err != nil {
-		return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to verify firebase ID token")
+		return nil, err
+	}
+
+	// Only verified contacts of the token identify accounts
+	signInReq.Account.Email, signInReq.Account.Phone = "", ""
+	if identity.EmailVerified {
+		signInReq.Account.Email = identity.Email
+	}
+	if identity.PhoneVerified {
+		signInReq.Account.Phone = identity.Phone
+	}
+	if signInReq.Account.Email == "" && signInReq.Account.Phone == "" {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "firebase token has no verified email or phone")
 	}
 
+	return accountAPI.signInIdentity(ctx, signInReq.ProjectId, identity, signInReq.Account)
+}
+
+// signs in the account with the email or phone of an externally verified user, creating it if missing
+func (accountAPI *accountAPIServer) signInExternalAccount(
+	ctx context.Context, projectID string, pb *account.Account,
+) (*account.SignInResponse, error) {
 	var (
-		db = &Account{}
-		ID uint
+		db  = &Account{}
+		ID  uint
+		err error
 	)
 
+	if pb.Phone != "" {
+		pb.Phone, err = accountAPI.normalisePhone(projectID, pb.Phone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Get user
 	switch {
-	case signInReq.Account.Email != "":
-		err = accountAPI.SQLDBWrites.First(db, "email=? AND project_id = ?", signInReq.Account.Email, signInReq.ProjectId).Error
-	case signInReq.Account.Phone != "":
-		err = accountAPI.SQLDBWrites.First(db, "phone=? AND project_id = ?", signInReq.Account.Phone, signInReq.ProjectId).Error
+	case pb.Email != "":
+		err = accountAPI.SQLDBWrites.First(db, "email=? AND project_id = ?", pb.Email, projectID).Error
+	case pb.Phone != "":
+		err = accountAPI.SQLDBWrites.First(db, "phone=? AND project_id = ?", pb.Phone, projectID).Error
 	}
 	switch {
 	case err == nil:
 		ID = db.AccountID
 	case errors.Is(err, gorm.ErrRecordNotFound):
 		// Create user
-		signInReq.Account.ProjectId = signInReq.ProjectId
-		db, err = AccountModel(signInReq.Account)
+		pb.ProjectId = projectID
+		db, err = AccountModel(pb)
+		if err != nil {
+			return nil, err
+		}
+		db.Attributes, err = accountAPI.attributesJSON(projectID, pb.Attributes)
 		if err != nil {
 			return nil, err
 		}
 		db.AccountState = account.AccountState_ACTIVE.String()
-		err = accountAPI.SQLDBWrites.Create(db).Error
+		err = accountAPI.SQLDBWrites.Transaction(func(tx *gorm.DB) error {
+			err := tx.Create(db).Error
+			if err != nil {
+				return errs.FailedToSave("account", err)
+			}
+			return accountAPI.addAccountEvent(tx, account.AccountEventType_ACCOUNT_CREATED, db.AccountID, "")
+		})
 		if err != nil {
-			return nil, errs.FailedToSave("account", err)
+			return nil, err
 		}
-		return accountAPI.updateSession(ctx, db, "")
+		accountAPI.indexAccount(db.AccountID)
+		return accountAPI.signInSession(ctx, db, "")
 	default:
 		return nil, errs.FailedToSave("account", err)
 	}
@@ -232,12 +431,20 @@ func (accountAPI *accountAPIServer) SignInExternal(
 	omitFields := []string{"project_id", "id_number", "linked_accounts", "password", "primary_group", "account_state", "secondary_groups", "security_question", "security-answer", "account_id", "gender", "created_at"}
 
 	// Update account
-	err = accountAPI.SQLDBWrites.Table(accountsTable).Where("account_id= ?", ID).Omit(omitFields...).Updates(db).Error
+	err = accountAPI.SQLDBWrites.Transaction(func(tx *gorm.DB) error {
+		err := tx.Table(accountsTable).Where("account_id= ?", ID).Omit(omitFields...).Updates(db).Error
+		if err != nil {
+			return errs.FailedToUpdate("account", err)
+		}
+		return accountAPI.addAccountEvent(tx, account.AccountEventType_ACCOUNT_UPDATED, ID, "")
+	})
 	if err != nil {
-		return nil, errs.FailedToUpdate("account", err)
+		return nil, err
 	}
 
-	return accountAPI.updateSession(ctx, db, "")
+	accountAPI.indexAccount(ID)
+
+	return accountAPI.signInSession(ctx, db, "")
 }
 
 func (accountAPI *accountAPIServer) RefreshSession(
@@ -262,17 +469,18 @@ func (accountAPI *accountAPIServer) RefreshSession(
 		}
 	}
 
-	// Ensure that refresh token already exists
-	ok, err := accountAPI.RedisDBWrites.SIsMember(ctx, refreshTokenSet(), req.RefreshToken).Result()
-	switch {
-	case err == nil:
-		if !ok {
-			return nil, errs.WrapMessage(codes.Unauthenticated, "not signed in")
-		}
-	case errors.Is(err, redis.Nil) || !ok:
+	err = denyImpersonation(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that refresh token belongs to a session of the account
+	sess, err := accountAPI.getSessionByRefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if sess.AccountID != req.AccountId {
 		return nil, errs.WrapMessage(codes.Unauthenticated, "not signed in")
-	default:
-		return nil, errs.RedisCmdFailed(err, "get")
 	}
 
 	db := &Account{}
@@ -292,7 +500,12 @@ func (accountAPI *accountAPIServer) RefreshSession(
 		return nil, errs.WrapMessage(codes.PermissionDenied, "account is deleted")
 	}
 
-	return accountAPI.updateSession(ctx, db, req.AccountGroup)
+	refreshToken, err := accountAPI.rotateSession(ctx, sess)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountAPI.sessionResponse(ctx, db, req.AccountGroup, sess.ID, refreshToken)
 }
 
 func (accountAPI *accountAPIServer) ActivateAccount(
@@ -349,16 +562,23 @@ func (accountAPI *accountAPIServer) ActivateAccount(
 	}
 
 	// Check that account exists
+	db := &Account{}
 	if errors.Is(accountAPI.SQLDBWrites.Select("account_state").
-		First(&Account{}, "account_id=?", ID).Error, gorm.ErrRecordNotFound) {
+		First(db, "account_id=?", ID).Error, gorm.ErrRecordNotFound) {
 		return nil, errs.DoesNotExist("account", activateReq.AccountId)
 	}
 
 	// Update the model of the user to activate their account
-	err = accountAPI.SQLDBWrites.Table(accountsTable).Where("account_id=?", ID).
-		Update("account_state", account.AccountState_ACTIVE.String()).Error
+	err = accountAPI.SQLDBWrites.Transaction(func(tx *gorm.DB) error {
+		err := tx.Table(accountsTable).Where("account_id=?", ID).
+			Update("account_state", account.AccountState_ACTIVE.String()).Error
+		if err != nil {
+			return errs.FailedToUpdate("account", err)
+		}
+		return accountAPI.addAccountEvent(tx, account.AccountEventType_ACCOUNT_STATE_CHANGED, uint(ID), db.AccountState)
+	})
 	if err != nil {
-		return nil, errs.FailedToUpdate("account", err)
+		return nil, err
 	}
 
 	return &account.ActivateAccountResponse{}, nil
@@ -391,7 +611,7 @@ func (accountAPI *accountAPIServer) UpdateAccount(
 
 	// GetAccount the account details from database
 	db := &Account{}
-	err = accountAPI.SQLDBWrites.Select("account_state").
+	err = accountAPI.SQLDBWrites.Select("account_id,project_id,account_state,attributes").
 		First(db, "account_id=?", updateReq.Account.AccountId).Error
 	switch {
 	case err == nil:
@@ -413,6 +633,21 @@ func (accountAPI *accountAPIServer) UpdateAccount(
 		return nil, err
 	}
 
+	if dbX.Phone != "" {
+		dbX.Phone, err = accountAPI.normalisePhone(db.ProjectID, dbX.Phone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Attributes in the request are merged into the stored attributes
+	if len(updateReq.Account.Attributes) != 0 {
+		dbX.Attributes, err = accountAPI.mergeAttributesJSON(db.ProjectID, db.Attributes, updateReq.Account.Attributes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if dbX.AccountState == account.AccountState_ACCOUNT_STATE_UNSPECIFIED.String() {
 		dbX.AccountState = ""
 	}
@@ -420,21 +655,36 @@ func (accountAPI *accountAPIServer) UpdateAccount(
 		dbX.Gender = ""
 	}
 
-	if !accountAPI.AuthAPI.IsAdmin(payload.Group) {
-		// Update the model; omit "id", "primary_group", "account_state" and "security profile"
-		err = accountAPI.SQLDBWrites.Model(dbX).
-			Omit("id", "primary_group", "account_state", "password", "security_answer", "security_question").
-			Where("account_id=?", updateReq.Account.AccountId).
-			Updates(dbX).Error
-	} else {
-		err = accountAPI.SQLDBWrites.Model(dbX).
-			Where("account_id=?", updateReq.Account.AccountId).
-			Updates(dbX).Error
+	isAdmin := accountAPI.AuthAPI.IsAdmin(payload.Group)
+
+	eventType := account.AccountEventType_ACCOUNT_UPDATED
+	if isAdmin && dbX.AccountState != "" && dbX.AccountState != db.AccountState {
+		eventType = account.AccountEventType_ACCOUNT_STATE_CHANGED
 	}
+
+	err = accountAPI.SQLDBWrites.Transaction(func(tx *gorm.DB) error {
+		if !isAdmin {
+			// Update the model; omit "id", "primary_group", "account_state" and "security profile"
+			err = tx.Model(dbX).
+				Omit("id", "primary_group", "account_state", "password", "security_answer", "security_question").
+				Where("account_id=?", updateReq.Account.AccountId).
+				Updates(dbX).Error
+		} else {
+			err = tx.Model(dbX).
+				Where("account_id=?", updateReq.Account.AccountId).
+				Updates(dbX).Error
+		}
+		if err != nil {
+			return errs.FailedToUpdate("account", err)
+		}
+		return accountAPI.addAccountEvent(tx, eventType, db.AccountID, db.AccountState)
+	})
 	if err != nil {
-		return nil, errs.FailedToUpdate("account", err)
+		return nil, err
 	}
 
+	accountAPI.indexAccount(db.AccountID)
+
 	return &empty.Empty{}, nil
 }
 
@@ -461,10 +711,15 @@ func (accountAPI *accountAPIServer) RequestChangePrivateAccount(
 		return nil, errs.WrapMessage(codes.InvalidArgument, "send method is unspecified")
 	}
 
+	err = denyImpersonation(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// GetAccount the user from database
 	db := &Account{}
 	err = accountAPI.SQLDBWrites.
-		First(db, "(email=? OR phone=?) AND project_id = ?", req.Payload, req.Payload, req.Project).Error
+		First(db, "(email=? OR phone=?) AND project_id = ?", req.Payload, accountAPI.usernamePhone(req.Project, req.Payload), req.Project).Error
 	switch {
 	case err == nil:
 	case errors.Is(err, gorm.ErrRecordNotFound):
@@ -576,6 +831,11 @@ func (accountAPI *accountAPIServer) UpdatePrivateAccount(
 		}
 	}
 
+	err = denyImpersonation(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Authorization
 	_, err = accountAPI.AuthAPI.AuthorizeActorOrGroup(ctx, req.AccountId, accountAPI.AuthAPI.AdminGroups()...)
 	if err != nil {
@@ -584,7 +844,7 @@ func (accountAPI *accountAPIServer) UpdatePrivateAccount(
 
 	// GetAccount the account details from database
 	db := &Account{}
-	err = accountAPI.SQLDBWrites.Select("account_state,password").First(db, "account_id=?", ID).Error
+	err = accountAPI.SQLDBWrites.Select("account_id,project_id,account_state,password").First(db, "account_id=?", ID).Error
 	switch {
 	case err == nil:
 	case errors.Is(err, gorm.ErrRecordNotFound):
@@ -619,17 +879,22 @@ func (accountAPI *accountAPIServer) UpdatePrivateAccount(
 			return nil, errs.WrapMessage(codes.InvalidArgument, "passwords do not match")
 		}
 
-		req.PrivateAccount.Password, err = genHash(req.PrivateAccount.Password)
-		if err != nil {
-			return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate password hash")
-		}
-
 		if req.PrivateAccount.OldPassword != "" {
-			err = compareHash(db.Password, req.PrivateAccount.OldPassword)
+			err = accountAPI.compareHash(db.Password, req.PrivateAccount.OldPassword)
 			if err != nil {
 				return nil, errs.WrapMessage(codes.InvalidArgument, "incorrect old password")
 			}
 		}
+
+		err = accountAPI.checkPasswordPolicy(db.ProjectID, db.AccountID, req.PrivateAccount.Password)
+		if err != nil {
+			return nil, err
+		}
+
+		req.PrivateAccount.Password, err = accountAPI.genHash(req.PrivateAccount.Password)
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate password hash")
+		}
 	}
 
 	// Create database model of the new account
@@ -640,9 +905,18 @@ func (accountAPI *accountAPIServer) UpdatePrivateAccount(
 	}
 
 	// Update the model
-	err = accountAPI.SQLDBWrites.Model(privateDB).Where("account_id=?", ID).Updates(privateDB).Error
+	err = accountAPI.SQLDBWrites.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(privateDB).Where("account_id=?", ID).Updates(privateDB).Error
+		if err != nil {
+			return errs.FailedToUpdate("account", err)
+		}
+		if privateDB.Password != "" {
+			return accountAPI.passwordChanged(tx, db.ProjectID, db.AccountID, privateDB.Password)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, errs.FailedToUpdate("account", err)
+		return nil, err
 	}
 
 	return &empty.Empty{}, nil
@@ -670,21 +944,26 @@ func (accountAPI *accountAPIServer) UpdatePrivateAccountExternal(
 	default:
 	}
 
-	// Validate jwt token from request
-	payload, err := accountAPI.AuthAPI.GetPayloadFromJwt(req.Jwt)
-	if err != nil {
-		return nil, err
+	// Validate jwt token from request; tokens issued for expired passwords are only accepted here
+	payload, ok := accountAPI.parsePasswordChangeToken(req.Jwt)
+	if !ok {
+		payload, err = accountAPI.AuthAPI.GetPayloadFromJwt(req.Jwt)
+		if err != nil {
+			return nil, err
+		}
 	}
 
+	phone := accountAPI.usernamePhone(req.ProjectId, req.Username)
+
 	// The username should match payload data
-	if payload.EmailAddress != req.Username && payload.PhoneNumber != req.Username && req.Username != payload.ID {
+	if payload.EmailAddress != req.Username && payload.PhoneNumber != phone && req.Username != payload.ID {
 		return nil, errs.WrapMessage(codes.PermissionDenied, "you are not allowed to perform this operation")
 	}
 
 	// GetAccount the account details from database
 	db := &Account{}
-	err = accountAPI.SQLDBWrites.Select("account_id,account_state").
-		First(db, "(email=? OR phone=?) AND project_id = ?", req.Username, req.Username, req.ProjectId).Error
+	err = accountAPI.SQLDBWrites.Select("account_id,project_id,account_state").
+		First(db, "(email=? OR phone=?) AND project_id = ?", req.Username, phone, req.ProjectId).Error
 	switch {
 	case err == nil:
 	case errors.Is(err, gorm.ErrRecordNotFound):
@@ -719,7 +998,12 @@ func (accountAPI *accountAPIServer) UpdatePrivateAccountExternal(
 			return nil, errs.WrapMessage(codes.InvalidArgument, "passwords do not match")
 		}
 
-		req.PrivateAccount.Password, err = genHash(req.PrivateAccount.Password)
+		err = accountAPI.checkPasswordPolicy(db.ProjectID, db.AccountID, req.PrivateAccount.Password)
+		if err != nil {
+			return nil, err
+		}
+
+		req.PrivateAccount.Password, err = accountAPI.genHash(req.PrivateAccount.Password)
 		if err != nil {
 			return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate password hash")
 		}
@@ -733,9 +1017,18 @@ func (accountAPI *accountAPIServer) UpdatePrivateAccountExternal(
 	}
 
 	// Update the model
-	err = accountAPI.SQLDBWrites.Model(privateDB).Where("account_id=?", db.AccountID).Updates(privateDB).Error
+	err = accountAPI.SQLDBWrites.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(privateDB).Where("account_id=?", db.AccountID).Updates(privateDB).Error
+		if err != nil {
+			return errs.FailedToUpdate("account", err)
+		}
+		if privateDB.Password != "" {
+			return accountAPI.passwordChanged(tx, db.ProjectID, db.AccountID, privateDB.Password)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, errs.FailedToUpdate("account", err)
+		return nil, err
 	}
 
 	return &empty.Empty{}, nil
@@ -749,6 +1042,10 @@ func (accountAPI *accountAPIServer) DeleteAccount(
 		return nil, errs.NilObject("DeleteAccountRequest")
 	}
 
+	if err := denyImpersonation(ctx); err != nil {
+		return nil, err
+	}
+
 	// Authorization
 	_, err := accountAPI.AuthAPI.AuthorizeActorOrGroup(ctx, delReq.AccountId, accountAPI.AuthAPI.AdminGroups()...)
 	if err != nil {
@@ -784,9 +1081,21 @@ func (accountAPI *accountAPIServer) DeleteAccount(
 	}
 
 	// Soft delete their account
-	err = accountAPI.SQLDBWrites.Delete(db, "account_id=?", ID).Error
+	err = accountAPI.SQLDBWrites.Transaction(func(tx *gorm.DB) error {
+		err := tx.Delete(db, "account_id=?", ID).Error
+		if err != nil {
+			return errs.FailedToDelete("account", err)
+		}
+		return accountAPI.addAccountEvent(tx, account.AccountEventType_ACCOUNT_DELETED, uint(ID), db.AccountState)
+	})
 	if err != nil {
-		return nil, errs.FailedToDelete("account", err)
+		return nil, err
+	}
+
+	// Sign out of all devices
+	err = accountAPI.revokeAllSessions(ctx, delReq.AccountId, "")
+	if err != nil {
+		return nil, err
 	}
 
 	return &empty.Empty{}, nil
@@ -830,7 +1139,7 @@ func (accountAPI *accountAPIServer) GetAccount(
 		}
 	} else {
 		if req.UsePhone {
-			err = accountAPI.SQLDBWrites.First(db, "phone=?", req.AccountId).Error
+			err = accountAPI.SQLDBWrites.First(db, "phone=?", accountAPI.usernamePhone(e164.DefaultProject, req.AccountId)).Error
 		} else if req.UseEmail {
 			err = accountAPI.SQLDBWrites.First(db, "email=?", req.AccountId).Error
 		} else {
@@ -898,8 +1207,12 @@ func (accountAPI *accountAPIServer) ExistAccount(
 		return nil, errs.MissingField("email, phone or external id")
 	}
 
-	// Fix phone
-	phone = fixPhone(phone)
+	if phone != "" {
+		phone, err = accountAPI.normalisePhone(projectID, phone)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	db := &Account{}
 
@@ -944,6 +1257,11 @@ func (accountAPI *accountAPIServer) ListAccounts(
 		return nil, errs.IncorrectVal("page size")
 	}
 
+	err := checkAttributesFilter(req.ListCriteria)
+	if err != nil {
+		return nil, err
+	}
+
 	// Authenticate the request
 	payload, err := accountAPI.AuthAPI.AuthenticateRequestV2(ctx)
 	if err != nil {
@@ -973,10 +1291,10 @@ func (accountAPI *accountAPIServer) ListAccounts(
 		id = uint(ids[0])
 	}
 
-	db := accountAPI.SQLDBWrites.Limit(int(pageSize) + 1).Order("account_id DESC").Clauses(hints.ForceIndex("PRIMARY").ForOrderBy()).Model(&Account{})
+	db := sqldb.ForceIndexForOrderBy(accountAPI.SQLDBWrites.Limit(int(pageSize)+1).Order("account_id DESC"), "PRIMARY").Model(&Account{})
 
 	// Apply filter criterias
-	db = filterQuery(db, req.GetListCriteria()).Debug()
+	db = accountAPI.filterQuery(db, req.GetListCriteria()).Debug()
 
 	// For admins
 	for _, group := range accountAPI.AuthAPI.AdminGroups() {
@@ -995,7 +1313,7 @@ func (accountAPI *accountAPIServer) ListAccounts(
 	if payload.ProjectID != "" {
 		db = db.Where("project_id=?", payload.ProjectID)
 		if req.ListCriteria != nil {
-			req.ListCriteria.ProjectIds = []string{}
+			req.ListCriteria.ProjectIds = []string{payload.ProjectID}
 		}
 	} else {
 		if !accountAPI.AuthAPI.IsAdmin(payload.Group) {
@@ -1064,6 +1382,11 @@ func (accountAPI *accountAPIServer) SearchAccounts(
 		return nil, errs.NilObject("SearchRequest")
 	}
 
+	err := checkAttributesFilter(req.SearchCriteria)
+	if err != nil {
+		return nil, err
+	}
+
 	// Authenticate the request
 	payload, err := accountAPI.AuthAPI.AuthenticateRequestV2(ctx)
 	if err != nil {
@@ -1103,7 +1426,7 @@ func (accountAPI *accountAPIServer) SearchAccounts(
 	db := accountAPI.SQLDBReads.Limit(int(pageSize)).Order("account_id DESC").Model(&Account{})
 
 	// Apply filter criterias
-	db = filterQuery(db, req.GetSearchCriteria())
+	db = accountAPI.filterQuery(db, req.GetSearchCriteria())
 
 	// For admins
 	for _, group := range accountAPI.AuthAPI.AdminGroups() {
@@ -1117,7 +1440,7 @@ func (accountAPI *accountAPIServer) SearchAccounts(
 	if payload.ProjectID != "" {
 		db = db.Where("project_id=?", payload.ProjectID)
 		if req.SearchCriteria != nil {
-			req.SearchCriteria.ProjectIds = []string{}
+			req.SearchCriteria.ProjectIds = []string{payload.ProjectID}
 		}
 	} else {
 		if !accountAPI.AuthAPI.IsAdmin(payload.Group) {
@@ -1125,6 +1448,11 @@ func (accountAPI *accountAPIServer) SearchAccounts(
 		}
 	}
 
+	// Search index ranks results by relevance
+	if accountAPI.SearchIndex != nil {
+		return accountAPI.searchIndex(db, req, payload.ProjectID, pageSize)
+	}
+
 	var collectionCount int64
 
 	// Page token
@@ -1135,11 +1463,7 @@ func (accountAPI *accountAPIServer) SearchAccounts(
 		}
 	}
 
-	parsedQuery := dbutil.ParseQuery(req.Query)
-
-	// "names", "email", "phone", "linked_accounts"
-	err = db.Find(&accountsDB, "MATCH(names, email, phone, linked_accounts) AGAINST(? IN BOOLEAN MODE)", parsedQuery).
-		Error
+	err = sqldb.MatchFullText(db, req.Query, "names", "email", "phone", "linked_accounts").Find(&accountsDB).Error
 	switch {
 	case err == nil:
 	default:
@@ -1179,7 +1503,7 @@ func (accountAPI *accountAPIServer) SearchAccounts(
 	}, nil
 }
 
-func filterQuery(db *gorm.DB, criteria *account.Criteria) *gorm.DB {
+func (accountAPI *accountAPIServer) filterQuery(db *gorm.DB, criteria *account.Criteria) *gorm.DB {
 	if criteria == nil {
 		return db
 	}
@@ -1208,17 +1532,17 @@ func filterQuery(db *gorm.DB, criteria *account.Criteria) *gorm.DB {
 		switch {
 		case criteria.CreatedFrom > 0 && criteria.CreatedUntil > 0 && criteria.CreatedFrom < criteria.CreatedUntil:
 			db = db.Where(
-				"UNIX_TIMESTAMP(created_at) BETWEEN ? AND ?",
+				sqldb.UnixTime(db, "created_at")+" BETWEEN ? AND ?",
 				criteria.CreatedFrom, criteria.CreatedUntil,
 			)
 		case criteria.CreatedUntil > 0:
 			db = db.Where(
-				"UNIX_TIMESTAMP(created_at) < ?", criteria.CreatedUntil,
+				sqldb.UnixTime(db, "created_at")+" < ?", criteria.CreatedUntil,
 			)
 		case criteria.CreatedFrom > 0:
 			if criteria.CreatedFrom < nowSecs {
 				db = db.Where(
-					"UNIX_TIMESTAMP(created_at) > ?", criteria.CreatedFrom,
+					sqldb.UnixTime(db, "created_at")+" > ?", criteria.CreatedFrom,
 				)
 			}
 		}
@@ -1236,7 +1560,12 @@ func filterQuery(db *gorm.DB, criteria *account.Criteria) *gorm.DB {
 
 	// Filter by phones
 	if len(criteria.Phones) != 0 {
-		db = db.Where("phone IN (?)", criteria.Phones)
+		db = db.Where("phone IN (?)", accountAPI.filterPhones(criteria.ProjectIds, criteria.Phones))
+	}
+
+	// Filter by custom attributes
+	if len(criteria.Attributes) != 0 {
+		db = accountAPI.filterAttributes(db, criteria)
 	}
 
 	// Filter by email
@@ -1283,12 +1612,6 @@ func (accountAPI *accountAPIServer) DailyRegisteredUsers(
 		req.Filter.ProjectIds = []string{actor.ProjectID}
 	}
 
-	var (
-		wg    = &sync.WaitGroup{}
-		mu    = &sync.Mutex{} // guards stats
-		stats = make([]*account.CountStat, 0, len(req.Dates))
-	)
-
 	if req.DateIsRange {
 		dates, err := timeutil.GetDateRanges(req.Dates[0], req.Dates[1])
 		if err != nil {
@@ -1298,45 +1621,50 @@ func (accountAPI *accountAPIServer) DailyRegisteredUsers(
 		req.Dates = dates
 	}
 
-	for _, date := range req.Dates {
-		wg.Add(1)
-
-		go func(date string) {
-			defer wg.Done()
-
-			dateTime, err := timeutil.GetDateFromString(date)
-			if err != nil {
-				accountAPI.Logger.Errorf("FAILED DailyRegisteredUsers: %v", err)
-				return
-			}
-
-			db := accountAPI.SQLDBReads.Model(&Account{}).Where("created_at BETWEEN ? AND ?", dateTime, dateTime.Add(24*time.Hour)).Debug()
-			if req.Filter != nil {
-				if len(req.Filter.ProjectIds) != 0 {
-					db = db.Where("project_id IN (?)", req.Filter.ProjectIds)
-				}
-			}
-
-			var count int64
+	var (
+		days     = make([]string, 0, len(req.Dates))
+		min, max time.Time
+	)
 
-			// Get count of new users
-			err = db.Count(&count).Error
-			if err != nil {
-				accountAPI.Logger.Errorf("FAILED Count: %v", err)
-				return
-			}
+	for i, date := range req.Dates {
+		dateTime, err := timeutil.GetDateFromString(date)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 || dateTime.Before(min) {
+			min = *dateTime
+		}
+		if i == 0 || dateTime.After(max) {
+			max = *dateTime
+		}
+		days = append(days, dateTime.Format("2006-01-02"))
+	}
+
+	// Count new users of all dates in one query
+	db := accountAPI.SQLDBReads
+	counts := make([]*bucketCount, 0, len(days))
+	err = db.Model(&Account{}).
+		Select(sqldb.DateBucket(db, "created_at", sqldb.Day)+" AS bucket, COUNT(*) AS count").
+		Where("created_at BETWEEN ? AND ?", min, max.Add(24*time.Hour)).
+		Where("project_id IN (?)", req.Filter.ProjectIds).
+		Group("bucket").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "count")
+	}
 
-			mu.Lock()
-			stats = append(stats, &account.CountStat{
-				Date:  date,
-				Count: count,
-			})
-			mu.Unlock()
-		}(date)
+	countsByDay := make(map[string]int64, len(counts))
+	for _, c := range counts {
+		countsByDay[c.Bucket] = c.Count
 	}
 
-	// Wait for results
-	wg.Wait()
+	stats := make([]*account.CountStat, 0, len(req.Dates))
+	for i, date := range req.Dates {
+		stats = append(stats, &account.CountStat{
+			Date:  date,
+			Count: countsByDay[days[i]],
+		})
+	}
 
 	return &account.CountStats{
 		Stats: stats,
@@ -1347,6 +1675,8 @@ func (accountAPI *accountAPIServer) AuthFuncOverride(ctx context.Context, fullMe
 	switch {
 	case strings.Contains(fullMethodName, "SignInOTP"):
 		return ctx, nil
+	case strings.Contains(fullMethodName, "SignInMFA"):
+		return ctx, nil
 	case strings.Contains(fullMethodName, "SignIn"):
 		return ctx, nil
 	case strings.Contains(fullMethodName, "SignInExternal"):
@@ -1355,15 +1685,36 @@ func (accountAPI *accountAPIServer) AuthFuncOverride(ctx context.Context, fullMe
 		return ctx, nil
 	case strings.Contains(fullMethodName, "RequestSignInOTP"):
 		return ctx, nil
+	case strings.Contains(fullMethodName, "UpdatePrivateAccountExternal"):
+		return ctx, nil
+	case strings.Contains(fullMethodName, "RequestMagicLink"):
+		return ctx, nil
+	case strings.Contains(fullMethodName, "AcceptInvitation"):
+		return ctx, nil
 	case strings.Contains(fullMethodName, "CreateAccount"):
-		ctx2, err := accountAPI.AuthAPI.AuthorizeFunc(ctx)
+		ctx2, err := accountAPI.authorizeFunc(ctx)
 		if err != nil {
 			return ctx, nil
 		}
 		return ctx2, nil
 	default:
-		return accountAPI.AuthAPI.AuthorizeFunc(ctx)
+		return accountAPI.authorizeFunc(ctx)
+	}
+}
+
+// authorizes the token of the request and rejects impersonation tokens of revoked sessions
+func (accountAPI *accountAPIServer) authorizeFunc(ctx context.Context) (context.Context, error) {
+	ctx2, err := accountAPI.AuthAPI.AuthorizeFunc(ctx)
+	if err != nil {
+		return nil, err
 	}
+
+	err = accountAPI.checkImpersonationSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ctx2, nil
 }
 
 // func (accountAPI *accountAPIServer) apiCtx(ctx context.Context, group string) (context.Context, error) {
@@ -10,13 +10,12 @@ import (
 	"github.com/Pallinder/go-randomdata"
 
 	"github.com/gidyon/micro/v2"
-	"github.com/gidyon/micro/v2/pkg/conn"
 	micro_mock "github.com/gidyon/micro/v2/pkg/mocks"
 	"github.com/gidyon/micro/v2/utils/encryption"
+	"github.com/gidyon/services/internal/pkg/sqldb/sqldbtest"
 	"github.com/gidyon/services/pkg/api/account"
 	"github.com/gidyon/services/pkg/mocks"
 	redis "github.com/go-redis/redis/v8"
-	_ "github.com/go-sql-driver/mysql"
 	"gorm.io/gorm"
 
 	"github.com/onsi/ginkgo"
@@ -34,19 +33,12 @@ var (
 )
 
 const (
-	dbAddress    = "localhost:3306"
 	schema       = "services"
 	templatesDir = "/home/gideon/go/src/github.com/gidyon/services/internal/account/templates"
 )
 
 func startDB() (*gorm.DB, error) {
-	return conn.OpenGormConn(&conn.DBOptions{
-		Dialect:  "mysql",
-		Address:  dbAddress,
-		User:     "root",
-		Password: "hakty11",
-		Schema:   schema,
-	})
+	return sqldbtest.Open(schema)
 }
 
 var _ = BeforeSuite(func() {
@@ -61,6 +53,9 @@ var _ = BeforeSuite(func() {
 	Expect(db.Migrator().DropTable(accountsTable)).ShouldNot(HaveOccurred())
 	Expect(db.Migrator().AutoMigrate(&Account{})).ShouldNot(HaveOccurred())
 
+	Expect(db.Migrator().DropTable(mfaTable)).ShouldNot(HaveOccurred())
+	Expect(db.Migrator().AutoMigrate(&MFA{})).ShouldNot(HaveOccurred())
+
 	// Redis db
 	redisDB := redis.NewClient(&redis.Options{
 		Network: "tcp",
@@ -75,6 +70,9 @@ var _ = BeforeSuite(func() {
 
 	authAPI := micro_mock.AuthAPI
 
+	encryptionAPI, err := encryption.NewAPI([]byte(randomdata.RandStringRunes(32)))
+	Expect(err).ShouldNot(HaveOccurred())
+
 	opt := &Options{
 		AppName:          "Accounts API",
 		EmailDisplayName: "Accounts API",
@@ -89,6 +87,8 @@ var _ = BeforeSuite(func() {
 		Logger:           Logger,
 		MessagingClient:  mocks.MessagingAPI,
 		FirebaseAuth:     mocks.FirebaseAuthAPI,
+		EncryptionAPI:    encryptionAPI,
+		TokenSigningKey:  []byte(randomdata.RandStringRunes(32)),
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -124,6 +124,12 @@ var _ = BeforeSuite(func() {
 	Expect(err).Should(HaveOccurred())
 
 	opt.MessagingClient = mocks.MessagingAPI
+	signingKey := opt.TokenSigningKey
+	opt.TokenSigningKey = nil
+	_, err = NewAccountAPI(ctx, opt)
+	Expect(err).Should(HaveOccurred())
+
+	opt.TokenSigningKey = signingKey
 	opt.Logger = nil
 	_, err = NewAccountAPI(ctx, opt)
 	Expect(err).Should(HaveOccurred())
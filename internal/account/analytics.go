@@ -0,0 +1,485 @@
+package account
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/internal/pkg/sqldb"
+	"github.com/gidyon/services/pkg/api/account"
+	redis "github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+const (
+	signInEventsTable       = "account_sign_in_events"
+	defaultAnalyticsBuckets = 30
+	maxAnalyticsBuckets     = 400
+)
+
+// SignInEvent records a successful sign in of an account for activity analytics
+type SignInEvent struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	ProjectID string    `gorm:"index;type:varchar(50);not null"`
+	AccountID uint      `gorm:"index;not null"`
+	CreatedAt time.Time `gorm:"index;precision:6;not null;autoCreateTime"`
+}
+
+// TableName is the name of the table
+func (*SignInEvent) TableName() string {
+	return signInEventsTable
+}
+
+// records the sign in of the account; failures only affect analytics so they are logged
+func (accountAPI *accountAPIServer) recordSignIn(db *Account) {
+	err := accountAPI.SQLDBWrites.Create(&SignInEvent{
+		ProjectID: db.ProjectID,
+		AccountID: db.AccountID,
+	}).Error
+	if err != nil {
+		accountAPI.Logger.Errorf("failed to record sign in of account %d: %v", db.AccountID, err)
+	}
+}
+
+// period analytics results are cached for
+func analyticsCacheTTL() time.Duration {
+	return envMinutes("ANALYTICS_CACHE_MINUTES", 5)
+}
+
+func analyticsCacheKey(kind string, req *account.AnalyticsRequest) (string, error) {
+	bs, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", errs.FromProtoMarshal(err, "analytics request")
+	}
+	sum := sha256.Sum256(bs)
+	return "accountanalytics:" + kind + ":" + hex.EncodeToString(sum[:]), nil
+}
+
+// analyticsCached returns the cached result of the request or computes and caches it
+func (accountAPI *accountAPIServer) analyticsCached(
+	ctx context.Context, kind string, req *account.AnalyticsRequest, res proto.Message, compute func() error,
+) error {
+	key, err := analyticsCacheKey(kind, req)
+	if err != nil {
+		return err
+	}
+
+	bs, err := accountAPI.RedisDBReads.Get(ctx, key).Bytes()
+	switch {
+	case err == nil:
+		err = proto.Unmarshal(bs, res)
+		if err == nil {
+			return nil
+		}
+		accountAPI.Logger.Warningf("failed to unmarshal cached analytics: %v", err)
+	case err != redis.Nil:
+		accountAPI.Logger.Warningf("failed to get cached analytics: %v", err)
+	}
+
+	err = compute()
+	if err != nil {
+		return err
+	}
+
+	bs, err = proto.Marshal(res)
+	if err != nil {
+		return errs.FromProtoMarshal(err, "analytics")
+	}
+
+	err = accountAPI.RedisDBWrites.Set(ctx, key, bs, analyticsCacheTTL()).Err()
+	if err != nil {
+		accountAPI.Logger.Warningf("failed to cache analytics: %v", err)
+	}
+
+	return nil
+}
+
+// unit of sqldb.DateBucket for the time bucket
+func bucketUnit(bucket account.TimeBucket) string {
+	switch bucket {
+	case account.TimeBucket_BUCKET_WEEK:
+		return sqldb.Week
+	case account.TimeBucket_BUCKET_MONTH:
+		return sqldb.Month
+	}
+	return sqldb.Day
+}
+
+// returns the start of the bucket containing t in UTC
+func bucketStart(t time.Time, bucket account.TimeBucket) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch bucket {
+	case account.TimeBucket_BUCKET_WEEK:
+		// Weeks start on monday
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case account.TimeBucket_BUCKET_MONTH:
+		return day.AddDate(0, 0, 1-day.Day())
+	}
+	return day
+}
+
+func nextBucket(t time.Time, bucket account.TimeBucket) time.Time {
+	switch bucket {
+	case account.TimeBucket_BUCKET_WEEK:
+		return t.AddDate(0, 0, 7)
+	case account.TimeBucket_BUCKET_MONTH:
+		return t.AddDate(0, 1, 0)
+	}
+	return t.AddDate(0, 0, 1)
+}
+
+// returns the labels of buckets from start until end
+func bucketLabels(start, end time.Time, bucket account.TimeBucket) []string {
+	labels := make([]string, 0, defaultAnalyticsBuckets)
+	for t := bucketStart(start, bucket); t.Before(end); t = nextBucket(t, bucket) {
+		labels = append(labels, t.Format("2006-01-02"))
+	}
+	return labels
+}
+
+// validates the analytics request and applies its defaults and the project of the admin
+func (accountAPI *accountAPIServer) analyticsRequest(
+	ctx context.Context, req *account.AnalyticsRequest,
+) (*account.AnalyticsRequest, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("analytics request")
+	case req.StartTime < 0:
+		return nil, errs.IncorrectVal("start time")
+	case req.EndTime < 0:
+		return nil, errs.IncorrectVal("end time")
+	case req.EndTime > 0 && req.StartTime >= req.EndTime:
+		return nil, errs.WrapMessage(codes.InvalidArgument, "start time must be before end time")
+	}
+
+	// Authorization
+	payload, err := accountAPI.AuthAPI.AuthorizeGroup(ctx, accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	req = proto.Clone(req).(*account.AnalyticsRequest)
+
+	// Admins of a project only see accounts of their project
+	if payload.ProjectID != "" {
+		req.ProjectId = payload.ProjectID
+	}
+
+	if req.Bucket == account.TimeBucket_TIME_BUCKET_UNSPECIFIED {
+		req.Bucket = account.TimeBucket_BUCKET_DAY
+	}
+
+	if req.ActivitySource == account.ActivitySource_ACTIVITY_SOURCE_UNSPECIFIED {
+		req.ActivitySource = account.ActivitySource_ACTIVITY_SIGN_IN_EVENTS
+	}
+
+	return req, nil
+}
+
+// returns the request with the default time range of time series. Requests are cached before the
+// defaults are applied so that results of the default range are reused until they expire.
+func seriesRequest(req *account.AnalyticsRequest) (*account.AnalyticsRequest, error) {
+	req = proto.Clone(req).(*account.AnalyticsRequest)
+
+	if req.EndTime == 0 {
+		req.EndTime = time.Now().Unix()
+	}
+
+	if req.StartTime == 0 {
+		start := bucketStart(time.Unix(req.EndTime, 0), req.Bucket)
+		for i := 1; i < defaultAnalyticsBuckets; i++ {
+			start = bucketStart(start.Add(-time.Second), req.Bucket)
+		}
+		req.StartTime = start.Unix()
+	}
+
+	if len(bucketLabels(time.Unix(req.StartTime, 0), time.Unix(req.EndTime, 0), req.Bucket)) > maxAnalyticsBuckets {
+		return nil, errs.WrapMessagef(codes.InvalidArgument, "time range cannot have more than %d buckets", maxAnalyticsBuckets)
+	}
+
+	return req, nil
+}
+
+// applies the project and group filters of the request to a query of the accounts table
+func analyticsAccountsQuery(db *gorm.DB, req *account.AnalyticsRequest, table string) *gorm.DB {
+	if req.ProjectId != "" {
+		db = db.Where(table+".project_id=?", req.ProjectId)
+	}
+	if len(req.Groups) != 0 {
+		db = db.Where(table+".primary_group IN (?)", req.Groups)
+	}
+	return db
+}
+
+// applies the time range of the request to the column
+func analyticsTimeRange(db *gorm.DB, req *account.AnalyticsRequest, column string) *gorm.DB {
+	if req.StartTime > 0 {
+		db = db.Where(column+">=?", time.Unix(req.StartTime, 0))
+	}
+	if req.EndTime > 0 {
+		db = db.Where(column+"<?", time.Unix(req.EndTime, 0))
+	}
+	return db
+}
+
+type bucketCount struct {
+	Bucket string
+	Count  int64
+}
+
+// returns the points of every bucket in the request time range including empty buckets
+func timeSeries(req *account.AnalyticsRequest, counts []*bucketCount) *account.TimeSeries {
+	countsByBucket := make(map[string]int64, len(counts))
+	for _, c := range counts {
+		countsByBucket[c.Bucket] += c.Count
+	}
+
+	labels := bucketLabels(time.Unix(req.StartTime, 0), time.Unix(req.EndTime, 0), req.Bucket)
+
+	res := &account.TimeSeries{
+		Bucket: req.Bucket,
+		Points: make([]*account.TimeSeriesPoint, 0, len(labels)),
+	}
+	for _, label := range labels {
+		res.Points = append(res.Points, &account.TimeSeriesPoint{
+			Bucket: label,
+			Count:  countsByBucket[label],
+		})
+		res.Total += countsByBucket[label]
+	}
+	return res
+}
+
+func (accountAPI *accountAPIServer) registrationCounts(req *account.AnalyticsRequest) ([]*bucketCount, error) {
+	db := accountAPI.SQLDBReads
+	bucket := sqldb.DateBucket(db, accountsTable+".created_at", bucketUnit(req.Bucket))
+
+	counts := make([]*bucketCount, 0)
+	err := analyticsTimeRange(analyticsAccountsQuery(db.Model(&Account{}), req, accountsTable), req, accountsTable+".created_at").
+		Select(bucket + " AS bucket, COUNT(*) AS count").
+		Group("bucket").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "registrations")
+	}
+
+	return counts, nil
+}
+
+func (accountAPI *accountAPIServer) GetRegistrationStats(
+	ctx context.Context, req *account.AnalyticsRequest,
+) (*account.TimeSeries, error) {
+	req, err := accountAPI.analyticsRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &account.TimeSeries{}
+
+	err = accountAPI.analyticsCached(ctx, "registrations", req, res, func() error {
+		req, err := seriesRequest(req)
+		if err != nil {
+			return err
+		}
+		counts, err := accountAPI.registrationCounts(req)
+		if err != nil {
+			return err
+		}
+		proto.Merge(res, timeSeries(req, counts))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (accountAPI *accountAPIServer) GetActiveUsersStats(
+	ctx context.Context, req *account.AnalyticsRequest,
+) (*account.TimeSeries, error) {
+	req, err := accountAPI.analyticsRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &account.TimeSeries{}
+
+	err = accountAPI.analyticsCached(ctx, "active", req, res, func() error {
+		req, err := seriesRequest(req)
+		if err != nil {
+			return err
+		}
+
+		var (
+			db     = accountAPI.SQLDBReads
+			unit   = bucketUnit(req.Bucket)
+			counts = make([]*bucketCount, 0)
+		)
+
+		switch req.ActivitySource {
+		case account.ActivitySource_ACTIVITY_LAST_LOGIN:
+			err = analyticsTimeRange(analyticsAccountsQuery(db.Model(&Account{}), req, accountsTable), req, accountsTable+".last_login").
+				Select(sqldb.DateBucket(db, accountsTable+".last_login", unit) + " AS bucket, COUNT(*) AS count").
+				Group("bucket").
+				Scan(&counts).Error
+		default:
+			err = analyticsTimeRange(signInEventsQuery(db, req), req, signInEventsTable+".created_at").
+				Select(sqldb.DateBucket(db, signInEventsTable+".created_at", unit) + " AS bucket, COUNT(DISTINCT " + signInEventsTable + ".account_id) AS count").
+				Group("bucket").
+				Scan(&counts).Error
+		}
+		if err != nil {
+			return errs.SQLQueryFailed(err, "active users")
+		}
+
+		proto.Merge(res, timeSeries(req, counts))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// joins sign in events with accounts that are not deleted and applies the filters of the request
+func signInEventsQuery(db *gorm.DB, req *account.AnalyticsRequest) *gorm.DB {
+	db = db.Table(signInEventsTable).
+		Joins("JOIN " + accountsTable + " ON " + accountsTable + ".account_id = " + signInEventsTable + ".account_id").
+		Where(accountsTable + ".deleted_at IS NULL")
+	return analyticsAccountsQuery(db, req, accountsTable)
+}
+
+func (accountAPI *accountAPIServer) GetAccountBreakdown(
+	ctx context.Context, req *account.AnalyticsRequest,
+) (*account.AccountBreakdown, error) {
+	req, err := accountAPI.analyticsRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &account.AccountBreakdown{}
+
+	err = accountAPI.analyticsCached(ctx, "breakdown", req, res, func() error {
+		for _, breakdown := range []struct {
+			column string
+			counts *[]*account.CountByValue
+		}{
+			{"account_state", &res.ByState},
+			{"gender", &res.ByGender},
+			{"primary_group", &res.ByGroup},
+		} {
+			db := analyticsAccountsQuery(accountAPI.SQLDBReads.Model(&Account{}), req, accountsTable)
+			err := analyticsTimeRange(db, req, accountsTable+".created_at").
+				Select(breakdown.column + " AS value, COUNT(*) AS count").
+				Group(breakdown.column).
+				Order("count DESC").
+				Scan(breakdown.counts).Error
+			if err != nil {
+				return errs.SQLQueryFailed(err, breakdown.column+" breakdown")
+			}
+		}
+
+		for _, c := range res.ByState {
+			res.Total += c.Count
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (accountAPI *accountAPIServer) GetRetentionCohorts(
+	ctx context.Context, req *account.AnalyticsRequest,
+) (*account.RetentionCohorts, error) {
+	req, err := accountAPI.analyticsRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ActivitySource == account.ActivitySource_ACTIVITY_LAST_LOGIN {
+		return nil, errs.WrapMessage(codes.InvalidArgument, "retention cohorts are only computed from sign in events")
+	}
+
+	res := &account.RetentionCohorts{}
+
+	err = accountAPI.analyticsCached(ctx, "cohorts", req, res, func() error {
+		req, err := seriesRequest(req)
+		if err != nil {
+			return err
+		}
+
+		sizes, err := accountAPI.registrationCounts(req)
+		if err != nil {
+			return err
+		}
+
+		var (
+			db     = accountAPI.SQLDBReads
+			unit   = bucketUnit(req.Bucket)
+			cohort = sqldb.DateBucket(db, accountsTable+".created_at", unit)
+			bucket = sqldb.DateBucket(db, signInEventsTable+".created_at", unit)
+			counts = make([]*struct {
+				Cohort string
+				Bucket string
+				Count  int64
+			}, 0)
+		)
+
+		db = analyticsTimeRange(signInEventsQuery(db, req), req, accountsTable+".created_at")
+		err = analyticsTimeRange(db, req, signInEventsTable+".created_at").
+			Select(cohort + " AS cohort, " + bucket + " AS bucket, COUNT(DISTINCT " + signInEventsTable + ".account_id) AS count").
+			Group("cohort, bucket").
+			Scan(&counts).Error
+		if err != nil {
+			return errs.SQLQueryFailed(err, "retention cohorts")
+		}
+
+		labels := bucketLabels(time.Unix(req.StartTime, 0), time.Unix(req.EndTime, 0), req.Bucket)
+		positions := make(map[string]int, len(labels))
+		for i, label := range labels {
+			positions[label] = i
+		}
+
+		res.Bucket = req.Bucket
+		res.Cohorts = make([]*account.RetentionCohort, len(labels))
+		for i, label := range labels {
+			res.Cohorts[i] = &account.RetentionCohort{
+				Bucket:   label,
+				Retained: make([]int64, len(labels)-i),
+			}
+		}
+
+		for _, size := range sizes {
+			if i, ok := positions[size.Bucket]; ok {
+				res.Cohorts[i].Size += size.Count
+			}
+		}
+
+		for _, c := range counts {
+			i, ok := positions[c.Cohort]
+			j, ok2 := positions[c.Bucket]
+			if !ok || !ok2 || j < i {
+				continue
+			}
+			res.Cohorts[i].Retained[j-i] += c.Count
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
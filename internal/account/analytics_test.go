@@ -0,0 +1,151 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/services/pkg/api/account"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Aggregating account analytics @analytics", func() {
+	var (
+		ctx   context.Context
+		group string
+		start = time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+		day   = func(n int) time.Time { return start.AddDate(0, 0, n).Add(9 * time.Hour) }
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	weeklyRequest := func() *account.AnalyticsRequest {
+		return &account.AnalyticsRequest{
+			StartTime: start.Unix(),
+			EndTime:   start.AddDate(0, 0, 21).Unix(),
+			Bucket:    account.TimeBucket_BUCKET_WEEK,
+			Groups:    []string{group},
+		}
+	}
+
+	seriesCounts := func(res *account.TimeSeries) []int64 {
+		counts := make([]int64, 0, len(res.Points))
+		for _, point := range res.Points {
+			counts = append(counts, point.Count)
+		}
+		return counts
+	}
+
+	It("should create accounts with sign in history", func() {
+		group = "ANALYTICS_" + randomdata.RandStringRunes(10)
+
+		for _, acc := range []struct {
+			createdAt time.Time
+			state     account.AccountState
+			signIns   []time.Time
+		}{
+			{day(0), account.AccountState_ACTIVE, []time.Time{day(1), day(1), day(9)}},
+			{day(2), account.AccountState_BLOCKED, []time.Time{day(3)}},
+			{day(8), account.AccountState_ACTIVE, []time.Time{day(15)}},
+		} {
+			pb := fakeAccount()
+			pb.ProjectId = projectID
+			pb.Group = group
+			pb.State = acc.state
+
+			db, err := AccountModel(pb)
+			Expect(err).ShouldNot(HaveOccurred())
+			db.CreatedAt = acc.createdAt
+
+			Expect(AccountAPIServer.SQLDBWrites.Create(db).Error).ShouldNot(HaveOccurred())
+
+			for _, signIn := range acc.signIns {
+				err = AccountAPIServer.SQLDBWrites.Create(&SignInEvent{
+					ProjectID: projectID,
+					AccountID: db.AccountID,
+					CreatedAt: signIn,
+				}).Error
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+		}
+	})
+
+	It("should count registrations in every bucket", func() {
+		res, err := AccountAPI.GetRegistrationStats(ctx, weeklyRequest())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(res.Points[0].Bucket).Should(Equal("2021-03-01"))
+		Expect(seriesCounts(res)).Should(Equal([]int64{2, 1, 0}))
+		Expect(res.Total).Should(BeEquivalentTo(3))
+
+		req := weeklyRequest()
+		req.Bucket = account.TimeBucket_BUCKET_DAY
+		res, err = AccountAPI.GetRegistrationStats(ctx, req)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(res.Points).Should(HaveLen(21))
+		Expect(res.Points[2].Bucket).Should(Equal("2021-03-03"))
+		Expect(res.Points[2].Count).Should(BeEquivalentTo(1))
+	})
+
+	It("should count distinct active users", func() {
+		res, err := AccountAPI.GetActiveUsersStats(ctx, weeklyRequest())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(seriesCounts(res)).Should(Equal([]int64{2, 1, 1}))
+	})
+
+	It("should break accounts down by state, gender and group", func() {
+		res, err := AccountAPI.GetAccountBreakdown(ctx, &account.AnalyticsRequest{Groups: []string{group}})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(res.Total).Should(BeEquivalentTo(3))
+		Expect(res.ByState).Should(HaveLen(2))
+		Expect(res.ByState[0].Value).Should(Equal(account.AccountState_ACTIVE.String()))
+		Expect(res.ByState[0].Count).Should(BeEquivalentTo(2))
+		Expect(res.ByGroup).Should(HaveLen(1))
+		Expect(res.ByGroup[0].Value).Should(Equal(group))
+	})
+
+	It("should compute the retention of registration cohorts", func() {
+		res, err := AccountAPI.GetRetentionCohorts(ctx, weeklyRequest())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(res.Cohorts).Should(HaveLen(3))
+		Expect(res.Cohorts[0].Size).Should(BeEquivalentTo(2))
+		Expect(res.Cohorts[0].Retained).Should(Equal([]int64{2, 1, 0}))
+		Expect(res.Cohorts[1].Size).Should(BeEquivalentTo(1))
+		Expect(res.Cohorts[1].Retained).Should(Equal([]int64{0, 1}))
+		Expect(res.Cohorts[2].Retained).Should(Equal([]int64{0}))
+	})
+
+	It("should serve repeated requests from the cache", func() {
+		pb := fakeAccount()
+		pb.ProjectId = projectID
+		pb.Group = group
+		db, err := AccountModel(pb)
+		Expect(err).ShouldNot(HaveOccurred())
+		db.CreatedAt = day(1)
+		Expect(AccountAPIServer.SQLDBWrites.Create(db).Error).ShouldNot(HaveOccurred())
+
+		res, err := AccountAPI.GetRegistrationStats(ctx, weeklyRequest())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(res.Total).Should(BeEquivalentTo(3))
+	})
+
+	It("should fail for invalid requests", func() {
+		req := weeklyRequest()
+		req.StartTime = req.EndTime
+		_, err := AccountAPI.GetRegistrationStats(ctx, req)
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+
+		req = weeklyRequest()
+		req.Bucket = account.TimeBucket_BUCKET_DAY
+		req.StartTime = start.AddDate(-2, 0, 0).Unix()
+		_, err = AccountAPI.GetActiveUsersStats(ctx, req)
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+
+		req = weeklyRequest()
+		req.ActivitySource = account.ActivitySource_ACTIVITY_LAST_LOGIN
+		_, err = AccountAPI.GetRetentionCohorts(ctx, req)
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+})
@@ -0,0 +1,107 @@
+package account
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/internal/pkg/attributes"
+	"github.com/gidyon/services/internal/pkg/sqldb"
+	"github.com/gidyon/services/pkg/api/account"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func attributesError(violations []attributes.Violation) error {
+	badRequest := &errdetails.BadRequest{}
+	msg := "invalid attributes"
+	for i, v := range violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       "attributes." + v.Attribute,
+			Description: v.Description,
+		})
+		if i == 0 {
+			msg += ": " + v.Description
+		} else {
+			msg += "; " + v.Description
+		}
+	}
+
+	st, err := status.New(codes.InvalidArgument, msg).WithDetails(badRequest)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	return st.Err()
+}
+
+// validates custom attributes against the schema of the project and returns them as json
+func (accountAPI *accountAPIServer) attributesJSON(projectID string, values map[string]string) ([]byte, error) {
+	values, violations := accountAPI.AttributeSchemas.Schema(projectID).Validate(values)
+	if len(violations) != 0 {
+		return nil, attributesError(violations)
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	bs, err := json.Marshal(values)
+	if err != nil {
+		return nil, errs.FromJSONMarshal(err, "attributes")
+	}
+	return bs, nil
+}
+
+// merges updated attributes into the stored attributes of an account; empty values remove attributes
+func (accountAPI *accountAPIServer) mergeAttributesJSON(projectID string, stored []byte, updates map[string]string) ([]byte, error) {
+	values := make(map[string]string)
+	if len(stored) != 0 {
+		err := json.Unmarshal(stored, &values)
+		if err != nil {
+			return nil, errs.FromJSONUnMarshal(err, "attributes")
+		}
+	}
+	for name, val := range updates {
+		values[name] = val
+	}
+
+	bs, err := accountAPI.attributesJSON(projectID, values)
+	if err != nil {
+		return nil, err
+	}
+	if bs == nil {
+		// An empty object clears the column since nil values are not updated
+		bs = []byte("{}")
+	}
+	return bs, nil
+}
+
+func checkAttributesFilter(criteria *account.Criteria) error {
+	for name := range criteria.GetAttributes() {
+		if !attributes.ValidName(name) {
+			return errs.WrapMessagef(codes.InvalidArgument, "%q is not a valid attribute name", name)
+		}
+	}
+	return nil
+}
+
+// filters accounts whose custom attributes have the values of the criteria
+func (accountAPI *accountAPIServer) filterAttributes(db *gorm.DB, criteria *account.Criteria) *gorm.DB {
+	schema := accountAPI.AttributeSchemas.Schema(attributes.DefaultSchema)
+	if len(criteria.ProjectIds) == 1 {
+		schema = accountAPI.AttributeSchemas.Schema(criteria.ProjectIds[0])
+	}
+
+	names := make([]string, 0, len(criteria.Attributes))
+	for name := range criteria.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		expr, arg := sqldb.JSONText(db, "attributes", name)
+		db = db.Where(expr+"=?", arg, schema.Normalise(name, criteria.Attributes[name]))
+	}
+	return db
+}
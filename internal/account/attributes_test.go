@@ -0,0 +1,157 @@
+package account
+
+import (
+	"context"
+
+	"github.com/gidyon/services/internal/pkg/attributes"
+	"github.com/gidyon/services/pkg/api/account"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Custom account attributes @attributes", func() {
+	var (
+		ctx       context.Context
+		accountID string
+		schemas   attributes.Schemas
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("should set attribute schemas", func() {
+		schemas = AccountAPIServer.AttributeSchemas
+		newSchemas := attributes.Schemas{
+			projectID: {
+				"department": {Type: attributes.TypeString, Enum: []string{"engineering", "operations"}},
+				"level":      {Type: attributes.TypeInteger},
+			},
+			"attributes-required": {
+				"employee_no": {Required: true, Pattern: "^E[0-9]{4}$"},
+			},
+		}
+		for _, schema := range newSchemas {
+			Expect(schema.Compile()).ShouldNot(HaveOccurred())
+		}
+		AccountAPIServer.AttributeSchemas = newSchemas
+	})
+
+	It("should fail to create an account without a required attribute", func() {
+		_, err := AccountAPI.CreateAccount(ctx, &account.CreateAccountRequest{
+			Account:   fakeAccount(),
+			ProjectId: "attributes-required",
+		})
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+
+		details := status.Convert(err).Details()
+		Expect(details).Should(HaveLen(1))
+		badRequest, ok := details[0].(*errdetails.BadRequest)
+		Expect(ok).Should(BeTrue())
+		Expect(badRequest.FieldViolations[0].Field).Should(Equal("attributes.employee_no"))
+	})
+
+	It("should fail to create an account with unknown or invalid attributes", func() {
+		for _, attrs := range []map[string]string{
+			{"unknown": "x"},
+			{"department": "finance"},
+			{"level": "high"},
+		} {
+			pb := fakeAccount()
+			pb.Attributes = attrs
+			_, err := AccountAPI.CreateAccount(ctx, &account.CreateAccountRequest{
+				Account:   pb,
+				ProjectId: projectID,
+			})
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		}
+	})
+
+	It("should fail to create an externally signed in account with invalid attributes", func() {
+		pb := fakeAccount()
+		pb.Attributes = map[string]string{"department": "finance"}
+		_, err := AccountAPIServer.signInExternalAccount(ctx, projectID, pb)
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("should create an account with attributes", func() {
+		pb := fakeAccount()
+		pb.Attributes = map[string]string{"department": "engineering", "level": "03"}
+		createRes, err := AccountAPI.CreateAccount(ctx, &account.CreateAccountRequest{
+			Account:   pb,
+			ProjectId: projectID,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		accountID = createRes.AccountId
+
+		getRes, err := AccountAPI.GetAccount(ctx, &account.GetAccountRequest{AccountId: accountID})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(getRes.Attributes).Should(Equal(map[string]string{"department": "engineering", "level": "3"}))
+	})
+
+	It("should merge attributes on update", func() {
+		_, err := AccountAPI.UpdateAccount(ctx, &account.UpdateAccountRequest{
+			Account: &account.Account{
+				AccountId:  accountID,
+				Attributes: map[string]string{"level": "4"},
+			},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		getRes, err := AccountAPI.GetAccount(ctx, &account.GetAccountRequest{AccountId: accountID})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(getRes.Attributes).Should(Equal(map[string]string{"department": "engineering", "level": "4"}))
+	})
+
+	It("should remove attributes updated to empty values", func() {
+		_, err := AccountAPI.UpdateAccount(ctx, &account.UpdateAccountRequest{
+			Account: &account.Account{
+				AccountId:  accountID,
+				Attributes: map[string]string{"department": ""},
+			},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		getRes, err := AccountAPI.GetAccount(ctx, &account.GetAccountRequest{AccountId: accountID})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(getRes.Attributes).Should(Equal(map[string]string{"level": "4"}))
+	})
+
+	It("should fail to update attributes with invalid values", func() {
+		_, err := AccountAPI.UpdateAccount(ctx, &account.UpdateAccountRequest{
+			Account: &account.Account{
+				AccountId:  accountID,
+				Attributes: map[string]string{"department": "finance"},
+			},
+		})
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("should filter accounts by attributes", func() {
+		listRes, err := AccountAPI.ListAccounts(ctx, &account.ListAccountsRequest{
+			ListCriteria: &account.Criteria{
+				Filter:     true,
+				ProjectIds: []string{projectID},
+				Attributes: map[string]string{"level": "04"},
+			},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(listRes.Accounts).Should(HaveLen(1))
+		Expect(listRes.Accounts[0].AccountId).Should(Equal(accountID))
+	})
+
+	It("should fail to filter by an invalid attribute name", func() {
+		_, err := AccountAPI.ListAccounts(ctx, &account.ListAccountsRequest{
+			ListCriteria: &account.Criteria{
+				Filter:     true,
+				Attributes: map[string]string{"level') OR ('1": "1"},
+			},
+		})
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("should restore attribute schemas", func() {
+		AccountAPIServer.AttributeSchemas = schemas
+	})
+})
@@ -0,0 +1,219 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/pkg/api/account"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+const auditEventsTable = "account_audit_events"
+
+// errAuditAppendOnly is returned when an audit event is modified after it was recorded
+var errAuditAppendOnly = errors.New("audit events are append only")
+
+// AuditEvent records an administrative operation performed on an account
+type AuditEvent struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	ProjectID   string    `gorm:"index;type:varchar(50);not null"`
+	AdminID     string    `gorm:"index;type:varchar(50);not null"`
+	AccountID   string    `gorm:"index;type:varchar(50);not null"`
+	Operation   string    `gorm:"index;type:varchar(30);not null"`
+	Reason      string    `gorm:"type:text"`
+	PayloadDiff []byte    `gorm:"type:json"`
+	IPAddress   string    `gorm:"type:varchar(50)"`
+	UserAgent   string    `gorm:"type:varchar(256)"`
+	Device      string    `gorm:"type:varchar(100)"`
+	CreatedAt   time.Time `gorm:"index;precision:6;not null;autoCreateTime"`
+}
+
+// TableName is the name of the table
+func (*AuditEvent) TableName() string {
+	return auditEventsTable
+}
+
+// BeforeUpdate prevents audit events from being changed
+func (*AuditEvent) BeforeUpdate(*gorm.DB) error {
+	return errAuditAppendOnly
+}
+
+// BeforeDelete prevents audit events from being removed
+func (*AuditEvent) BeforeDelete(*gorm.DB) error {
+	return errAuditAppendOnly
+}
+
+// AuditEventProto converts audit event model to protobuf message
+func AuditEventProto(db *AuditEvent) *account.AuditEvent {
+	return &account.AuditEvent{
+		EventId:     fmt.Sprint(db.ID),
+		ProjectId:   db.ProjectID,
+		AdminId:     db.AdminID,
+		AccountId:   db.AccountID,
+		Operation:   account.UpdateOperation(account.UpdateOperation_value[db.Operation]),
+		Reason:      db.Reason,
+		PayloadDiff: string(db.PayloadDiff),
+		IpAddress:   db.IPAddress,
+		UserAgent:   db.UserAgent,
+		Device:      db.Device,
+		CreatedAt:   db.CreatedAt.Unix(),
+	}
+}
+
+// redacted replaces secrets in audit diffs
+const redacted = "[REDACTED]"
+
+type auditChange struct {
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
+}
+
+// auditDiff maps changed account fields to their old and new values
+type auditDiff map[string]*auditChange
+
+// records an audit event for an administrative operation using tx
+func (accountAPI *accountAPIServer) recordAuditEvent(
+	ctx context.Context, tx *gorm.DB, req *account.AdminUpdateAccountRequest, projectID string, diff auditDiff,
+) error {
+	bs, err := json.Marshal(diff)
+	if err != nil {
+		return errs.FromJSONMarshal(err, "audit diff")
+	}
+
+	device, userAgent, ipAddress := accountAPI.clientInfo(ctx)
+
+	err = tx.Create(&AuditEvent{
+		ProjectID:   projectID,
+		AdminID:     req.AdminId,
+		AccountID:   req.AccountId,
+		Operation:   req.UpdateOperation.String(),
+		Reason:      req.Reason,
+		PayloadDiff: bs,
+		IPAddress:   ipAddress,
+		UserAgent:   userAgent,
+		Device:      device,
+	}).Error
+	if err != nil {
+		return errs.FailedToSave("audit event", err)
+	}
+
+	return nil
+}
+
+// applies the filters of the request to the audit events query
+func auditEventsQuery(db *gorm.DB, req *account.ListAuditEventsRequest) *gorm.DB {
+	if req.ProjectId != "" {
+		db = db.Where("project_id=?", req.ProjectId)
+	}
+	if req.AdminId != "" {
+		db = db.Where("admin_id=?", req.AdminId)
+	}
+	if req.AccountId != "" {
+		db = db.Where("account_id=?", req.AccountId)
+	}
+	if len(req.Operations) != 0 {
+		ops := make([]string, 0, len(req.Operations))
+		for _, op := range req.Operations {
+			ops = append(ops, op.String())
+		}
+		db = db.Where("operation IN (?)", ops)
+	}
+	if req.CreatedFrom > 0 {
+		db = db.Where("created_at>=?", time.Unix(req.CreatedFrom, 0))
+	}
+	if req.CreatedUntil > 0 {
+		db = db.Where("created_at<?", time.Unix(req.CreatedUntil, 0))
+	}
+	return db
+}
+
+func (accountAPI *accountAPIServer) ListAuditEvents(
+	ctx context.Context, req *account.ListAuditEventsRequest,
+) (*account.ListAuditEventsResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("list audit events request")
+	case req.PageSize < 0:
+		return nil, errs.IncorrectVal("page size")
+	case req.CreatedUntil > 0 && req.CreatedFrom > req.CreatedUntil:
+		return nil, errs.WrapMessage(codes.InvalidArgument, "created from must be before created until")
+	}
+
+	// Authorization
+	payload, err := accountAPI.AuthAPI.AuthorizeGroup(ctx, accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Admins of a project only see events of their project
+	if payload.ProjectID != "" {
+		req.ProjectId = payload.ProjectID
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize == 0 || pageSize > defaultPageSize {
+		pageSize = defaultPageSize
+	}
+
+	var id uint
+
+	// Get last id from page token
+	pageToken := req.GetPageToken()
+	if pageToken != "" {
+		ids, err := accountAPI.PaginationHasher.DecodeInt64WithError(pageToken)
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+		}
+		id = uint(ids[0])
+	}
+
+	db := auditEventsQuery(accountAPI.SQLDBReads.Model(&AuditEvent{}), req)
+
+	var collectionCount int64
+
+	if pageToken == "" {
+		err = db.Count(&collectionCount).Error
+		if err != nil {
+			return nil, errs.SQLQueryFailed(err, "count")
+		}
+	}
+
+	if id > 0 {
+		db = db.Where("id<?", id)
+	}
+
+	dbs := make([]*AuditEvent, 0, pageSize+1)
+	err = db.Order("id DESC").Limit(int(pageSize) + 1).Find(&dbs).Error
+	if err != nil {
+		return nil, errs.FailedToFind("audit events", err)
+	}
+
+	pbs := make([]*account.AuditEvent, 0, len(dbs))
+	for i, db := range dbs {
+		if i == int(pageSize) {
+			break
+		}
+		pbs = append(pbs, AuditEventProto(db))
+		id = db.ID
+	}
+
+	var token string
+	if len(dbs) > int(pageSize) {
+		token, err = accountAPI.PaginationHasher.EncodeInt64([]int64{int64(id)})
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate page token")
+		}
+	}
+
+	return &account.ListAuditEventsResponse{
+		Events:          pbs,
+		NextPageToken:   token,
+		CollectionCount: collectionCount,
+	}, nil
+}
@@ -0,0 +1,104 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gidyon/services/pkg/api/account"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Auditing administrative operations @audit", func() {
+	var (
+		ctx       context.Context
+		adminID   string
+		accountID string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("should create an admin and an account", func() {
+		var err error
+		adminID, err = createAdmin(account.AccountState_ACTIVE)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		createRes, err := AccountAPI.CreateAccount(ctx, &account.CreateAccountRequest{
+			Account:        fakeAccount(),
+			PrivateAccount: fakePrivateAccount(),
+			ProjectId:      projectID,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		accountID = createRes.AccountId
+	})
+
+	It("should record operations performed by the admin", func() {
+		for _, op := range []account.UpdateOperation{account.UpdateOperation_ADMIN_ACTIVATE, account.UpdateOperation_BLOCK} {
+			_, err := AccountAPI.AdminUpdateAccount(ctx, &account.AdminUpdateAccountRequest{
+				AccountId:       accountID,
+				AdminId:         adminID,
+				UpdateOperation: op,
+				Reason:          "audit test",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+
+		listRes, err := AccountAPI.ListAuditEvents(ctx, &account.ListAuditEventsRequest{AccountId: accountID})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(listRes.Events).Should(HaveLen(2))
+		Expect(listRes.CollectionCount).Should(BeEquivalentTo(2))
+
+		event := listRes.Events[0]
+		Expect(event.Operation).Should(Equal(account.UpdateOperation_BLOCK))
+		Expect(event.AdminId).Should(Equal(adminID))
+		Expect(event.Reason).Should(Equal("audit test"))
+
+		diff := map[string]map[string]interface{}{}
+		Expect(json.Unmarshal([]byte(event.PayloadDiff), &diff)).ShouldNot(HaveOccurred())
+		Expect(diff["account_state"]["old"]).Should(Equal(account.AccountState_ACTIVE.String()))
+		Expect(diff["account_state"]["new"]).Should(Equal(account.AccountState_BLOCKED.String()))
+	})
+
+	It("should not record operations that fail", func() {
+		_, err := AccountAPI.AdminUpdateAccount(ctx, &account.AdminUpdateAccountRequest{
+			AccountId:       accountID,
+			AdminId:         adminID,
+			UpdateOperation: account.UpdateOperation_BLOCK,
+		})
+		Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+		listRes, err := AccountAPI.ListAuditEvents(ctx, &account.ListAuditEventsRequest{
+			AccountId:  accountID,
+			Operations: []account.UpdateOperation{account.UpdateOperation_BLOCK},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(listRes.Events).Should(HaveLen(1))
+	})
+
+	It("should paginate audit events", func() {
+		listRes, err := AccountAPI.ListAuditEvents(ctx, &account.ListAuditEventsRequest{
+			AccountId: accountID,
+			PageSize:  1,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(listRes.Events).Should(HaveLen(1))
+		Expect(listRes.NextPageToken).ShouldNot(BeZero())
+
+		listRes2, err := AccountAPI.ListAuditEvents(ctx, &account.ListAuditEventsRequest{
+			AccountId: accountID,
+			PageSize:  1,
+			PageToken: listRes.NextPageToken,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(listRes2.Events).Should(HaveLen(1))
+		Expect(listRes2.Events[0].EventId).ShouldNot(Equal(listRes.Events[0].EventId))
+		Expect(listRes2.NextPageToken).Should(BeZero())
+	})
+
+	It("should not allow audit events to be deleted", func() {
+		err := AccountAPIServer.SQLDBWrites.Delete(&AuditEvent{}, "account_id=?", accountID).Error
+		Expect(err).Should(HaveOccurred())
+	})
+})
@@ -77,6 +77,8 @@ func (accountAPI *accountAPIServer) AdminUpdateAccount(
 		return nil, errs.MissingField("admin id")
 	case req.UpdateOperation == account.UpdateOperation_UPDATE_OPERATION_INSPECIFIED:
 		return nil, errs.WrapMessage(codes.InvalidArgument, "update operation is uknown")
+	case req.UpdateOperation == account.UpdateOperation_IMPERSONATE:
+		return nil, errs.WrapMessage(codes.InvalidArgument, "use ImpersonateAccount to impersonate accounts")
 	default:
 		_, err := strconv.Atoi(req.AdminId)
 		if err != nil {
@@ -104,16 +106,25 @@ func (accountAPI *accountAPIServer) AdminUpdateAccount(
 		return nil, err
 	}
 
+	// Purged accounts no longer hold the data they are restored with
+	if req.UpdateOperation == account.UpdateOperation_UNDELETE && db.PurgedAt != nil {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "account has been purged and cannot be restored")
+	}
+
 	var (
 		fullName    = db.Names
 		messageType messaging.MessageType
 		title       string
 		data        string
 		link        string
+		diff        = auditDiff{}
+		// Sessions are revoked once the change is committed
+		revokeSessions bool
 	)
 
 	// Start a transaction
 	err = accountAPI.SQLDBWrites.Transaction(func(tx *gorm.DB) error {
+		auditTx := tx.Session(&gorm.Session{NewDB: true})
 
 		tx = tx.Model(&Account{}).Where("account_id=?", req.AccountId)
 
@@ -123,6 +134,7 @@ func (accountAPI *accountAPIServer) AdminUpdateAccount(
 			if err != nil {
 				return errs.WrapMessage(codes.Internal, "failed to delete account")
 			}
+			diff["deleted_at"] = &auditChange{Old: db.DeletedAt, New: nil}
 			messageType = messaging.MessageType_INFO
 			title = "Your Account Has Been Restored"
 			data = fmt.Sprintf(
@@ -130,11 +142,14 @@ func (accountAPI *accountAPIServer) AdminUpdateAccount(
 			)
 
 		case account.UpdateOperation_DELETE:
-			err = tx.Update("deleted_at", time.Now()).Error
+			deletedAt := time.Now()
+			err = tx.Update("deleted_at", deletedAt).Error
 			if err != nil {
 
 				return errs.WrapMessage(codes.Internal, "failed to undelete account")
 			}
+			diff["deleted_at"] = &auditChange{Old: db.DeletedAt, New: deletedAt}
+			revokeSessions = true
 			messageType = messaging.MessageType_ALERT
 			title = "Your Account Has Been Deleted"
 			data = fmt.Sprintf(
@@ -150,6 +165,7 @@ func (accountAPI *accountAPIServer) AdminUpdateAccount(
 			if err != nil {
 				return errs.WrapMessage(codes.Internal, "failed to unblock account")
 			}
+			diff["account_state"] = &auditChange{Old: db.AccountState, New: account.AccountState_ACTIVE.String()}
 			messageType = messaging.MessageType_INFO
 			title = "Your Account Has Been Unblock"
 			data = fmt.Sprintf(
@@ -165,6 +181,8 @@ func (accountAPI *accountAPIServer) AdminUpdateAccount(
 			if err != nil {
 				return errs.WrapMessage(codes.Internal, "failed to block account")
 			}
+			diff["account_state"] = &auditChange{Old: db.AccountState, New: account.AccountState_BLOCKED.String()}
+			revokeSessions = true
 			messageType = messaging.MessageType_ALERT
 			title = "Your Account Has Been Blocked"
 			data = fmt.Sprintf(
@@ -188,6 +206,7 @@ func (accountAPI *accountAPIServer) AdminUpdateAccount(
 			if err != nil {
 				return errs.WrapMessage(codes.Internal, "failed to update secondary groups")
 			}
+			diff["secondary_groups"] = &auditChange{Old: json.RawMessage(firstVal(string(db.SecondaryGroups), "null")), New: req.Payload}
 			messageType = messaging.MessageType_INFO
 			title = "Your Account Has Group Has Been Changed"
 			data = fmt.Sprintf(
@@ -207,6 +226,7 @@ func (accountAPI *accountAPIServer) AdminUpdateAccount(
 			if err != nil {
 				return errs.WrapMessage(codes.Internal, "failed to update primary group")
 			}
+			diff["primary_group"] = &auditChange{Old: db.PrimaryGroup, New: req.Payload[0]}
 			messageType = messaging.MessageType_INFO
 			title = fmt.Sprintf("Your Group Has Been Changed To %s", req.Payload[0])
 			data = fmt.Sprintf(
@@ -218,6 +238,7 @@ func (accountAPI *accountAPIServer) AdminUpdateAccount(
 			if err != nil {
 				return errs.WrapMessage(codes.Internal, "failed to activate account")
 			}
+			diff["account_state"] = &auditChange{Old: db.AccountState, New: account.AccountState_ACTIVE.String()}
 			messageType = messaging.MessageType_INFO
 			title = "Your Account Has Been Activated"
 			data = fmt.Sprintf(
@@ -228,7 +249,11 @@ func (accountAPI *accountAPIServer) AdminUpdateAccount(
 			if len(req.Payload) == 0 {
 				return errs.MissingField("payload")
 			}
-			newPass, err := genHash(req.Payload[0])
+			err = accountAPI.checkPasswordPolicy(db.ProjectID, db.AccountID, req.Payload[0])
+			if err != nil {
+				return err
+			}
+			newPass, err := accountAPI.genHash(req.Payload[0])
 			if err != nil {
 				return errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate hash password")
 			}
@@ -236,6 +261,12 @@ func (accountAPI *accountAPIServer) AdminUpdateAccount(
 			if err != nil {
 				return errs.WrapMessage(codes.Internal, "failed to update password")
 			}
+			diff["password"] = &auditChange{Old: redacted, New: redacted}
+			err = accountAPI.passwordChanged(tx.Session(&gorm.Session{NewDB: true}), db.ProjectID, db.AccountID, newPass)
+			if err != nil {
+				return err
+			}
+			revokeSessions = true
 			messageType = messaging.MessageType_INFO
 			title = "Your Account Pasword Has Been Updated"
 			data = fmt.Sprintf(
@@ -250,6 +281,7 @@ func (accountAPI *accountAPIServer) AdminUpdateAccount(
 			if err != nil {
 				return errs.WrapMessage(codes.Internal, "failed to update group id")
 			}
+			diff["group_id"] = &auditChange{Old: db.GroupID, New: req.Payload[0]}
 
 		case account.UpdateOperation_PARENT_ID:
 			if len(req.Payload) == 0 {
@@ -259,14 +291,30 @@ func (accountAPI *accountAPIServer) AdminUpdateAccount(
 			if err != nil {
 				return errs.WrapMessage(codes.Internal, "failed to update parent id")
 			}
+			diff["parent_id"] = &auditChange{Old: db.ParentID, New: req.Payload[0]}
+		}
+
+		err = accountAPI.recordAuditEvent(ctx, auditTx, req, db.ProjectID, diff)
+		if err != nil {
+			return err
 		}
 
+		if eventType, ok := adminOperationEvents[req.UpdateOperation]; ok {
+			return accountAPI.addAccountEvent(auditTx, eventType, db.AccountID, eventState(db))
+		}
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	if revokeSessions {
+		err = accountAPI.revokeAllSessions(ctx, req.AccountId, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if req.Notify {
 		// Email template
 		emailContent := templateutil.EmailData{
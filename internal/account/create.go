@@ -10,9 +10,9 @@ import (
 	"time"
 
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
-	"github.com/gidyon/micro/v2/utils/dbutil"
 	"github.com/gidyon/micro/v2/utils/errs"
 	"github.com/gidyon/micro/v2/utils/mdutil"
+	"github.com/gidyon/services/internal/pkg/sqldb"
 	"github.com/gidyon/services/pkg/api/messaging"
 
 	"github.com/gidyon/services/pkg/api/account"
@@ -20,16 +20,7 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
-func fixPhone(phone string) string {
-	phone = strings.TrimPrefix(phone, "+")
-	if strings.HasPrefix(phone, "7") {
-		phone = fmt.Sprint("254", phone)
-	}
-	if strings.HasPrefix(phone, "07") {
-		phone = fmt.Sprint("254", phone[1:])
-	}
-	return phone
-}
+const createSavePoint = "create_account"
 
 func (accountAPI *accountAPIServer) CreateAccount(
 	ctx context.Context, req *account.CreateAccountRequest,
@@ -87,8 +78,18 @@ func (accountAPI *accountAPIServer) CreateAccount(
 		return nil, err
 	}
 
-	// Fix phone number
-	db.Phone = fixPhone(db.Phone)
+	// Phones are stored in E.164
+	if db.Phone != "" {
+		db.Phone, err = accountAPI.normalisePhone(req.ProjectId, db.Phone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	db.Attributes, err = accountAPI.attributesJSON(req.ProjectId, pb.Attributes)
+	if err != nil {
+		return nil, err
+	}
 
 	accountState := account.AccountState_INACTIVE
 
@@ -130,7 +131,11 @@ func (accountAPI *accountAPIServer) CreateAccount(
 		// Store password as encrypted
 		db.SecurityAnswer = accountPrivate.GetSecurityAnswer()
 		if accountPrivate.Password != "" {
-			newPass, err := genHash(accountPrivate.GetPassword())
+			err = accountAPI.checkPasswordPolicy(req.ProjectId, 0, accountPrivate.GetPassword())
+			if err != nil {
+				return nil, err
+			}
+			newPass, err := accountAPI.genHash(accountPrivate.GetPassword())
 			if err != nil {
 				return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate hash password")
 			}
@@ -153,6 +158,13 @@ func (accountAPI *accountAPIServer) CreateAccount(
 		return nil, errs.FailedToBeginTx(err)
 	}
 
+	// Failed statements abort transactions on some databases; the upsert continues from the savepoint
+	err = tx.SavePoint(createSavePoint).Error
+	if err != nil {
+		tx.Rollback()
+		return nil, errs.SQLQueryFailed(err, "SAVEPOINT")
+	}
+
 	err = tx.Create(db).Error
 	switch {
 	case err == nil:
@@ -167,11 +179,16 @@ func (accountAPI *accountAPIServer) CreateAccount(
 			return "id", fmt.Sprint(db.AccountID)
 		}
 
-		if dbutil.IsDuplicate(err) {
+		if sqldb.IsDuplicate(err) {
 			// Upsert must be true
 			if req.GetUpdateOnly() && req.GetByAdmin() {
 				// Update account instead
-				err = accountAPI.SQLDBWrites.Table(accountsTable).Updates(db).Error
+				err = tx.RollbackTo(createSavePoint).Error
+				if err == nil {
+					err = tx.Table(accountsTable).
+						Where("project_id=? AND (email=? OR phone=?)", req.ProjectId, db.Email, db.Phone).
+						Omit("account_id", "created_at").Updates(db).Error
+				}
 				if err != nil {
 					tx.Rollback()
 					return nil, errs.FailedToUpdate("account", err)
@@ -187,6 +204,22 @@ func (accountAPI *accountAPIServer) CreateAccount(
 		return nil, errs.SQLQueryFailed(err, "CREATE")
 	}
 
+	if db.Password != "" && db.AccountID != 0 {
+		err = accountAPI.passwordChanged(tx, req.ProjectId, db.AccountID, db.Password)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if db.AccountID != 0 {
+		err = accountAPI.addAccountEvent(tx, account.AccountEventType_ACCOUNT_CREATED, db.AccountID, "")
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
 	accountID := fmt.Sprint(db.AccountID)
 
 	// Commit transaction
@@ -195,6 +228,8 @@ func (accountAPI *accountAPIServer) CreateAccount(
 		return nil, errs.FailedToCommitTx(err)
 	}
 
+	accountAPI.indexAccount(db.AccountID)
+
 	if !req.GetUpdateOnly() && req.Notify {
 		// Generate jwt token with expiration of 6 hours
 		jwtToken, err := accountAPI.AuthAPI.GenToken(ctx, &auth.Payload{
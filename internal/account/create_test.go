@@ -113,7 +113,7 @@ var _ = Describe("Creating Account @create", func() {
 		When("Creating account with valid request", func() {
 			It("should succeed when email is missing but phone is not", func() {
 				createReq.Account.Email = ""
-				createReq.Account.Phone = randomdata.PhoneNumber()[:10]
+				createReq.Account.Phone = fakePhone()
 				createRes, err := AccountAPI.CreateAccount(ctx, createReq)
 
 				Expect(err).ShouldNot(HaveOccurred())
@@ -0,0 +1,98 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/internal/pkg/outbox"
+	"github.com/gidyon/services/pkg/api/account"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gorm.io/gorm"
+)
+
+// AccountsTopic is the topic of account events
+const AccountsTopic = "accounts"
+
+// events of administrative operations that change accounts
+var adminOperationEvents = map[account.UpdateOperation]account.AccountEventType{
+	account.UpdateOperation_UNDELETE:             account.AccountEventType_ACCOUNT_STATE_CHANGED,
+	account.UpdateOperation_DELETE:               account.AccountEventType_ACCOUNT_DELETED,
+	account.UpdateOperation_UNBLOCK:              account.AccountEventType_ACCOUNT_STATE_CHANGED,
+	account.UpdateOperation_BLOCK:                account.AccountEventType_ACCOUNT_STATE_CHANGED,
+	account.UpdateOperation_CHANGE_GROUP:         account.AccountEventType_ACCOUNT_UPDATED,
+	account.UpdateOperation_CHANGE_PRIMARY_GROUP: account.AccountEventType_ACCOUNT_UPDATED,
+	account.UpdateOperation_ADMIN_ACTIVATE:       account.AccountEventType_ACCOUNT_STATE_CHANGED,
+	account.UpdateOperation_GROUP_ID:             account.AccountEventType_ACCOUNT_UPDATED,
+	account.UpdateOperation_PARENT_ID:            account.AccountEventType_ACCOUNT_UPDATED,
+}
+
+// returns the state of the account as it appears in events
+func eventState(db *Account) string {
+	if db.DeletedAt.Valid {
+		return account.AccountState_DELETED.String()
+	}
+	return db.AccountState
+}
+
+// saves an event about the account in the outbox using tx. The account is read using tx so that the event
+// describes the account as changed by tx. Events are only saved when the service has an event bus.
+func (accountAPI *accountAPIServer) addAccountEvent(
+	tx *gorm.DB, eventType account.AccountEventType, accountID uint, previousState string,
+) error {
+	if accountAPI.EventBus == nil {
+		return nil
+	}
+
+	db := &Account{}
+	err := tx.Session(&gorm.Session{NewDB: true}).Unscoped().First(db, "account_id=?", accountID).Error
+	if err != nil {
+		return errs.FailedToFind("account", err)
+	}
+
+	pb, err := AccountProto(db)
+	if err != nil {
+		return err
+	}
+	pb.State = account.AccountState(account.AccountState_value[eventState(db)])
+
+	event := &account.AccountEvent{
+		Type:       eventType,
+		AccountId:  pb.AccountId,
+		ProjectId:  db.ProjectID,
+		Account:    pb,
+		OccurredAt: time.Now().Unix(),
+	}
+
+	if previousState != "" && previousState != pb.State.String() {
+		event.PreviousState = account.AccountState(account.AccountState_value[previousState])
+	}
+
+	bs, err := protojson.Marshal(event)
+	if err != nil {
+		return errs.FromProtoMarshal(err, "account event")
+	}
+
+	err = outbox.Add(tx.Session(&gorm.Session{NewDB: true}), AccountsTopic, pb.AccountId, eventType.String(), bs)
+	if err != nil {
+		return errs.FailedToSave("account event", err)
+	}
+
+	return nil
+}
+
+// publishes account events saved in the outbox until ctx is done
+func (accountAPI *accountAPIServer) startEventRelay(ctx context.Context) error {
+	relay, err := outbox.NewRelay(&outbox.RelayOptions{
+		DB:     accountAPI.SQLDBWrites,
+		Bus:    accountAPI.EventBus,
+		Logger: accountAPI.Logger,
+	})
+	if err != nil {
+		return err
+	}
+
+	go relay.Run(ctx)
+
+	return nil
+}
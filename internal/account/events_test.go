@@ -0,0 +1,148 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/services/internal/pkg/eventbus"
+	"github.com/gidyon/services/internal/pkg/outbox"
+	"github.com/gidyon/services/pkg/api/account"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var _ = Describe("Emitting account events @events", func() {
+	var (
+		ctx       context.Context
+		cancel    context.CancelFunc
+		bus       eventbus.Bus
+		accountID string
+		adminID   string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("should set an event bus", func() {
+		Expect(outbox.Migrate(AccountAPIServer.SQLDBWrites)).ShouldNot(HaveOccurred())
+		bus = eventbus.NewMemory()
+		AccountAPIServer.EventBus = bus
+	})
+
+	It("should emit events of changes to an account", func() {
+		var err error
+		adminID, err = createAdmin(account.AccountState_ACTIVE)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		createReq := &account.CreateAccountRequest{
+			Account:        fakeAccount(),
+			PrivateAccount: fakePrivateAccount(),
+			ProjectId:      "1",
+		}
+		createReq.Account.Group = auth.DefaultUserGroup()
+		createRes, err := AccountAPI.CreateAccount(ctx, createReq)
+		Expect(err).ShouldNot(HaveOccurred())
+		accountID = createRes.AccountId
+
+		updateReq := &account.UpdateAccountRequest{Account: fakeAccount()}
+		updateReq.Account.AccountId = accountID
+		updateReq.Account.State = account.AccountState_ACCOUNT_STATE_UNSPECIFIED
+		_, err = AccountAPI.UpdateAccount(ctx, updateReq)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = AccountAPI.AdminUpdateAccount(ctx, &account.AdminUpdateAccountRequest{
+			AccountId:       accountID,
+			AdminId:         adminID,
+			UpdateOperation: account.UpdateOperation_BLOCK,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = AccountAPI.AdminUpdateAccount(ctx, &account.AdminUpdateAccountRequest{
+			AccountId:       accountID,
+			AdminId:         adminID,
+			UpdateOperation: account.UpdateOperation_UNBLOCK,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = AccountAPI.DeleteAccount(ctx, &account.DeleteAccountRequest{AccountId: accountID})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("should relay the events of the account in order", func() {
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+
+		events := make(chan *account.AccountEvent, 100)
+		go bus.Subscribe(ctx, AccountsTopic, "test", "consumer", func(ctx context.Context, msg *eventbus.Message) error {
+			if msg.Key != accountID {
+				return nil
+			}
+			event := &account.AccountEvent{}
+			err := protojson.Unmarshal(msg.Payload, event)
+			if err != nil {
+				return err
+			}
+			events <- event
+			return nil
+		})
+		// Let the subscription create its group
+		time.Sleep(20 * time.Millisecond)
+
+		relay, err := outbox.NewRelay(&outbox.RelayOptions{
+			DB:     AccountAPIServer.SQLDBWrites,
+			Bus:    bus,
+			Logger: AccountAPIServer.Logger,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		for {
+			published, err := relay.RelayBatch(ctx)
+			Expect(err).ShouldNot(HaveOccurred())
+			if published == 0 {
+				break
+			}
+		}
+
+		for _, want := range []struct {
+			eventType     account.AccountEventType
+			state         account.AccountState
+			previousState account.AccountState
+		}{
+			{eventType: account.AccountEventType_ACCOUNT_CREATED},
+			{eventType: account.AccountEventType_ACCOUNT_UPDATED},
+			{account.AccountEventType_ACCOUNT_STATE_CHANGED, account.AccountState_BLOCKED, account.AccountState_ACTIVE},
+			{account.AccountEventType_ACCOUNT_STATE_CHANGED, account.AccountState_ACTIVE, account.AccountState_BLOCKED},
+			{account.AccountEventType_ACCOUNT_DELETED, account.AccountState_DELETED, account.AccountState_ACTIVE},
+		} {
+			var event *account.AccountEvent
+			Eventually(events).Should(Receive(&event))
+			Expect(event.Type).Should(Equal(want.eventType))
+			Expect(event.AccountId).Should(Equal(accountID))
+			Expect(event.Account).ShouldNot(BeNil())
+			if want.state != account.AccountState_ACCOUNT_STATE_UNSPECIFIED {
+				Expect(event.Account.State).Should(Equal(want.state))
+				Expect(event.PreviousState).Should(Equal(want.previousState))
+			}
+		}
+	})
+
+	It("should not save events without an event bus", func() {
+		AccountAPIServer.EventBus = nil
+
+		createReq := &account.CreateAccountRequest{
+			Account:        fakeAccount(),
+			PrivateAccount: fakePrivateAccount(),
+			ProjectId:      "1",
+		}
+		createReq.Account.Group = auth.DefaultUserGroup()
+		createRes, err := AccountAPI.CreateAccount(ctx, createReq)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		var count int64
+		err = AccountAPIServer.SQLDBWrites.Model(&outbox.Message{}).
+			Where("event_key=?", createRes.AccountId).Count(&count).Error
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(count).Should(BeZero())
+	})
+})
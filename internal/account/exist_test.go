@@ -18,7 +18,7 @@ var _ = Describe("Checking whether Phone or Email @exist", func() {
 	BeforeEach(func() {
 		existReq = &account.ExistAccountRequest{
 			Email:     randomdata.Email(),
-			Phone:     randomdata.PhoneNumber(),
+			Phone:     fakePhone(),
 			ProjectId: "1",
 		}
 		ctx = context.Background()
@@ -0,0 +1,262 @@
+package account
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/internal/pkg/xlsxstream"
+	"github.com/gidyon/services/pkg/api/account"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	exportPageSize  = 1000
+	exportChunkSize = 64 << 10
+)
+
+// Account fields that can be exported and their values
+var exportFields = map[string]func(*account.Account) string{
+	"account_id":    (*account.Account).GetAccountId,
+	"project_id":    (*account.Account).GetProjectId,
+	"email":         (*account.Account).GetEmail,
+	"phone":         (*account.Account).GetPhone,
+	"names":         (*account.Account).GetNames,
+	"id_number":     (*account.Account).GetIdNumber,
+	"gender":        func(pb *account.Account) string { return pb.GetGender().String() },
+	"birth_date":    (*account.Account).GetBirthDate,
+	"nationality":   (*account.Account).GetNationality,
+	"residence":     (*account.Account).GetResidence,
+	"profession":    (*account.Account).GetProfession,
+	"group":         (*account.Account).GetGroup,
+	"group_id":      (*account.Account).GetGroupId,
+	"parent_id":     (*account.Account).GetParentId,
+	"account_state": func(pb *account.Account) string { return pb.GetState().String() },
+	"last_login":    (*account.Account).GetLastLogin,
+	"created_at":    (*account.Account).GetCreatedAt,
+}
+
+// Fields exported when the request does not select any
+var defaultExportFields = []string{
+	"account_id", "email", "phone", "names", "id_number", "group", "account_state", "last_login", "created_at",
+}
+
+func exportFieldNames() []string {
+	names := make([]string, 0, len(exportFields))
+	for name := range exportFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// exportChunker sends writes to the stream in chunks of at most exportChunkSize bytes
+type exportChunker struct {
+	stream      account.AccountAPI_ExportAccountsServer
+	contentType string
+	buf         []byte
+}
+
+func (c *exportChunker) Write(p []byte) (int, error) {
+	c.buf = append(c.buf, p...)
+	for len(c.buf) >= exportChunkSize {
+		err := c.send(c.buf[:exportChunkSize])
+		if err != nil {
+			return 0, err
+		}
+		c.buf = append(make([]byte, 0, exportChunkSize), c.buf[exportChunkSize:]...)
+	}
+	return len(p), nil
+}
+
+func (c *exportChunker) send(data []byte) error {
+	err := c.stream.Send(&httpbody.HttpBody{ContentType: c.contentType, Data: data})
+	if err != nil {
+		return errs.WrapErrorWithCodeAndMsg(codes.Unavailable, err, "failed to send export chunk")
+	}
+	return nil
+}
+
+func (c *exportChunker) Flush() error {
+	if len(c.buf) == 0 {
+		return nil
+	}
+	err := c.send(c.buf)
+	c.buf = nil
+	return err
+}
+
+// exportEncoder writes rows of an export in one format
+type exportEncoder interface {
+	WriteRow(row []string) error
+	Close() error
+}
+
+type csvExportEncoder struct {
+	w *csv.Writer
+}
+
+func (e *csvExportEncoder) WriteRow(row []string) error {
+	return e.w.Write(row)
+}
+
+func (e *csvExportEncoder) Close() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type jsonLinesExportEncoder struct {
+	w      io.Writer
+	fields []string
+	header bool
+}
+
+func (e *jsonLinesExportEncoder) WriteRow(row []string) error {
+	// The first row names the fields of the objects
+	if !e.header {
+		e.header = true
+		return nil
+	}
+
+	line := &bytes.Buffer{}
+	line.WriteByte('{')
+	for i, field := range e.fields {
+		if i > 0 {
+			line.WriteByte(',')
+		}
+		key, _ := json.Marshal(field)
+		val, err := json.Marshal(row[i])
+		if err != nil {
+			return err
+		}
+		line.Write(key)
+		line.WriteByte(':')
+		line.Write(val)
+	}
+	line.WriteString("}\n")
+
+	_, err := e.w.Write(line.Bytes())
+	return err
+}
+
+func (e *jsonLinesExportEncoder) Close() error {
+	return nil
+}
+
+func newExportEncoder(w io.Writer, format account.ExportFormat, fields []string) (exportEncoder, string, error) {
+	switch format {
+	case account.ExportFormat_EXPORT_FORMAT_UNSPECIFIED, account.ExportFormat_EXPORT_CSV:
+		return &csvExportEncoder{w: csv.NewWriter(w)}, "text/csv", nil
+	case account.ExportFormat_EXPORT_JSON_LINES:
+		return &jsonLinesExportEncoder{w: w, fields: fields}, "application/x-ndjson", nil
+	case account.ExportFormat_EXPORT_XLSX:
+		xw, err := xlsxstream.NewWriter(w, "Accounts")
+		if err != nil {
+			return nil, "", err
+		}
+		return xw, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", nil
+	}
+	return nil, "", errs.WrapMessagef(codes.InvalidArgument, "unknown export format %s", format)
+}
+
+func exportExtension(format account.ExportFormat) string {
+	switch format {
+	case account.ExportFormat_EXPORT_JSON_LINES:
+		return "jsonl"
+	case account.ExportFormat_EXPORT_XLSX:
+		return "xlsx"
+	}
+	return "csv"
+}
+
+func (accountAPI *accountAPIServer) ExportAccounts(
+	req *account.ExportAccountsRequest, stream account.AccountAPI_ExportAccountsServer,
+) error {
+	// Validation
+	if req == nil {
+		return errs.NilObject("export accounts request")
+	}
+
+	fields := req.Fields
+	if len(fields) == 0 {
+		fields = defaultExportFields
+	}
+	for _, field := range fields {
+		if exportFields[field] == nil {
+			return errs.WrapMessagef(
+				codes.InvalidArgument, "unknown export field %s; must be one of %s", field, strings.Join(exportFieldNames(), ", "),
+			)
+		}
+	}
+
+	ctx := stream.Context()
+
+	// Authorization
+	_, err := accountAPI.AuthAPI.AuthorizeGroup(ctx, accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return err
+	}
+
+	chunker := &exportChunker{stream: stream}
+
+	enc, contentType, err := newExportEncoder(chunker, req.Format, fields)
+	if err != nil {
+		return err
+	}
+	chunker.contentType = contentType
+
+	err = stream.SendHeader(metadata.Pairs(
+		"content-disposition", fmt.Sprintf("attachment; filename=accounts.%s", exportExtension(req.Format)),
+	))
+	if err != nil {
+		return errs.WrapErrorWithCodeAndMsg(codes.Unavailable, err, "failed to send header")
+	}
+
+	err = enc.WriteRow(fields)
+	if err != nil {
+		return errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to encode header")
+	}
+
+	// Accounts are read a page at a time so that memory does not grow with the export
+	row := make([]string, len(fields))
+	pageToken := ""
+	for {
+		listRes, err := accountAPI.ListAccounts(ctx, &account.ListAccountsRequest{
+			PageToken:    pageToken,
+			PageSize:     exportPageSize,
+			ListCriteria: req.Criteria,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, pb := range listRes.Accounts {
+			for i, field := range fields {
+				row[i] = exportFields[field](pb)
+			}
+			err = enc.WriteRow(row)
+			if err != nil {
+				return errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to encode account")
+			}
+		}
+
+		pageToken = listRes.NextPageToken
+		if pageToken == "" {
+			break
+		}
+	}
+
+	err = enc.Close()
+	if err != nil {
+		return errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to finish export")
+	}
+
+	return chunker.Flush()
+}
@@ -0,0 +1,125 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+
+	"github.com/360EntSecGroup-Skylar/excelize"
+	"github.com/gidyon/services/pkg/api/account"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// exportStream collects chunks of an export
+type exportStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+	data   bytes.Buffer
+}
+
+func (s *exportStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *exportStream) SendHeader(md metadata.MD) error {
+	s.header = md
+	return nil
+}
+
+func (s *exportStream) Send(body *httpbody.HttpBody) error {
+	s.data.Write(body.Data)
+	return nil
+}
+
+var _ = Describe("Exporting accounts @export", func() {
+	var (
+		emails   []string
+		criteria = func() *account.Criteria {
+			return &account.Criteria{Emails: emails}
+		}
+	)
+
+	It("should create accounts to export", func() {
+		for i := 0; i < 3; i++ {
+			pb := fakeAccount()
+			createRes, err := AccountAPI.CreateAccount(context.Background(), &account.CreateAccountRequest{
+				Account:   pb,
+				ProjectId: projectID,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(createRes.AccountId).ShouldNot(BeZero())
+			emails = append(emails, pb.Email)
+		}
+	})
+
+	It("should fail for unknown fields", func() {
+		stream := &exportStream{ctx: context.Background()}
+		err := AccountAPI.ExportAccounts(&account.ExportAccountsRequest{
+			Criteria: criteria(),
+			Fields:   []string{"email", "password"},
+		}, stream)
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("should export selected fields as csv", func() {
+		stream := &exportStream{ctx: context.Background()}
+		err := AccountAPI.ExportAccounts(&account.ExportAccountsRequest{
+			Criteria: criteria(),
+			Fields:   []string{"email", "names"},
+		}, stream)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(stream.header.Get("content-disposition")).Should(ConsistOf("attachment; filename=accounts.csv"))
+
+		rows, err := csv.NewReader(&stream.data).ReadAll()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(rows).Should(HaveLen(len(emails) + 1))
+		Expect(rows[0]).Should(Equal([]string{"email", "names"}))
+
+		exported := make([]string, 0, len(emails))
+		for _, row := range rows[1:] {
+			exported = append(exported, row[0])
+		}
+		Expect(exported).Should(ConsistOf(emails))
+	})
+
+	It("should export accounts as json lines", func() {
+		stream := &exportStream{ctx: context.Background()}
+		err := AccountAPI.ExportAccounts(&account.ExportAccountsRequest{
+			Criteria: criteria(),
+			Format:   account.ExportFormat_EXPORT_JSON_LINES,
+			Fields:   []string{"account_id", "email"},
+		}, stream)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		lines := strings.Split(strings.TrimSpace(stream.data.String()), "\n")
+		Expect(lines).Should(HaveLen(len(emails)))
+		for _, line := range lines {
+			obj := map[string]string{}
+			Expect(json.Unmarshal([]byte(line), &obj)).ShouldNot(HaveOccurred())
+			Expect(obj).Should(HaveKey("account_id"))
+			Expect(emails).Should(ContainElement(obj["email"]))
+		}
+	})
+
+	It("should export accounts as xlsx", func() {
+		stream := &exportStream{ctx: context.Background()}
+		err := AccountAPI.ExportAccounts(&account.ExportAccountsRequest{
+			Criteria: criteria(),
+			Format:   account.ExportFormat_EXPORT_XLSX,
+		}, stream)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		xlsxFile, err := excelize.OpenReader(&stream.data)
+		Expect(err).ShouldNot(HaveOccurred())
+		rows := xlsxFile.GetRows(xlsxFile.GetSheetName(1))
+		Expect(rows).Should(HaveLen(len(emails) + 1))
+		Expect(rows[0]).Should(Equal(defaultExportFields))
+	})
+})
@@ -8,12 +8,9 @@ import (
 	"github.com/gidyon/services/pkg/api/account"
 )
 
+// creates a fake kenyan mobile number in E.164
 func fakePhone() string {
-	phone := randomdata.PhoneNumber()
-	if len(phone) > 10 {
-		phone = phone[:10]
-	}
-	return fmt.Sprintf("%s%d", phone, randomdata.Number(1000, 9999))
+	return fmt.Sprintf("+2547%08d", randomdata.Number(10000000, 99999999))
 }
 
 func createAdmin(accountState account.AccountState) (string, error) {
@@ -0,0 +1,327 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/internal/pkg/idp"
+	"github.com/gidyon/services/pkg/api/account"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const identitiesTable = "account_identities"
+
+// LinkedIdentity is an identity of an external provider that signs in to an account
+type LinkedIdentity struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	ProjectID string    `gorm:"uniqueIndex:idx_identity_subject;type:varchar(50);not null"`
+	Provider  string    `gorm:"uniqueIndex:idx_identity_subject;type:varchar(50);not null"`
+	Subject   string    `gorm:"uniqueIndex:idx_identity_subject;type:varchar(191);not null"`
+	AccountID uint      `gorm:"index;not null"`
+	Email     string    `gorm:"type:varchar(50)"`
+	LinkedAt  time.Time `gorm:"precision:6;not null;autoCreateTime"`
+}
+
+// TableName is the name of the table
+func (*LinkedIdentity) TableName() string {
+	return identitiesTable
+}
+
+func linkedIdentityProto(db *LinkedIdentity) *account.LinkedIdentity {
+	return &account.LinkedIdentity{
+		IdentityId: fmt.Sprint(db.ID),
+		AccountId:  fmt.Sprint(db.AccountID),
+		Provider:   db.Provider,
+		Subject:    db.Subject,
+		Email:      db.Email,
+		LinkedAt:   db.LinkedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// verifies a token of a firebase or registered identity provider
+func (accountAPI *accountAPIServer) verifyIdentity(
+	ctx context.Context, projectID, provider, token string,
+) (*idp.Identity, error) {
+	if provider == "" || provider == idp.Firebase {
+		if accountAPI.FirebaseAuth == nil {
+			return nil, errs.WrapMessage(codes.Unavailable, "firebase auth not available")
+		}
+		fToken, err := accountAPI.FirebaseAuth.VerifyIDToken(ctx, token)
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to verify firebase ID token")
+		}
+		identity := &idp.Identity{Provider: idp.Firebase, Subject: fToken.UID}
+		identity.Email, _ = fToken.Claims["email"].(string)
+		identity.EmailVerified, _ = fToken.Claims["email_verified"].(bool)
+		// Firebase only sets phone numbers verified by phone sign in
+		identity.Phone, _ = fToken.Claims["phone_number"].(string)
+		identity.PhoneVerified = identity.Phone != ""
+		return identity, nil
+	}
+
+	p, err := accountAPI.IdentityProviders.Provider(projectID, provider)
+	if err != nil {
+		return nil, errs.WrapMessagef(codes.InvalidArgument, "identity provider %s not enabled for project", provider)
+	}
+
+	identity, err := p.Verify(ctx, token)
+	switch {
+	case err == nil:
+		return identity, nil
+	case errors.Is(err, idp.ErrInvalidToken):
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Unauthenticated, err, "failed to verify token")
+	default:
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Unavailable, err, "identity provider not available")
+	}
+}
+
+// returns the identity linked to an account or nil
+func (accountAPI *accountAPIServer) getLinkedIdentity(projectID string, identity *idp.Identity) (*LinkedIdentity, error) {
+	if identity.Subject == "" {
+		return nil, nil
+	}
+	db := &LinkedIdentity{}
+	err := accountAPI.SQLDBWrites.First(
+		db, "project_id=? AND provider=? AND subject=?", projectID, identity.Provider, identity.Subject,
+	).Error
+	switch {
+	case err == nil:
+		return db, nil
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, nil
+	default:
+		return nil, errs.FailedToFind("linked identity", err)
+	}
+}
+
+// links identity to the account unless it is already linked
+func (accountAPI *accountAPIServer) linkIdentity(projectID string, accountID uint, identity *idp.Identity) (*LinkedIdentity, error) {
+	if identity.Subject == "" {
+		return nil, errs.WrapMessage(codes.InvalidArgument, "identity provider did not return a subject")
+	}
+
+	linked, err := accountAPI.getLinkedIdentity(projectID, identity)
+	switch {
+	case err != nil:
+		return nil, err
+	case linked != nil && linked.AccountID != accountID:
+		return nil, errs.WrapMessage(codes.AlreadyExists, "identity is linked to another account")
+	case linked != nil:
+		return linked, nil
+	}
+
+	linked = &LinkedIdentity{
+		ProjectID: projectID,
+		Provider:  identity.Provider,
+		Subject:   identity.Subject,
+		AccountID: accountID,
+		Email:     identity.Email,
+	}
+	err = accountAPI.SQLDBWrites.Create(linked).Error
+	if err != nil {
+		return nil, errs.FailedToSave("linked identity", err)
+	}
+
+	return linked, nil
+}
+
+// signs in the account that an identity resolves to, linking identities found through their email or phone
+func (accountAPI *accountAPIServer) signInIdentity(
+	ctx context.Context, projectID string, identity *idp.Identity, pb *account.Account,
+) (*account.SignInResponse, error) {
+	linked, err := accountAPI.getLinkedIdentity(projectID, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	if linked != nil {
+		db := &Account{}
+		err = accountAPI.SQLDBWrites.First(db, "account_id=?", linked.AccountID).Error
+		switch {
+		case err == nil:
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, errs.DoesNotExist("account", fmt.Sprint(linked.AccountID))
+		default:
+			return nil, errs.FailedToFind("account", err)
+		}
+		if db.AccountState == account.AccountState_BLOCKED.String() {
+			return nil, errs.WrapMessage(codes.FailedPrecondition, "account blocked")
+		}
+		return accountAPI.signInSession(ctx, db, "")
+	}
+
+	signInRes, err := accountAPI.signInExternalAccount(ctx, projectID, pb)
+	if err != nil {
+		return nil, err
+	}
+
+	if identity.Subject != "" {
+		accountID, err := strconv.ParseUint(signInRes.AccountId, 10, 64)
+		if err != nil {
+			return nil, errs.IncorrectVal("account id")
+		}
+		_, err = accountAPI.linkIdentity(projectID, uint(accountID), identity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return signInRes, nil
+}
+
+func (accountAPI *accountAPIServer) LinkIdentity(
+	ctx context.Context, req *account.LinkIdentityRequest,
+) (*account.LinkedIdentity, error) {
+	// Validation
+	var err error
+	switch {
+	case req == nil:
+		err = errs.NilObject("link identity request")
+	case req.AccountId == "":
+		err = errs.MissingField("account id")
+	case req.Provider == "":
+		err = errs.MissingField("provider")
+	case req.AuthToken == "":
+		err = errs.MissingField("auth token")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := denyImpersonation(ctx); err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	_, err = accountAPI.AuthAPI.AuthorizeActorOrGroup(ctx, req.AccountId, accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	db := &Account{}
+	err = accountAPI.SQLDBWrites.Select("account_id,project_id").First(db, "account_id=?", req.AccountId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("account", req.AccountId)
+	default:
+		return nil, errs.FailedToFind("account", err)
+	}
+
+	identity, err := accountAPI.verifyIdentity(ctx, db.ProjectID, req.Provider, req.AuthToken)
+	if err != nil {
+		return nil, err
+	}
+
+	linked, err := accountAPI.linkIdentity(db.ProjectID, db.AccountID, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	return linkedIdentityProto(linked), nil
+}
+
+func (accountAPI *accountAPIServer) UnlinkIdentity(
+	ctx context.Context, req *account.UnlinkIdentityRequest,
+) (*empty.Empty, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("unlink identity request")
+	case req.AccountId == "":
+		return nil, errs.MissingField("account id")
+	case req.IdentityId == "":
+		return nil, errs.MissingField("identity id")
+	}
+
+	if err := denyImpersonation(ctx); err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	_, err := accountAPI.AuthAPI.AuthorizeActorOrGroup(ctx, req.AccountId, accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = accountAPI.SQLDBWrites.Transaction(func(tx *gorm.DB) error {
+		db := &Account{}
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("account_id,password").
+			First(db, "account_id=?", req.AccountId).Error
+		switch {
+		case err == nil:
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return errs.DoesNotExist("account", req.AccountId)
+		default:
+			return errs.FailedToFind("account", err)
+		}
+
+		var linked int64
+		err = tx.Model(&LinkedIdentity{}).Where("account_id=?", req.AccountId).Count(&linked).Error
+		if err != nil {
+			return errs.FailedToFind("linked identities", err)
+		}
+
+		// Accounts must keep a password or another identity to sign in with
+		if db.Password == "" && linked <= 1 {
+			return errs.WrapMessage(
+				codes.FailedPrecondition, "cannot unlink the last sign in method; set a password or link another identity first",
+			)
+		}
+
+		res := tx.Delete(&LinkedIdentity{}, "id=? AND account_id=?", req.IdentityId, req.AccountId)
+		switch {
+		case res.Error != nil:
+			return errs.FailedToDelete("linked identity", res.Error)
+		case res.RowsAffected == 0:
+			return errs.DoesNotExist("linked identity", req.IdentityId)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (accountAPI *accountAPIServer) ListIdentities(
+	ctx context.Context, req *account.ListIdentitiesRequest,
+) (*account.ListIdentitiesResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("list identities request")
+	case req.AccountId == "":
+		return nil, errs.MissingField("account id")
+	}
+
+	// Authorization
+	_, err := accountAPI.AuthAPI.AuthorizeActorOrGroup(ctx, req.AccountId, accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	dbs := make([]*LinkedIdentity, 0)
+	err = accountAPI.SQLDBReads.Where("account_id=?", req.AccountId).Order("id").Find(&dbs).Error
+	if err != nil {
+		return nil, errs.FailedToFind("linked identities", err)
+	}
+
+	pbs := make([]*account.LinkedIdentity, 0, len(dbs))
+	for _, db := range dbs {
+		pbs = append(pbs, linkedIdentityProto(db))
+	}
+
+	return &account.ListIdentitiesResponse{
+		Identities: pbs,
+	}, nil
+}
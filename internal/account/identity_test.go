@@ -0,0 +1,114 @@
+package account
+
+import (
+	"context"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/services/internal/pkg/idp"
+	"github.com/gidyon/services/internal/pkg/idp/idptest"
+	"github.com/gidyon/services/pkg/api/account"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Linking identities @identity", func() {
+	var (
+		ctx                  context.Context
+		issuer               *idptest.Issuer
+		accountID            string
+		firstID, secondToken string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("should register a stub provider for the project", func() {
+		var err error
+		issuer, err = idptest.NewIssuer()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		AccountAPIServer.IdentityProviders, err = idp.NewRegistry(map[string][]*idp.Config{
+			projectID: {{Name: "stub", Type: idp.TypeOIDC, Issuer: issuer.URL, ClientIDs: []string{"app"}}},
+		}, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("should link the identity used to create an account", func() {
+		token, err := issuer.IDToken(randomdata.RandStringRunes(16), "app", map[string]interface{}{
+			"email": randomdata.Email(), "email_verified": true, "name": "Jane Doe",
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		signInRes, err := AccountAPI.SignInExternal(ctx, &account.SignInExternalRequest{
+			AuthToken: token,
+			ProjectId: projectID,
+			Provider:  "stub",
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		accountID = signInRes.AccountId
+
+		listRes, err := AccountAPI.ListIdentities(ctx, &account.ListIdentitiesRequest{AccountId: accountID})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(listRes.Identities).Should(HaveLen(1))
+		Expect(listRes.Identities[0].Provider).Should(Equal("stub"))
+		firstID = listRes.Identities[0].IdentityId
+	})
+
+	It("should refuse to unlink the only sign in method", func() {
+		_, err := AccountAPI.UnlinkIdentity(ctx, &account.UnlinkIdentityRequest{
+			AccountId:  accountID,
+			IdentityId: firstID,
+		})
+		Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+	})
+
+	It("should link another identity", func() {
+		var err error
+		secondToken, err = issuer.IDToken(randomdata.RandStringRunes(16), "app", map[string]interface{}{
+			"email": randomdata.Email(), "email_verified": true, "name": "Jane Doe",
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		linked, err := AccountAPI.LinkIdentity(ctx, &account.LinkIdentityRequest{
+			AccountId: accountID,
+			Provider:  "stub",
+			AuthToken: secondToken,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(linked.AccountId).Should(Equal(accountID))
+	})
+
+	It("should sign in to the same account with the other identity", func() {
+		signInRes, err := AccountAPI.SignInExternal(ctx, &account.SignInExternalRequest{
+			AuthToken: secondToken,
+			ProjectId: projectID,
+			Provider:  "stub",
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(signInRes.AccountId).Should(Equal(accountID))
+	})
+
+	It("should unlink an identity when another remains", func() {
+		_, err := AccountAPI.UnlinkIdentity(ctx, &account.UnlinkIdentityRequest{
+			AccountId:  accountID,
+			IdentityId: firstID,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		listRes, err := AccountAPI.ListIdentities(ctx, &account.ListIdentitiesRequest{AccountId: accountID})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(listRes.Identities).Should(HaveLen(1))
+
+		_, err = AccountAPI.UnlinkIdentity(ctx, &account.UnlinkIdentityRequest{
+			AccountId:  accountID,
+			IdentityId: listRes.Identities[0].IdentityId,
+		})
+		Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+	})
+
+	It("should stop the issuer", func() {
+		issuer.Close()
+		AccountAPIServer.IdentityProviders = nil
+	})
+})
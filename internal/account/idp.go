@@ -0,0 +1,57 @@
+package account
+
+import (
+	"context"
+
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/pkg/api/account"
+	"google.golang.org/grpc/codes"
+)
+
+// signs in with a token of an identity provider enabled for the project
+func (accountAPI *accountAPIServer) signInIdentityProvider(
+	ctx context.Context, signInReq *account.SignInExternalRequest,
+) (*account.SignInResponse, error) {
+	// Validation
+	var err error
+	switch {
+	case signInReq.ProjectId == "":
+		err = errs.MissingField("project id")
+	case signInReq.AuthToken == "":
+		err = errs.MissingField("auth token")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	identity, err := accountAPI.verifyIdentity(ctx, signInReq.ProjectId, signInReq.Provider, signInReq.AuthToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only verified contacts identify accounts
+	pb := &account.Account{
+		Names:      identity.Names,
+		ProfileUrl: identity.Picture,
+		Group:      auth.DefaultUserGroup(),
+	}
+	if identity.EmailVerified {
+		pb.Email = identity.Email
+	}
+	if identity.PhoneVerified {
+		pb.Phone = identity.Phone
+	}
+	if pb.Names == "" && signInReq.Account != nil {
+		pb.Names = signInReq.Account.Names
+	}
+
+	switch {
+	case pb.Email == "" && pb.Phone == "":
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "identity provider did not return a verified email or phone")
+	case pb.Names == "":
+		return nil, errs.MissingField("names")
+	}
+
+	return accountAPI.signInIdentity(ctx, signInReq.ProjectId, identity, pb)
+}
@@ -0,0 +1,99 @@
+package account
+
+import (
+	"context"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/services/internal/pkg/idp"
+	"github.com/gidyon/services/internal/pkg/idp/idptest"
+	"github.com/gidyon/services/pkg/api/account"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Signing in with identity providers @idp", func() {
+	var (
+		ctx    context.Context
+		issuer *idptest.Issuer
+		email  = randomdata.Email()
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("should register a stub provider for the project", func() {
+		var err error
+		issuer, err = idptest.NewIssuer()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		AccountAPIServer.IdentityProviders, err = idp.NewRegistry(map[string][]*idp.Config{
+			projectID: {{Name: "stub", Type: idp.TypeOIDC, Issuer: issuer.URL, ClientIDs: []string{"app"}}},
+		}, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("should fail for a provider not enabled for the project", func() {
+		_, err := AccountAPI.SignInExternal(ctx, &account.SignInExternalRequest{
+			AuthToken: "token",
+			ProjectId: projectID,
+			Provider:  "unknown",
+		})
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("should fail for a token issued to another client", func() {
+		token, err := issuer.IDToken("user-1", "other-app", map[string]interface{}{
+			"email": email, "email_verified": true, "name": "Jane Doe",
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = AccountAPI.SignInExternal(ctx, &account.SignInExternalRequest{
+			AuthToken: token,
+			ProjectId: projectID,
+			Provider:  "stub",
+		})
+		Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+	})
+
+	It("should fail when the email is not verified", func() {
+		token, err := issuer.IDToken("user-1", "app", map[string]interface{}{
+			"email": email, "name": "Jane Doe",
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = AccountAPI.SignInExternal(ctx, &account.SignInExternalRequest{
+			AuthToken: token,
+			ProjectId: projectID,
+			Provider:  "stub",
+		})
+		Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+	})
+
+	It("should create and then sign in the same account", func() {
+		token, err := issuer.IDToken("user-1", "app", map[string]interface{}{
+			"email": email, "email_verified": true, "name": "Jane Doe",
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		req := &account.SignInExternalRequest{
+			AuthToken: token,
+			ProjectId: projectID,
+			Provider:  "stub",
+		}
+
+		first, err := AccountAPI.SignInExternal(ctx, req)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(first.Account.Email).Should(Equal(email))
+		Expect(first.Account.Names).Should(Equal("Jane Doe"))
+
+		second, err := AccountAPI.SignInExternal(ctx, req)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(second.AccountId).Should(Equal(first.AccountId))
+	})
+
+	It("should stop the issuer", func() {
+		issuer.Close()
+		AccountAPIServer.IdentityProviders = nil
+	})
+})
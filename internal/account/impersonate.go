@@ -0,0 +1,299 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/pkg/api/account"
+	redis "github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	sessionImpersonator = "impersonator"
+
+	// Roles added to impersonation tokens naming the admin and the session of the impersonation
+	impersonatorRolePrefix         = "impersonator:"
+	impersonationSessionRolePrefix = "impersonation_session:"
+)
+
+// lifetime of tokens issued to impersonate an account. Other services accept the tokens until they expire,
+// so it is kept short.
+func impersonationTokenLifetime() time.Duration {
+	return envMinutes("IMPERSONATION_TOKEN_MINUTES", 5)
+}
+
+// roles that mark a token as impersonating the account
+func impersonationRoles(impersonatorID, sessionID string) []string {
+	return []string{impersonatorRolePrefix + impersonatorID, impersonationSessionRolePrefix + sessionID}
+}
+
+// returns the bearer token of the request
+func requestToken(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	parts := strings.SplitN(firstMD(md, "authorization"), " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") {
+		return ""
+	}
+	return parts[1]
+}
+
+// returns the admin impersonating the account in the token of the request. The token is not verified again
+// since the auth interceptor already did; the claim is only used to deny operations.
+func impersonator(ctx context.Context) string {
+	impersonatorID, _ := tokenImpersonation(requestToken(ctx))
+	return impersonatorID
+}
+
+// returns the admin impersonating the account in a token that has already been verified
+func tokenImpersonator(token string) string {
+	impersonatorID, _ := tokenImpersonation(token)
+	return impersonatorID
+}
+
+// returns the admin and session of an impersonation token; both are empty for other tokens
+func tokenImpersonation(token string) (impersonatorID, sessionID string) {
+	if token == "" {
+		return "", ""
+	}
+
+	claims := &auth.Claims{}
+	_, _, err := new(jwt.Parser).ParseUnverified(token, claims)
+	if err != nil || claims.Payload == nil {
+		return "", ""
+	}
+
+	for _, role := range claims.Roles {
+		switch {
+		case strings.HasPrefix(role, impersonatorRolePrefix):
+			impersonatorID = strings.TrimPrefix(role, impersonatorRolePrefix)
+		case strings.HasPrefix(role, impersonationSessionRolePrefix):
+			sessionID = strings.TrimPrefix(role, impersonationSessionRolePrefix)
+		}
+	}
+
+	return impersonatorID, sessionID
+}
+
+// fails requests made with an impersonation token whose session has been revoked
+func (accountAPI *accountAPIServer) checkImpersonationSession(ctx context.Context) error {
+	impersonatorID, sessionID := tokenImpersonation(requestToken(ctx))
+	if impersonatorID == "" {
+		return nil
+	}
+	if sessionID == "" {
+		return errs.WrapMessage(codes.Unauthenticated, "impersonation session has ended")
+	}
+
+	sess, err := accountAPI.getSession(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if sess == nil || sess.Impersonator != impersonatorID {
+		return errs.WrapMessage(codes.Unauthenticated, "impersonation session has ended")
+	}
+
+	return nil
+}
+
+// fails operations that only the owner of the account may perform when the request impersonates the account
+func denyImpersonation(ctx context.Context) error {
+	if impersonatorID := impersonator(ctx); impersonatorID != "" {
+		return errs.WrapMessagef(
+			codes.PermissionDenied, "operation not allowed while impersonating the account as admin %s", impersonatorID,
+		)
+	}
+	return nil
+}
+
+// creates a session for the impersonation that ends with its token; the session has no refresh token
+func (accountAPI *accountAPIServer) createImpersonationSession(
+	ctx context.Context, accountID uint, impersonatorID string, ttl time.Duration,
+) (*session, error) {
+	var (
+		device, userAgent, ipAddress = accountAPI.clientInfo(ctx)
+		now                          = time.Now().Unix()
+	)
+
+	sess := &session{
+		ID:           uuid.New().String(),
+		AccountID:    fmt.Sprint(accountID),
+		Device:       device,
+		UserAgent:    userAgent,
+		IPAddress:    ipAddress,
+		CreatedAt:    now,
+		LastUsedAt:   now,
+		Impersonator: impersonatorID,
+	}
+
+	_, err := accountAPI.RedisDBWrites.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, sessionKey(sess.ID), map[string]interface{}{
+			sessionAccountID:    sess.AccountID,
+			sessionDevice:       sess.Device,
+			sessionUserAgent:    sess.UserAgent,
+			sessionIPAddress:    sess.IPAddress,
+			sessionCreatedAt:    sess.CreatedAt,
+			sessionLastUsedAt:   sess.LastUsedAt,
+			sessionImpersonator: sess.Impersonator,
+		})
+		pipe.Expire(ctx, sessionKey(sess.ID), ttl)
+		pipe.SAdd(ctx, accountSessionsKey(sess.AccountID), sess.ID)
+		pipe.Expire(ctx, accountSessionsKey(sess.AccountID), refreshTokenAbsoluteLifetime())
+		return nil
+	})
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to create session")
+	}
+
+	return sess, nil
+}
+
+func (accountAPI *accountAPIServer) ImpersonateAccount(
+	ctx context.Context, req *account.ImpersonateAccountRequest,
+) (*account.SignInResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("impersonate account request")
+	case req.AccountId == "":
+		return nil, errs.MissingField("account id")
+	case req.AdminId == "":
+		return nil, errs.MissingField("admin id")
+	case req.Reason == "":
+		return nil, errs.MissingField("reason")
+	case req.AccountId == req.AdminId:
+		return nil, errs.WrapMessage(codes.InvalidArgument, "admin cannot impersonate their own account")
+	default:
+		_, err := strconv.Atoi(req.AdminId)
+		if err != nil {
+			return nil, errs.WrapMessage(codes.InvalidArgument, "incorrect admin id")
+		}
+		_, err = strconv.Atoi(req.AccountId)
+		if err != nil {
+			return nil, errs.WrapMessage(codes.InvalidArgument, "incorrect account id")
+		}
+	}
+
+	// Impersonation cannot be chained
+	err := denyImpersonation(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorize the admin
+	payload, err := accountAPI.AuthAPI.AuthorizeAdminStrict(ctx, req.AdminId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = accountAPI.validateAdmin(ctx, req.AdminId)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := accountAPI.getUser(ctx, req.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Admins of a project only impersonate accounts of their project
+	if payload.ProjectID != "" && payload.ProjectID != db.ProjectID {
+		return nil, errs.WrapMessage(codes.PermissionDenied, "account belongs to another project")
+	}
+
+	switch {
+	case db.DeletedAt.Valid:
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "account is deleted")
+	case db.AccountState == account.AccountState_BLOCKED.String():
+		return nil, errs.WrapMessage(codes.PermissionDenied, "account is blocked")
+	}
+
+	// Secondary groups
+	secondaryGroups := make([]string, 0)
+	if len(db.SecondaryGroups) != 0 {
+		err = json.Unmarshal(db.SecondaryGroups, &secondaryGroups)
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to json unmarshal")
+		}
+	}
+
+	// Impersonating admins would escalate privileges; tokens carry secondary groups as roles
+	for _, group := range append([]string{db.PrimaryGroup}, secondaryGroups...) {
+		for _, adminGroup := range accountAPI.AuthAPI.AdminGroups() {
+			if strings.EqualFold(group, adminGroup) {
+				return nil, errs.WrapMessage(codes.PermissionDenied, "admin accounts cannot be impersonated")
+			}
+		}
+	}
+
+	var (
+		lifetime = impersonationTokenLifetime()
+		expires  = time.Now().Add(lifetime)
+	)
+
+	sess, err := accountAPI.createImpersonationSession(ctx, db.AccountID, req.AdminId, lifetime)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := accountAPI.AuthAPI.GenToken(ctx, &auth.Payload{
+		ID:           fmt.Sprint(db.AccountID),
+		Names:        db.Names,
+		Group:        db.PrimaryGroup,
+		ProjectID:    db.ProjectID,
+		EmailAddress: db.Email,
+		PhoneNumber:  db.Phone,
+		Roles:        append(secondaryGroups[:len(secondaryGroups):len(secondaryGroups)], impersonationRoles(req.AdminId, sess.ID)...),
+	}, expires)
+	if err != nil {
+		if errRevoke := accountAPI.revokeSession(ctx, sess); errRevoke != nil {
+			accountAPI.Logger.Errorf("failed to revoke impersonation session without token: %v", errRevoke)
+		}
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate token")
+	}
+
+	// Every impersonation is kept in the audit trail of the account
+	err = accountAPI.recordAuditEvent(ctx, accountAPI.SQLDBWrites, &account.AdminUpdateAccountRequest{
+		AccountId:       req.AccountId,
+		AdminId:         req.AdminId,
+		UpdateOperation: account.UpdateOperation_IMPERSONATE,
+		Reason:          req.Reason,
+	}, db.ProjectID, auditDiff{
+		"session_id": &auditChange{New: sess.ID},
+		"expires_at": &auditChange{New: expires.UTC().Format(time.RFC3339)},
+	})
+	if err != nil {
+		if errRevoke := accountAPI.revokeSession(ctx, sess); errRevoke != nil {
+			accountAPI.Logger.Errorf("failed to revoke unrecorded impersonation session: %v", errRevoke)
+		}
+		return nil, err
+	}
+
+	accountAPI.Logger.Infof(
+		"admin %s is impersonating account %s in session %s", req.AdminId, req.AccountId, sess.ID,
+	)
+
+	pb, err := AccountProto(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return &account.SignInResponse{
+		SessionId:       sess.ID,
+		AccountId:       req.AccountId,
+		Token:           token,
+		State:           account.AccountState(account.AccountState_value[db.AccountState]),
+		Group:           db.PrimaryGroup,
+		SecondaryGroups: secondaryGroups,
+		Account:         pb,
+	}, nil
+}
@@ -0,0 +1,262 @@
+package account
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/services/pkg/api/account"
+	"github.com/gidyon/services/pkg/api/messaging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// signs tokens with a real auth API so that the claims of impersonation tokens can be inspected
+type signingAuthAPI struct {
+	auth.API
+	signer auth.API
+}
+
+func (authAPI *signingAuthAPI) GenToken(ctx context.Context, payload *auth.Payload, expires time.Time) (string, error) {
+	return authAPI.signer.GenToken(ctx, payload, expires)
+}
+
+func (authAPI *signingAuthAPI) GetPayloadFromJwt(token string) (*auth.Payload, error) {
+	return authAPI.signer.GetPayloadFromJwt(token)
+}
+
+var _ = Describe("Impersonating accounts @impersonate", func() {
+	var (
+		ctx        context.Context
+		signingKey = []byte(randomdata.RandStringRunes(32))
+		mockAPI    auth.API
+		signer     auth.API
+		adminID    string
+		accountID  string
+		res        *account.SignInResponse
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		var err error
+		signer, err = auth.NewAPI(&auth.Options{SigningKey: signingKey, Issuer: "Accounts API", Audience: "accounts"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		mockAPI = AccountAPIServer.AuthAPI
+		AccountAPIServer.AuthAPI = &signingAuthAPI{API: mockAPI, signer: signer}
+	})
+
+	AfterEach(func() {
+		AccountAPIServer.AuthAPI = mockAPI
+	})
+
+	impersonateReq := func() *account.ImpersonateAccountRequest {
+		return &account.ImpersonateAccountRequest{
+			AccountId: accountID,
+			AdminId:   adminID,
+			Reason:    "customer cannot see their invoices",
+		}
+	}
+
+	It("should create an admin and an account", func() {
+		var err error
+		adminID, err = createAdmin(account.AccountState_ACTIVE)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		pb := fakeAccount()
+		pb.ProjectId = projectID
+		db, err := AccountModel(pb)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(AccountAPIServer.SQLDBWrites.Create(db).Error).ShouldNot(HaveOccurred())
+		accountID = fmt.Sprint(db.AccountID)
+	})
+
+	It("should fail when the request is malformed", func() {
+		for _, mutate := range []func(*account.ImpersonateAccountRequest){
+			func(req *account.ImpersonateAccountRequest) { req.AccountId = "" },
+			func(req *account.ImpersonateAccountRequest) { req.AdminId = "" },
+			func(req *account.ImpersonateAccountRequest) { req.Reason = "" },
+			func(req *account.ImpersonateAccountRequest) { req.AccountId = req.AdminId },
+			func(req *account.ImpersonateAccountRequest) { req.AccountId = "abc" },
+		} {
+			req := impersonateReq()
+			mutate(req)
+			_, err := AccountAPI.ImpersonateAccount(ctx, req)
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		}
+	})
+
+	It("should fail to impersonate an admin", func() {
+		otherAdminID, err := createAdmin(account.AccountState_ACTIVE)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		req := impersonateReq()
+		req.AccountId = otherAdminID
+		_, err = AccountAPI.ImpersonateAccount(ctx, req)
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+	})
+
+	It("should fail to impersonate an account with an admin secondary group", func() {
+		pb := fakeAccount()
+		pb.ProjectId = projectID
+		db, err := AccountModel(pb)
+		Expect(err).ShouldNot(HaveOccurred())
+		db.SecondaryGroups = []byte(fmt.Sprintf("[%q]", auth.DefaultAdminGroup()))
+		Expect(AccountAPIServer.SQLDBWrites.Create(db).Error).ShouldNot(HaveOccurred())
+
+		req := impersonateReq()
+		req.AccountId = fmt.Sprint(db.AccountID)
+		_, err = AccountAPI.ImpersonateAccount(ctx, req)
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+	})
+
+	It("should issue a token naming the impersonator", func() {
+		var err error
+		res, err = AccountAPI.ImpersonateAccount(ctx, impersonateReq())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(res.AccountId).Should(Equal(accountID))
+		Expect(res.RefreshToken).Should(BeEmpty())
+
+		claims := &auth.Claims{}
+		_, err = jwt.ParseWithClaims(res.Token, claims, func(*jwt.Token) (interface{}, error) {
+			return signingKey, nil
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(claims.ID).Should(Equal(accountID))
+		Expect(claims.Issuer).Should(Equal("Accounts API"))
+		Expect(claims.Audience).Should(Equal("accounts"))
+		Expect(claims.ExpiresAt).ShouldNot(BeZero())
+
+		impersonatorID, sessionID := tokenImpersonation(res.Token)
+		Expect(impersonatorID).Should(Equal(adminID))
+		Expect(sessionID).Should(Equal(res.SessionId))
+
+		// Tokens are accepted as tokens of the account by other services
+		payload, err := signer.GetPayloadFromJwt(res.Token)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(payload.ID).Should(Equal(accountID))
+	})
+
+	It("should record the impersonation session", func() {
+		sessions, err := AccountAPI.ListSessions(ctx, &account.ListSessionsRequest{AccountId: accountID})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(sessions.Sessions).Should(HaveLen(1))
+		Expect(sessions.Sessions[0].SessionId).Should(Equal(res.SessionId))
+		Expect(sessions.Sessions[0].ImpersonatorId).Should(Equal(adminID))
+
+		events, err := AccountAPI.ListAuditEvents(ctx, &account.ListAuditEventsRequest{
+			AccountId:  accountID,
+			Operations: []account.UpdateOperation{account.UpdateOperation_IMPERSONATE},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(events.Events).Should(HaveLen(1))
+		Expect(events.Events[0].AdminId).Should(Equal(adminID))
+		Expect(events.Events[0].PayloadDiff).Should(ContainSubstring(res.SessionId))
+	})
+
+	It("should block sensitive operations while impersonating", func() {
+		ctx = auth.AddTokenMD(ctx, res.Token)
+
+		_, err := AccountAPI.DeleteAccount(ctx, &account.DeleteAccountRequest{AccountId: accountID})
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+
+		_, err = AccountAPI.UpdatePrivateAccount(ctx, &account.UpdatePrivateAccountRequest{
+			AccountId:      accountID,
+			PrivateAccount: fakePrivateAccount(),
+			ChangeToken:    "token",
+		})
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+
+		_, err = AccountAPI.ImpersonateAccount(ctx, impersonateReq())
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+
+		_, err = AccountAPI.ListSessions(ctx, &account.ListSessionsRequest{AccountId: accountID})
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+
+		_, err = AccountAPI.RevokeSession(ctx, &account.RevokeSessionRequest{AccountId: accountID, SessionId: res.SessionId})
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+
+		_, err = AccountAPI.RevokeAllSessions(ctx, &account.RevokeAllSessionsRequest{AccountId: accountID})
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+
+		_, err = AccountAPI.RequestDataExport(ctx, &account.RequestDataExportRequest{AccountId: accountID})
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+
+		_, err = AccountAPI.RequestChangePrivateAccount(ctx, &account.RequestChangePrivateAccountRequest{
+			Payload:     randomdata.Email(),
+			Project:     projectID,
+			FallbackUrl: "https://app.example.com",
+			SendMethod:  messaging.SendMethod_EMAIL,
+		})
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+
+		// Reading the account is allowed
+		_, err = AccountAPI.GetAccount(ctx, &account.GetAccountRequest{AccountId: accountID})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("should not start sessions from the impersonation token", func() {
+		_, err := AccountAPI.RefreshSession(auth.AddTokenMD(ctx, res.Token), &account.RefreshSessionRequest{
+			AccountId:    accountID,
+			RefreshToken: "token",
+		})
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ShouldNot(HaveOccurred())
+		handler, err := NewOIDCProvider(AccountAPI, &OIDCOptions{Issuer: "https://accounts.example.com", SigningKey: rsaKey})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		redirectURI := "https://app.example.com/callback"
+		client, err := AccountAPI.CreateOIDCClient(ctx, &account.CreateOIDCClientRequest{
+			Client: &account.OIDCClient{ProjectId: projectID, Name: "app", RedirectUris: []string{redirectURI}},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		q := url.Values{
+			"client_id":     {client.ClientId},
+			"redirect_uri":  {redirectURI},
+			"response_type": {"code"},
+			"scope":         {"openid"},
+		}
+		r := httptest.NewRequest(http.MethodGet, oidcAuthorizePath+"?"+q.Encode(), nil)
+		r.Header.Set("Authorization", "Bearer "+res.Token)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		Expect(w.Code).Should(Equal(http.StatusFound))
+		location, err := url.Parse(w.Header().Get("Location"))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(location.Query().Get("error")).Should(Equal("access_denied"))
+		Expect(location.Query().Get("code")).Should(BeEmpty())
+	})
+
+	It("should reject the token once the impersonation session is revoked", func() {
+		_, err := AccountAPIServer.AuthFuncOverride(auth.AddTokenMD(ctx, res.Token), "/account.AccountAPI/GetAccount")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = AccountAPI.RevokeSession(ctx, &account.RevokeSessionRequest{AccountId: accountID, SessionId: res.SessionId})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = AccountAPIServer.AuthFuncOverride(auth.AddTokenMD(ctx, res.Token), "/account.AccountAPI/GetAccount")
+		Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+	})
+
+	It("should not allow impersonation through admin update", func() {
+		_, err := AccountAPI.AdminUpdateAccount(ctx, &account.AdminUpdateAccountRequest{
+			AccountId:       accountID,
+			AdminId:         adminID,
+			UpdateOperation: account.UpdateOperation_IMPERSONATE,
+		})
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+})
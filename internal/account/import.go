@@ -0,0 +1,469 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/micro/v2/utils/mdutil"
+	"github.com/gidyon/services/internal/pkg/e164"
+	"github.com/gidyon/services/internal/pkg/tabular"
+	"github.com/gidyon/services/pkg/api/account"
+	"github.com/gidyon/services/pkg/api/longrunning"
+	redis "github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+const (
+	importBatchSize = 100
+	maxImportRows   = 50000
+	importTimeout   = 6 * time.Hour
+	importReportTTL = 7 * 24 * time.Hour
+)
+
+// Account fields that can be imported
+var importFields = map[string]bool{
+	"names":       true,
+	"email":       true,
+	"phone":       true,
+	"group":       true,
+	"gender":      true,
+	"id_number":   true,
+	"birth_date":  true,
+	"nationality": true,
+	"residence":   true,
+	"profession":  true,
+	"group_id":    true,
+	"parent_id":   true,
+}
+
+func importReportKey(operationID string) string {
+	return "accountimports:" + operationID
+}
+
+func importReportPath(operationID string) string {
+	return fmt.Sprintf("/api/accounts/imports/%s/report", operationID)
+}
+
+type importRow struct {
+	row int
+	db  *Account
+}
+
+func importError(row int, field, format string, args ...interface{}) *account.ImportRowError {
+	return &account.ImportRowError{Row: int64(row), Field: field, Message: fmt.Sprintf(format, args...)}
+}
+
+// validates and normalises a record into an account
+func importAccount(record *tabular.Record, projectID, defaultGroup, phoneRegion string) (*Account, *account.ImportRowError) {
+	f := record.Fields
+
+	db := &Account{
+		ProjectID:    projectID,
+		Names:        f["names"],
+		Email:        strings.ToLower(f["email"]),
+		Phone:        f["phone"],
+		PrimaryGroup: firstVal(f["group"], defaultGroup),
+		Gender:       account.Account_GENDER_UNSPECIFIED.String(),
+		IDNumber:     f["id_number"],
+		BirthDate:    f["birth_date"],
+		Nationality:  f["nationality"],
+		Residence:    f["residence"],
+		Profession:   f["profession"],
+		GroupID:      f["group_id"],
+		ParentID:     f["parent_id"],
+		AccountState: account.AccountState_ACTIVE.String(),
+	}
+
+	switch {
+	case db.Names == "":
+		return nil, importError(record.Row, "names", "names is required")
+	case len(db.Names) > 50:
+		return nil, importError(record.Row, "names", "names is longer than 50 characters")
+	case db.Email == "" && db.Phone == "":
+		return nil, importError(record.Row, "email", "email or phone is required")
+	}
+
+	if db.Email != "" {
+		addr, err := mail.ParseAddress(db.Email)
+		if err != nil || addr.Address != db.Email || len(db.Email) > 50 {
+			return nil, importError(record.Row, "email", "%q is not a valid email", f["email"])
+		}
+	}
+
+	if db.Phone != "" {
+		phone, err := e164.Normalise(db.Phone, phoneRegion)
+		if err != nil {
+			return nil, importError(record.Row, "phone", "%q is not a valid phone number", f["phone"])
+		}
+		db.Phone = phone
+	}
+
+	switch strings.ToLower(f["gender"]) {
+	case "":
+	case "m", "male":
+		db.Gender = account.Account_MALE.String()
+	case "f", "female":
+		db.Gender = account.Account_FEMALE.String()
+	default:
+		return nil, importError(record.Row, "gender", "%q is not a valid gender", f["gender"])
+	}
+
+	return db, nil
+}
+
+func (accountAPI *accountAPIServer) saveImportReport(ctx context.Context, report *account.ImportReport) error {
+	bs, err := proto.Marshal(report)
+	if err != nil {
+		return errs.FromProtoMarshal(err, "import report")
+	}
+	err = accountAPI.RedisDBWrites.Set(ctx, importReportKey(report.OperationId), bs, importReportTTL).Err()
+	if err != nil {
+		return errs.RedisCmdFailed(err, "set")
+	}
+	return nil
+}
+
+// updates the long running operation with progress of the import
+func (accountAPI *accountAPIServer) updateImportOperation(
+	ctx context.Context, report *account.ImportReport, status longrunning.OperationStatus,
+) {
+	bs, _ := json.Marshal(map[string]interface{}{
+		"dry_run":    report.DryRun,
+		"total_rows": report.TotalRows,
+		"processed":  report.Processed,
+		"created":    report.Created,
+		"failed":     report.Failed,
+		"report":     importReportPath(report.OperationId),
+	})
+
+	_, err := accountAPI.OperationsClient.UpdateOperation(ctx, &longrunning.UpdateOperationRequest{
+		OperationId: report.OperationId,
+		Result:      string(bs),
+		Status:      status,
+	})
+	if err != nil {
+		accountAPI.Logger.Errorf("failed to update import operation %s: %v", report.OperationId, err)
+	}
+}
+
+// returns rows whose email or phone already belongs to an account of the project
+func (accountAPI *accountAPIServer) existingContacts(projectID string, rows []*importRow) (map[string]bool, error) {
+	emails := make([]string, 0, len(rows))
+	phones := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if row.db.Email != "" {
+			emails = append(emails, row.db.Email)
+		}
+		if row.db.Phone != "" {
+			phones = append(phones, row.db.Phone)
+		}
+	}
+
+	existing := make(map[string]bool)
+	if len(emails) == 0 && len(phones) == 0 {
+		return existing, nil
+	}
+
+	db := accountAPI.SQLDBWrites.Unscoped().Select("email,phone").Where("project_id=?", projectID)
+	switch {
+	case len(emails) != 0 && len(phones) != 0:
+		db = db.Where("email IN (?) OR phone IN (?)", emails, phones)
+	case len(emails) != 0:
+		db = db.Where("email IN (?)", emails)
+	default:
+		db = db.Where("phone IN (?)", phones)
+	}
+
+	dbs := make([]*Account, 0)
+	err := db.Find(&dbs).Error
+	if err != nil {
+		return nil, errs.FailedToFind("accounts", err)
+	}
+
+	for _, db := range dbs {
+		existing[db.Email] = true
+		existing[db.Phone] = true
+	}
+
+	return existing, nil
+}
+
+// creates accounts of a batch, falling back to one at a time to attribute errors to rows
+func (accountAPI *accountAPIServer) createImportBatch(rows []*importRow) []*account.ImportRowError {
+	dbs := make([]*Account, 0, len(rows))
+	for _, row := range rows {
+		dbs = append(dbs, row.db)
+	}
+
+	err := accountAPI.SQLDBWrites.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&dbs).Error
+		if err != nil {
+			return err
+		}
+		for _, db := range dbs {
+			err = accountAPI.addAccountEvent(tx, account.AccountEventType_ACCOUNT_CREATED, db.AccountID, "")
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		for _, db := range dbs {
+			accountAPI.indexAccount(db.AccountID)
+		}
+		return nil
+	}
+
+	rowErrs := make([]*account.ImportRowError, 0)
+	for _, row := range rows {
+		row.db.AccountID = 0
+		err = accountAPI.SQLDBWrites.Transaction(func(tx *gorm.DB) error {
+			err := tx.Create(row.db).Error
+			if err != nil {
+				return err
+			}
+			return accountAPI.addAccountEvent(tx, account.AccountEventType_ACCOUNT_CREATED, row.db.AccountID, "")
+		})
+		if err != nil {
+			rowErrs = append(rowErrs, importError(row.row, "", "failed to create account: %v", err))
+			continue
+		}
+		accountAPI.indexAccount(row.db.AccountID)
+	}
+
+	return rowErrs
+}
+
+// imports records in batches, recording progress in the report
+func (accountAPI *accountAPIServer) runImport(
+	ctx context.Context, report *account.ImportReport, records []*tabular.Record, defaultGroup string,
+) {
+	status := longrunning.OperationStatus_FAILED
+
+	defer func() {
+		if err := recover(); err != nil {
+			accountAPI.Logger.Errorf("recovering from panic while importing accounts: %v", err)
+		}
+		report.Done = true
+		if err := accountAPI.saveImportReport(ctx, report); err != nil {
+			accountAPI.Logger.Errorf("failed to save import report %s: %v", report.OperationId, err)
+		}
+		accountAPI.updateImportOperation(ctx, report, status)
+	}()
+
+	// Emails and phones seen in earlier rows of the file
+	seen := make(map[string]int)
+
+	for start := 0; start < len(records); start += importBatchSize {
+		end := start + importBatchSize
+		if end > len(records) {
+			end = len(records)
+		}
+
+		rows := make([]*importRow, 0, end-start)
+		for _, record := range records[start:end] {
+			db, rowErr := importAccount(record, report.ProjectId, defaultGroup, accountAPI.PhoneRegions.Region(report.ProjectId))
+			if rowErr == nil {
+				if row, ok := seen[db.Email]; ok && db.Email != "" {
+					rowErr = importError(record.Row, "email", "email %s is a duplicate of row %d", db.Email, row)
+				} else if row, ok := seen[db.Phone]; ok && db.Phone != "" {
+					rowErr = importError(record.Row, "phone", "phone %s is a duplicate of row %d", db.Phone, row)
+				}
+			}
+			if rowErr != nil {
+				report.Errors = append(report.Errors, rowErr)
+				continue
+			}
+			for _, val := range []string{db.Email, db.Phone} {
+				if val != "" {
+					seen[val] = record.Row
+				}
+			}
+			rows = append(rows, &importRow{row: record.Row, db: db})
+		}
+
+		existing, err := accountAPI.existingContacts(report.ProjectId, rows)
+		if err != nil {
+			accountAPI.Logger.Errorf("failed to import accounts: %v", err)
+			return
+		}
+
+		newRows := make([]*importRow, 0, len(rows))
+		for _, row := range rows {
+			switch {
+			case row.db.Email != "" && existing[row.db.Email]:
+				report.Errors = append(report.Errors, importError(row.row, "email", "account with email %s already exists", row.db.Email))
+			case row.db.Phone != "" && existing[row.db.Phone]:
+				report.Errors = append(report.Errors, importError(row.row, "phone", "account with phone %s already exists", row.db.Phone))
+			default:
+				newRows = append(newRows, row)
+			}
+		}
+
+		created := int64(len(newRows))
+		if !report.DryRun && len(newRows) > 0 {
+			rowErrs := accountAPI.createImportBatch(newRows)
+			report.Errors = append(report.Errors, rowErrs...)
+			created -= int64(len(rowErrs))
+		}
+
+		report.Created += created
+		report.Processed = int64(end)
+		report.Failed = int64(len(report.Errors))
+
+		err = accountAPI.saveImportReport(ctx, report)
+		if err != nil {
+			accountAPI.Logger.Errorf("failed to save import report %s: %v", report.OperationId, err)
+		}
+		accountAPI.updateImportOperation(ctx, report, longrunning.OperationStatus_PENDING)
+	}
+
+	status = longrunning.OperationStatus_COMPLETED
+}
+
+func (accountAPI *accountAPIServer) ImportAccounts(
+	ctx context.Context, req *account.ImportAccountsRequest,
+) (*account.ImportAccountsResponse, error) {
+	// Validation
+	var err error
+	switch {
+	case req == nil:
+		err = errs.NilObject("import accounts request")
+	case req.ProjectId == "":
+		err = errs.MissingField("project id")
+	case len(req.File) == 0:
+		err = errs.MissingField("file")
+	case accountAPI.OperationsClient == nil:
+		err = errs.WrapMessage(codes.Unavailable, "long running operations not available")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	payload, err := accountAPI.AuthAPI.AuthorizeGroup(ctx, accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+	if payload.ProjectID != "" && payload.ProjectID != req.ProjectId {
+		return nil, errs.WrapMessage(codes.PermissionDenied, "cannot import accounts to another project")
+	}
+
+	format, err := tabular.ParseFormat(firstVal(req.Format, req.FileName))
+	if err != nil {
+		return nil, errs.WrapMessage(codes.InvalidArgument, "format must be csv or xlsx")
+	}
+
+	for col, field := range req.ColumnMapping {
+		if !importFields[field] {
+			return nil, errs.WrapMessagef(codes.InvalidArgument, "column %s maps to unknown field %s", col, field)
+		}
+	}
+
+	table, err := tabular.Read(bytes.NewReader(req.File), format)
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to read file")
+	}
+
+	records := table.Records(req.ColumnMapping)
+	switch {
+	case len(records) == 0:
+		return nil, errs.WrapMessage(codes.InvalidArgument, "file has no rows to import")
+	case len(records) > maxImportRows:
+		return nil, errs.WrapMessagef(codes.InvalidArgument, "file has more than %d rows", maxImportRows)
+	}
+
+	// The import outlives the request so it authenticates with its own token
+	token, err := accountAPI.AuthAPI.GenToken(ctx, payload, time.Now().Add(importTimeout))
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate token")
+	}
+	opCtx := mdutil.AddMD(context.Background(), metadata.Pairs(auth.Header(), fmt.Sprintf("%s %s", auth.Scheme(), token)))
+
+	op, err := accountAPI.OperationsClient.CreateOperation(opCtx, &longrunning.CreateOperationRequest{
+		Operation: &longrunning.Operation{
+			UserId:       payload.ID,
+			Details:      fmt.Sprintf("Importing %d accounts from %s", len(records), firstVal(req.FileName, string(format))),
+			Origin:       "account",
+			Status:       longrunning.OperationStatus_PENDING,
+			TimestampSec: time.Now().Unix(),
+		},
+	})
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Unavailable, err, "failed to create long running operation")
+	}
+
+	report := &account.ImportReport{
+		OperationId: op.Id,
+		ProjectId:   req.ProjectId,
+		DryRun:      req.DryRun,
+		TotalRows:   int64(len(records)),
+	}
+
+	err = accountAPI.saveImportReport(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(opCtx, importTimeout)
+		defer cancel()
+		accountAPI.runImport(ctx, report, records, firstVal(req.DefaultGroup, auth.DefaultUserGroup()))
+	}()
+
+	return &account.ImportAccountsResponse{
+		OperationId: op.Id,
+		TotalRows:   int64(len(records)),
+	}, nil
+}
+
+func (accountAPI *accountAPIServer) GetImportReport(
+	ctx context.Context, req *account.GetImportReportRequest,
+) (*account.ImportReport, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("get import report request")
+	case req.OperationId == "":
+		return nil, errs.MissingField("operation id")
+	}
+
+	// Authorization
+	payload, err := accountAPI.AuthAPI.AuthorizeGroup(ctx, accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	bs, err := accountAPI.RedisDBReads.Get(ctx, importReportKey(req.OperationId)).Bytes()
+	switch {
+	case err == nil:
+	case errors.Is(err, redis.Nil):
+		return nil, errs.DoesNotExist("import report", req.OperationId)
+	default:
+		return nil, errs.RedisCmdFailed(err, "get")
+	}
+
+	report := &account.ImportReport{}
+	err = proto.Unmarshal(bs, report)
+	if err != nil {
+		return nil, errs.FromProtoUnMarshal(err, "import report")
+	}
+
+	if payload.ProjectID != "" && payload.ProjectID != report.ProjectId {
+		return nil, errs.DoesNotExist("import report", req.OperationId)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,133 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/services/pkg/api/account"
+	"github.com/gidyon/services/pkg/api/longrunning"
+	lrmocks "github.com/gidyon/services/pkg/mocks/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Importing accounts @import", func() {
+	var (
+		ctx      context.Context
+		emails   []string
+		importFn = func(dryRun bool) *account.ImportReport {
+			rows := []string{"Jina,Barua Pepe,Simu,Jinsia"}
+			for _, email := range emails {
+				rows = append(rows, fmt.Sprintf("%s,%s,,F", randomdata.FullName(randomdata.Female), email))
+			}
+			rows = append(rows,
+				"No Contact,,,",
+				fmt.Sprintf("Duplicate,%s,,", emails[0]),
+				"Bad Email,not-an-email,,",
+			)
+
+			importRes, err := AccountAPI.ImportAccounts(ctx, &account.ImportAccountsRequest{
+				ProjectId: projectID,
+				File:      []byte(strings.Join(rows, "\n")),
+				FileName:  "accounts.csv",
+				ColumnMapping: map[string]string{
+					"Jina": "names", "Barua Pepe": "email", "Simu": "phone", "Jinsia": "gender",
+				},
+				DryRun: dryRun,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(importRes.TotalRows).Should(BeEquivalentTo(len(emails) + 3))
+
+			report := &account.ImportReport{}
+			Eventually(func() bool {
+				report, err = AccountAPI.GetImportReport(ctx, &account.GetImportReportRequest{
+					OperationId: importRes.OperationId,
+				})
+				return err == nil && report.Done
+			}, "5s").Should(BeTrue())
+			return report
+		}
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("should use a long running operations client", func() {
+		operations := &lrmocks.LongrunningClientMock{}
+		operations.On("CreateOperation", mock.Anything, mock.Anything, mock.Anything).
+			Return(&longrunning.Operation{Id: uuid.New().String()}, nil)
+		operations.On("UpdateOperation", mock.Anything, mock.Anything, mock.Anything).
+			Return(&longrunning.Operation{}, nil)
+		AccountAPIServer.OperationsClient = operations
+
+		for i := 0; i < 3; i++ {
+			emails = append(emails, strings.ToLower(randomdata.Email()))
+		}
+	})
+
+	It("should fail for a file of unknown format", func() {
+		_, err := AccountAPI.ImportAccounts(ctx, &account.ImportAccountsRequest{
+			ProjectId: projectID,
+			File:      []byte("names\nJane"),
+			FileName:  "accounts.pdf",
+		})
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("should fail when a column maps to an unknown field", func() {
+		_, err := AccountAPI.ImportAccounts(ctx, &account.ImportAccountsRequest{
+			ProjectId:     projectID,
+			File:          []byte("names\nJane"),
+			Format:        "csv",
+			ColumnMapping: map[string]string{"names": "password"},
+		})
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("should validate rows without creating accounts in dry run", func() {
+		report := importFn(true)
+		Expect(report.Created).Should(BeEquivalentTo(len(emails)))
+		Expect(report.Failed).Should(BeEquivalentTo(3))
+
+		existRes, err := AccountAPI.ExistAccount(ctx, &account.ExistAccountRequest{
+			Email:     emails[0],
+			ProjectId: projectID,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(existRes.Exists).Should(BeFalse())
+	})
+
+	It("should create accounts and report failed rows", func() {
+		report := importFn(false)
+		Expect(report.Created).Should(BeEquivalentTo(len(emails)))
+		Expect(report.Errors).Should(HaveLen(3))
+
+		fields := make([]string, 0, len(report.Errors))
+		for _, rowErr := range report.Errors {
+			fields = append(fields, rowErr.Field)
+		}
+		Expect(fields).Should(ConsistOf("email", "email", "email"))
+
+		existRes, err := AccountAPI.ExistAccount(ctx, &account.ExistAccountRequest{
+			Email:     emails[0],
+			ProjectId: projectID,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(existRes.Exists).Should(BeTrue())
+	})
+
+	It("should not import accounts that already exist", func() {
+		report := importFn(false)
+		Expect(report.Created).Should(BeZero())
+		Expect(report.Failed).Should(BeEquivalentTo(len(emails) + 3))
+	})
+
+	It("should remove the long running operations client", func() {
+		AccountAPIServer.OperationsClient = nil
+	})
+})
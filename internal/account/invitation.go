@@ -0,0 +1,550 @@
+package account
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/micro/v2/utils/mdutil"
+	"github.com/gidyon/services/pkg/api/account"
+	"github.com/gidyon/services/pkg/api/messaging"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+const (
+	invitationsTable      = "account_invitations"
+	defaultInvitationTTL  = 7 * 24 * time.Hour
+	invitationCodeLength  = 10
+	invitationCodeLetters = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+// Invitation is an invitation to create an account in a project and group
+type Invitation struct {
+	ID              uint       `gorm:"primaryKey;autoIncrement"`
+	ProjectID       string     `gorm:"index;type:varchar(50);not null"`
+	Email           string     `gorm:"index;type:varchar(50)"`
+	Phone           string     `gorm:"index;type:varchar(50)"`
+	Names           string     `gorm:"type:varchar(50)"`
+	PrimaryGroup    string     `gorm:"type:varchar(50);not null"`
+	SecondaryGroups []byte     `gorm:"type:json"`
+	ParentID        string     `gorm:"type:varchar(50)"`
+	CodeHash        string     `gorm:"uniqueIndex;type:varchar(64);not null"`
+	MaxUses         int32      `gorm:"not null"`
+	Uses            int32      `gorm:"not null;default:0"`
+	CreatedBy       string     `gorm:"index;type:varchar(50)"`
+	ExpiresAt       time.Time  `gorm:"index;not null"`
+	RevokedAt       *time.Time `gorm:"index"`
+	CreatedAt       time.Time  `gorm:"index;precision:6;not null;autoCreateTime"`
+	UpdatedAt       time.Time  `gorm:"precision:6"`
+}
+
+// TableName is the name of the table
+func (*Invitation) TableName() string {
+	return invitationsTable
+}
+
+func (inv *Invitation) state(now time.Time) account.InvitationState {
+	switch {
+	case inv.RevokedAt != nil:
+		return account.InvitationState_INVITATION_REVOKED
+	case inv.Uses >= inv.MaxUses:
+		return account.InvitationState_INVITATION_ACCEPTED
+	case !now.Before(inv.ExpiresAt):
+		return account.InvitationState_INVITATION_EXPIRED
+	default:
+		return account.InvitationState_INVITATION_PENDING
+	}
+}
+
+// InvitationProto converts invitation model to protobuf message
+func InvitationProto(inv *Invitation) (*account.Invitation, error) {
+	secondaryGroups := make([]string, 0)
+	if len(inv.SecondaryGroups) != 0 {
+		err := json.Unmarshal(inv.SecondaryGroups, &secondaryGroups)
+		if err != nil {
+			return nil, errs.FromJSONUnMarshal(err, "secondary groups")
+		}
+	}
+
+	return &account.Invitation{
+		InvitationId:    fmt.Sprint(inv.ID),
+		ProjectId:       inv.ProjectID,
+		Email:           inv.Email,
+		Phone:           inv.Phone,
+		Names:           inv.Names,
+		PrimaryGroup:    inv.PrimaryGroup,
+		SecondaryGroups: secondaryGroups,
+		ParentId:        inv.ParentID,
+		MaxUses:         inv.MaxUses,
+		Uses:            inv.Uses,
+		ExpiresAt:       inv.ExpiresAt.Unix(),
+		CreatedBy:       inv.CreatedBy,
+		State:           inv.state(time.Now()),
+		CreatedAt:       inv.CreatedAt.Unix(),
+	}, nil
+}
+
+// generates a code that is easy to type from an sms
+func invitationCode() (string, error) {
+	var sb strings.Builder
+	max := big.NewInt(int64(len(invitationCodeLetters)))
+	for i := 0; i < invitationCodeLength; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteByte(invitationCodeLetters[n.Int64()])
+	}
+	return sb.String(), nil
+}
+
+// codes are matched regardless of case and separators added when typing them
+func normaliseInvitationCode(code string) string {
+	return strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
+
+// sends the invitation code to the contacts of the invitation
+func (accountAPI *accountAPIServer) sendInvitation(
+	ctx context.Context, req *account.CreateInvitationRequest, inv *Invitation, code string,
+) {
+	var (
+		appName     = firstVal(req.GetSender().GetAppName(), req.GetSmsAuth().GetAppName(), accountAPI.AppName)
+		sendMethods = make([]messaging.SendMethod, 0, 2)
+		link        string
+	)
+
+	if inv.Email != "" {
+		sendMethods = append(sendMethods, messaging.SendMethod_EMAIL)
+	}
+	if inv.Phone != "" {
+		sendMethods = append(sendMethods, messaging.SendMethod_SMSV2)
+	}
+
+	if accountAPI.InvitationURL != "" {
+		link = fmt.Sprintf("%s?code=%s", accountAPI.InvitationURL, code)
+	}
+
+	data := fmt.Sprintf(
+		"Hello %s. You have been invited to create a %s account. Use the code %s before %s to create your account.",
+		firstVal(inv.Names, "there"), appName, code, inv.ExpiresAt.UTC().Format("2 Jan 2006 15:04 MST"),
+	)
+	emailData := data
+	if link != "" {
+		emailData = fmt.Sprintf("%s Or follow the link %s", data, link)
+	}
+
+	ctx, cancel := context.WithTimeout(mdutil.AddFromCtx(ctx), 10*time.Second)
+	defer cancel()
+
+	_, err := accountAPI.MessagingClient.SendMessage(ctx, &messaging.SendMessageRequest{
+		Message: &messaging.Message{
+			Title:       fmt.Sprintf("You are invited to %s", appName),
+			Data:        data,
+			EmailData:   emailData,
+			Link:        link,
+			Type:        messaging.MessageType_INFO,
+			SendMethods: sendMethods,
+		},
+		Sender:          req.GetSender(),
+		SmsAuth:         req.GetSmsAuth(),
+		FetchSmsAuth:    req.FetchSmsAuth,
+		SmsCredentialId: req.SmsCredentialId,
+		Email:           inv.Email,
+		Phone:           inv.Phone,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		accountAPI.Logger.Errorf("failed to send invitation %d: %v", inv.ID, err)
+	}
+}
+
+func (accountAPI *accountAPIServer) CreateInvitation(
+	ctx context.Context, req *account.CreateInvitationRequest,
+) (*account.Invitation, error) {
+	// Validation
+	var err error
+	switch {
+	case req == nil:
+		err = errs.NilObject("create invitation request")
+	case req.Invitation == nil:
+		err = errs.NilObject("invitation")
+	case req.Invitation.ProjectId == "":
+		err = errs.MissingField("project id")
+	case req.Invitation.PrimaryGroup == "":
+		err = errs.MissingField("primary group")
+	case req.Invitation.Email == "" && req.Invitation.Phone == "":
+		err = errs.MissingField("email or phone")
+	case req.Invitation.MaxUses < 0:
+		err = errs.IncorrectVal("max uses")
+	case req.Invitation.ExpiresAt != 0 && req.Invitation.ExpiresAt <= time.Now().Unix():
+		err = errs.WrapMessage(codes.InvalidArgument, "invitation must expire in the future")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	payload, err := accountAPI.AuthAPI.AuthorizeGroup(ctx, accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Admins of a project only invite to their project
+	if payload.ProjectID != "" && payload.ProjectID != req.Invitation.ProjectId {
+		return nil, errs.WrapMessage(codes.PermissionDenied, "cannot invite to another project")
+	}
+
+	pb := req.Invitation
+
+	inv := &Invitation{
+		ProjectID:    pb.ProjectId,
+		Email:        pb.Email,
+		Phone:        pb.Phone,
+		Names:        pb.Names,
+		PrimaryGroup: pb.PrimaryGroup,
+		ParentID:     pb.ParentId,
+		MaxUses:      pb.MaxUses,
+		CreatedBy:    payload.ID,
+		ExpiresAt:    time.Unix(pb.ExpiresAt, 0),
+	}
+	if inv.MaxUses == 0 {
+		inv.MaxUses = 1
+	}
+	if pb.ExpiresAt == 0 {
+		inv.ExpiresAt = time.Now().Add(defaultInvitationTTL)
+	}
+
+	if inv.Phone != "" {
+		inv.Phone, err = accountAPI.normalisePhone(inv.ProjectID, inv.Phone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(pb.SecondaryGroups) != 0 {
+		inv.SecondaryGroups, err = json.Marshal(pb.SecondaryGroups)
+		if err != nil {
+			return nil, errs.FromJSONMarshal(err, "secondary groups")
+		}
+	}
+
+	code, err := invitationCode()
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate invitation code")
+	}
+	inv.CodeHash = hashToken(code)
+
+	err = accountAPI.SQLDBWrites.Create(inv).Error
+	if err != nil {
+		return nil, errs.FailedToSave("invitation", err)
+	}
+
+	accountAPI.sendInvitation(ctx, req, inv, code)
+
+	pb, err = InvitationProto(inv)
+	if err != nil {
+		return nil, err
+	}
+	pb.Code = code
+
+	return pb, nil
+}
+
+// applies the state filters of the request to the invitations query
+func invitationStatesQuery(db *gorm.DB, states []account.InvitationState, now time.Time) *gorm.DB {
+	conds := make([]string, 0, len(states))
+	args := make([]interface{}, 0, len(states))
+	for _, state := range states {
+		switch state {
+		case account.InvitationState_INVITATION_PENDING:
+			conds = append(conds, "(revoked_at IS NULL AND uses<max_uses AND expires_at>?)")
+			args = append(args, now)
+		case account.InvitationState_INVITATION_ACCEPTED:
+			conds = append(conds, "(revoked_at IS NULL AND uses>=max_uses)")
+		case account.InvitationState_INVITATION_EXPIRED:
+			conds = append(conds, "(revoked_at IS NULL AND uses<max_uses AND expires_at<=?)")
+			args = append(args, now)
+		case account.InvitationState_INVITATION_REVOKED:
+			conds = append(conds, "revoked_at IS NOT NULL")
+		}
+	}
+	if len(conds) == 0 {
+		return db
+	}
+	return db.Where(strings.Join(conds, " OR "), args...)
+}
+
+func (accountAPI *accountAPIServer) ListInvitations(
+	ctx context.Context, req *account.ListInvitationsRequest,
+) (*account.ListInvitationsResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("list invitations request")
+	case req.ProjectId == "":
+		return nil, errs.MissingField("project id")
+	case req.PageSize < 0:
+		return nil, errs.IncorrectVal("page size")
+	}
+
+	// Authorization
+	payload, err := accountAPI.AuthAPI.AuthorizeGroup(ctx, accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.ProjectID != "" && payload.ProjectID != req.ProjectId {
+		return nil, errs.WrapMessage(codes.PermissionDenied, "cannot list invitations of another project")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize == 0 || pageSize > defaultPageSize {
+		pageSize = defaultPageSize
+	}
+
+	var id uint
+
+	// Get last id from page token
+	pageToken := req.GetPageToken()
+	if pageToken != "" {
+		ids, err := accountAPI.PaginationHasher.DecodeInt64WithError(pageToken)
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+		}
+		id = uint(ids[0])
+	}
+
+	db := accountAPI.SQLDBReads.Model(&Invitation{}).Where("project_id=?", req.ProjectId)
+	db = invitationStatesQuery(db, req.States, time.Now())
+
+	var collectionCount int64
+
+	if pageToken == "" {
+		err = db.Count(&collectionCount).Error
+		if err != nil {
+			return nil, errs.SQLQueryFailed(err, "count")
+		}
+	}
+
+	if id > 0 {
+		db = db.Where("id<?", id)
+	}
+
+	dbs := make([]*Invitation, 0, pageSize+1)
+	err = db.Order("id DESC").Limit(int(pageSize) + 1).Find(&dbs).Error
+	if err != nil {
+		return nil, errs.FailedToFind("invitations", err)
+	}
+
+	pbs := make([]*account.Invitation, 0, len(dbs))
+	for i, db := range dbs {
+		if i == int(pageSize) {
+			break
+		}
+		pb, err := InvitationProto(db)
+		if err != nil {
+			return nil, err
+		}
+		pbs = append(pbs, pb)
+		id = db.ID
+	}
+
+	var token string
+	if len(dbs) > int(pageSize) {
+		// Next page token
+		token, err = accountAPI.PaginationHasher.EncodeInt64([]int64{int64(id)})
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to generate next page token")
+		}
+	}
+
+	return &account.ListInvitationsResponse{
+		Invitations:     pbs,
+		NextPageToken:   token,
+		CollectionCount: collectionCount,
+	}, nil
+}
+
+func (accountAPI *accountAPIServer) RevokeInvitation(
+	ctx context.Context, req *account.RevokeInvitationRequest,
+) (*empty.Empty, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("revoke invitation request")
+	case req.InvitationId == "":
+		return nil, errs.MissingField("invitation id")
+	}
+
+	id, err := strconv.Atoi(req.InvitationId)
+	if err != nil {
+		return nil, errs.IncorrectVal("invitation id")
+	}
+
+	// Authorization
+	payload, err := accountAPI.AuthAPI.AuthorizeGroup(ctx, accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	inv := &Invitation{}
+	err = accountAPI.SQLDBWrites.First(inv, "id=?", id).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("invitation", req.InvitationId)
+	default:
+		return nil, errs.FailedToFind("invitation", err)
+	}
+
+	if payload.ProjectID != "" && payload.ProjectID != inv.ProjectID {
+		return nil, errs.WrapMessage(codes.PermissionDenied, "cannot revoke invitations of another project")
+	}
+
+	err = accountAPI.SQLDBWrites.Model(inv).Where("revoked_at IS NULL").Update("revoked_at", time.Now()).Error
+	if err != nil {
+		return nil, errs.FailedToUpdate("invitation", err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (accountAPI *accountAPIServer) AcceptInvitation(
+	ctx context.Context, req *account.AcceptInvitationRequest,
+) (*account.SignInResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("accept invitation request")
+	case req.Code == "":
+		return nil, errs.MissingField("code")
+	case req.Account == nil:
+		return nil, errs.NilObject("account")
+	case req.Account.Names == "":
+		return nil, errs.MissingField("names")
+	}
+
+	inv := &Invitation{}
+	err := accountAPI.SQLDBWrites.First(inv, "code_hash=?", hashToken(normaliseInvitationCode(req.Code))).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.WrapMessage(codes.NotFound, "invitation does not exist")
+	default:
+		return nil, errs.FailedToFind("invitation", err)
+	}
+
+	switch inv.state(time.Now()) {
+	case account.InvitationState_INVITATION_PENDING:
+	case account.InvitationState_INVITATION_EXPIRED:
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "invitation has expired")
+	default:
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "invitation is no longer valid")
+	}
+
+	pb := req.Account
+
+	// The code reached the contacts of a single use invitation so the account takes them
+	if inv.MaxUses == 1 {
+		pb.Email = firstVal(inv.Email, pb.Email)
+		pb.Phone = firstVal(inv.Phone, pb.Phone)
+	}
+	if pb.Email == "" && pb.Phone == "" {
+		return nil, errs.MissingField("email or phone")
+	}
+
+	pb.Group = inv.PrimaryGroup
+	pb.ParentId = inv.ParentID
+	pb.State = account.AccountState_ACTIVE
+
+	db, err := AccountModel(pb)
+	if err != nil {
+		return nil, err
+	}
+	db.ProjectID = inv.ProjectID
+	db.SecondaryGroups = inv.SecondaryGroups
+
+	if db.Phone != "" {
+		db.Phone, err = accountAPI.normalisePhone(inv.ProjectID, db.Phone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	db.Attributes, err = accountAPI.attributesJSON(inv.ProjectID, pb.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	existRes, err := accountAPI.ExistAccount(ctx, &account.ExistAccountRequest{
+		Email:     db.Email,
+		Phone:     db.Phone,
+		ProjectId: inv.ProjectID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if existRes.Exists {
+		return nil, errs.WrapMessagef(
+			codes.AlreadyExists, "account with %s already exists", strings.Join(existRes.ExistingFields, " and "),
+		)
+	}
+
+	if req.PrivateAccount != nil {
+		db.SecurityQuestion = req.PrivateAccount.SecurityQuestion
+		db.SecurityAnswer = req.PrivateAccount.SecurityAnswer
+		if req.PrivateAccount.Password != "" {
+			err = accountAPI.checkPasswordPolicy(inv.ProjectID, 0, req.PrivateAccount.Password)
+			if err != nil {
+				return nil, err
+			}
+			db.Password, err = accountAPI.genHash(req.PrivateAccount.Password)
+			if err != nil {
+				return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate hash password")
+			}
+		}
+	}
+
+	err = accountAPI.SQLDBWrites.Transaction(func(tx *gorm.DB) error {
+		// Uses are counted atomically so that the limit holds for concurrent accepts
+		res := tx.Model(&Invitation{}).
+			Where("id=? AND revoked_at IS NULL AND uses<max_uses AND expires_at>?", inv.ID, time.Now()).
+			Update("uses", gorm.Expr("uses+1"))
+		switch {
+		case res.Error != nil:
+			return errs.FailedToUpdate("invitation", res.Error)
+		case res.RowsAffected == 0:
+			return errs.WrapMessage(codes.FailedPrecondition, "invitation is no longer valid")
+		}
+
+		err := tx.Create(db).Error
+		if err != nil {
+			return errs.FailedToSave("account", err)
+		}
+
+		if db.Password != "" {
+			err = accountAPI.passwordChanged(tx, inv.ProjectID, db.AccountID, db.Password)
+			if err != nil {
+				return err
+			}
+		}
+
+		return accountAPI.addAccountEvent(tx, account.AccountEventType_ACCOUNT_CREATED, db.AccountID, "")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accountAPI.indexAccount(db.AccountID)
+
+	return accountAPI.signInSession(ctx, db, "")
+}
@@ -0,0 +1,198 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	micro_mocks "github.com/gidyon/micro/v2/pkg/mocks/mocks"
+	"github.com/gidyon/services/pkg/api/account"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Inviting users to create accounts @invitation", func() {
+	var (
+		ctx context.Context
+		inv *account.Invitation
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	fakeInvitation := func() *account.Invitation {
+		return &account.Invitation{
+			ProjectId:       projectID,
+			Email:           fakeAccount().Email,
+			Names:           "Invited User",
+			PrimaryGroup:    "TEAM_MEMBER",
+			SecondaryGroups: []string{"REVIEWER"},
+			ParentId:        "1",
+		}
+	}
+
+	acceptReq := func(code string) *account.AcceptInvitationRequest {
+		pb := fakeAccount()
+		pb.Email = ""
+		return &account.AcceptInvitationRequest{
+			Code:           code,
+			Account:        pb,
+			PrivateAccount: fakePrivateAccount(),
+		}
+	}
+
+	Describe("Creating invitations", func() {
+		It("should fail when the invitation is malformed", func() {
+			for _, mutate := range []func(*account.Invitation){
+				func(pb *account.Invitation) { pb.ProjectId = "" },
+				func(pb *account.Invitation) { pb.PrimaryGroup = "" },
+				func(pb *account.Invitation) { pb.Email = "" },
+				func(pb *account.Invitation) { pb.MaxUses = -1 },
+				func(pb *account.Invitation) { pb.ExpiresAt = time.Now().Add(-time.Hour).Unix() },
+			} {
+				pb := fakeInvitation()
+				mutate(pb)
+				_, err := AccountAPI.CreateInvitation(ctx, &account.CreateInvitationRequest{Invitation: pb})
+				Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			}
+		})
+
+		It("should create an invitation and return its code once", func() {
+			var err error
+			inv, err = AccountAPI.CreateInvitation(ctx, &account.CreateInvitationRequest{Invitation: fakeInvitation()})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(inv.InvitationId).ShouldNot(BeZero())
+			Expect(inv.Code).ShouldNot(BeEmpty())
+			Expect(inv.MaxUses).Should(BeEquivalentTo(1))
+			Expect(inv.State).Should(Equal(account.InvitationState_INVITATION_PENDING))
+			Expect(inv.ExpiresAt).Should(BeNumerically(">", time.Now().Unix()))
+
+			res, err := AccountAPI.ListInvitations(ctx, &account.ListInvitationsRequest{ProjectId: projectID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.Invitations).ShouldNot(BeEmpty())
+			for _, pb := range res.Invitations {
+				Expect(pb.Code).Should(BeEmpty())
+			}
+		})
+	})
+
+	Describe("Accepting invitations", func() {
+		It("should not require an access token to accept an invitation", func() {
+			authAPI := &micro_mocks.AuthAPIMock{}
+			authAPI.On("AuthorizeFunc", mock.Anything).Return(nil, status.Error(codes.Unauthenticated, "no token"))
+			accountAPI := &accountAPIServer{Options: &Options{AuthAPI: authAPI}}
+
+			_, err := accountAPI.AuthFuncOverride(ctx, "/gidyon.apis.AccountAPI/AcceptInvitation")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// Managing invitations still requires one
+			_, err = accountAPI.AuthFuncOverride(ctx, "/gidyon.apis.AccountAPI/CreateInvitation")
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+		})
+
+		It("should fail for unknown codes", func() {
+			_, err := AccountAPI.AcceptInvitation(ctx, acceptReq("UNKNOWN"))
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+
+		It("should create the account in the invited project and groups", func() {
+			res, err := AccountAPI.AcceptInvitation(ctx, acceptReq(inv.Code))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.Token).ShouldNot(BeEmpty())
+			Expect(res.Group).Should(Equal("TEAM_MEMBER"))
+			Expect(res.SecondaryGroups).Should(ConsistOf("REVIEWER"))
+
+			pb, err := AccountAPI.GetAccount(ctx, &account.GetAccountRequest{AccountId: res.AccountId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(pb.ProjectId).Should(Equal(projectID))
+			Expect(pb.Email).Should(Equal(inv.Email))
+			Expect(pb.ParentId).Should(Equal("1"))
+			Expect(pb.State).Should(Equal(account.AccountState_ACTIVE))
+		})
+
+		It("should not accept the invitation more than its usage limit", func() {
+			_, err := AccountAPI.AcceptInvitation(ctx, acceptReq(inv.Code))
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			res, err := AccountAPI.ListInvitations(ctx, &account.ListInvitationsRequest{
+				ProjectId: projectID,
+				States:    []account.InvitationState{account.InvitationState_INVITATION_ACCEPTED},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(invitationIDs(res.Invitations)).Should(ContainElement(inv.InvitationId))
+		})
+
+		It("should accept multi use invitations until the limit", func() {
+			pb := fakeInvitation()
+			pb.MaxUses = 2
+			multi, err := AccountAPI.CreateInvitation(ctx, &account.CreateInvitationRequest{Invitation: pb})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for i := 0; i < 2; i++ {
+				req := acceptReq(multi.Code)
+				req.Account.Email = fakeAccount().Email
+				_, err = AccountAPI.AcceptInvitation(ctx, req)
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			req := acceptReq(multi.Code)
+			req.Account.Email = fakeAccount().Email
+			_, err = AccountAPI.AcceptInvitation(ctx, req)
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should not accept expired invitations", func() {
+			expired, err := AccountAPI.CreateInvitation(ctx, &account.CreateInvitationRequest{Invitation: fakeInvitation()})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = AccountAPIServer.SQLDBWrites.Model(&Invitation{}).Where("id=?", expired.InvitationId).
+				Update("expires_at", time.Now().Add(-time.Minute)).Error
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = AccountAPI.AcceptInvitation(ctx, acceptReq(expired.Code))
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			res, err := AccountAPI.ListInvitations(ctx, &account.ListInvitationsRequest{
+				ProjectId: projectID,
+				States:    []account.InvitationState{account.InvitationState_INVITATION_EXPIRED},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(invitationIDs(res.Invitations)).Should(ContainElement(expired.InvitationId))
+		})
+	})
+
+	Describe("Revoking invitations", func() {
+		It("should not accept revoked invitations", func() {
+			revoked, err := AccountAPI.CreateInvitation(ctx, &account.CreateInvitationRequest{Invitation: fakeInvitation()})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = AccountAPI.RevokeInvitation(ctx, &account.RevokeInvitationRequest{InvitationId: revoked.InvitationId})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = AccountAPI.AcceptInvitation(ctx, acceptReq(revoked.Code))
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+
+			res, err := AccountAPI.ListInvitations(ctx, &account.ListInvitationsRequest{
+				ProjectId: projectID,
+				States:    []account.InvitationState{account.InvitationState_INVITATION_REVOKED},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(invitationIDs(res.Invitations)).Should(ContainElement(revoked.InvitationId))
+		})
+
+		It("should fail for unknown invitations", func() {
+			_, err := AccountAPI.RevokeInvitation(ctx, &account.RevokeInvitationRequest{InvitationId: fmt.Sprint(1 << 30)})
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+	})
+})
+
+func invitationIDs(pbs []*account.Invitation) []string {
+	ids := make([]string, 0, len(pbs))
+	for _, pb := range pbs {
+		ids = append(ids, pb.InvitationId)
+	}
+	return ids
+}
@@ -0,0 +1,298 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/pkg/api/messaging"
+	redis "github.com/go-redis/redis/v8"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// LockoutOptions configures tracking of failed password sign ins
+type LockoutOptions struct {
+	// Failed attempts on a username before the account is temporarily locked
+	MaxFailedAttempts int
+	// Failed attempts from a single IP before further attempts from it are delayed
+	MaxFailedAttemptsPerIP int
+	// Period in which failed attempts are counted
+	FailureWindow time.Duration
+	// How long an account remains locked
+	LockDuration time.Duration
+	// Delay after the second failed attempt, doubled on every subsequent failure
+	BaseDelay time.Duration
+	// Upper bound for the delay between attempts
+	MaxDelay time.Duration
+	// Inform account owner when their account is locked
+	NotifyOnLock bool
+}
+
+// DefaultLockoutOptions are used when lockout options are not provided
+var DefaultLockoutOptions = LockoutOptions{
+	MaxFailedAttempts:      5,
+	MaxFailedAttemptsPerIP: 50,
+	FailureWindow:          15 * time.Minute,
+	LockDuration:           15 * time.Minute,
+	BaseDelay:              time.Second,
+	MaxDelay:               30 * time.Second,
+}
+
+func (opt *LockoutOptions) withDefaults() *LockoutOptions {
+	out := DefaultLockoutOptions
+	if opt == nil {
+		return &out
+	}
+	out.NotifyOnLock = opt.NotifyOnLock
+	if opt.MaxFailedAttempts > 0 {
+		out.MaxFailedAttempts = opt.MaxFailedAttempts
+	}
+	if opt.MaxFailedAttemptsPerIP > 0 {
+		out.MaxFailedAttemptsPerIP = opt.MaxFailedAttemptsPerIP
+	}
+	if opt.FailureWindow > 0 {
+		out.FailureWindow = opt.FailureWindow
+	}
+	if opt.LockDuration > 0 {
+		out.LockDuration = opt.LockDuration
+	}
+	if opt.BaseDelay > 0 {
+		out.BaseDelay = opt.BaseDelay
+	}
+	if opt.MaxDelay > 0 {
+		out.MaxDelay = opt.MaxDelay
+	}
+	return &out
+}
+
+// delay before the next attempt is allowed after the given number of failures.
+// A single mistake is not delayed.
+func (opt *LockoutOptions) delay(failures int64) time.Duration {
+	if failures <= 1 {
+		return 0
+	}
+	d := opt.BaseDelay
+	for i := int64(2); i < failures && d < opt.MaxDelay; i++ {
+		d *= 2
+	}
+	if d > opt.MaxDelay {
+		d = opt.MaxDelay
+	}
+	return d
+}
+
+func signInSubject(projectID, username string) string {
+	return projectID + ":" + strings.ToLower(strings.TrimSpace(username))
+}
+
+func signInFailuresKey(subject string) string {
+	return "signinfailures:" + subject
+}
+
+func signInDelayKey(subject string) string {
+	return "signindelay:" + subject
+}
+
+func signInLockKey(subject string) string {
+	return "signinlock:" + subject
+}
+
+// username under which failed second factors of an account are counted, whichever username signed in
+func accountUsername(accountID uint) string {
+	return fmt.Sprintf("account:%d", accountID)
+}
+
+func ipSubject(ipAddress string) string {
+	return "ip:" + ipAddress
+}
+
+func retryError(code codes.Code, reason, msg string, retryAfter time.Duration) error {
+	st, err := status.New(code, msg).WithDetails(
+		&errdetails.ErrorInfo{Reason: reason, Domain: "accounts"},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)},
+	)
+	if err != nil {
+		return status.Error(code, msg)
+	}
+	return st.Err()
+}
+
+func accountLockedError(retryAfter time.Duration) error {
+	return retryError(
+		codes.ResourceExhausted,
+		"ACCOUNT_LOCKED",
+		fmt.Sprintf("account temporarily locked due to many failed sign in attempts; try again in %s", retryAfter.Round(time.Second)),
+		retryAfter,
+	)
+}
+
+// checks whether the username or client are allowed to attempt signing in
+func (accountAPI *accountAPIServer) checkSignInAllowed(ctx context.Context, projectID, username string) error {
+	var (
+		subject = signInSubject(projectID, username)
+		ipAddr  = clientIP(ctx, accountAPI.trustedProxies)
+	)
+
+	ttl, err := accountAPI.RedisDBWrites.PTTL(ctx, signInLockKey(subject)).Result()
+	if err != nil {
+		return errs.RedisCmdFailed(err, "PTTL")
+	}
+	if ttl > 0 {
+		return accountLockedError(ttl)
+	}
+
+	keys := []string{signInDelayKey(subject)}
+	if ipAddr != "" {
+		keys = append(keys, signInDelayKey(ipSubject(ipAddr)))
+	}
+
+	for _, key := range keys {
+		ttl, err := accountAPI.RedisDBWrites.PTTL(ctx, key).Result()
+		if err != nil {
+			return errs.RedisCmdFailed(err, "PTTL")
+		}
+		if ttl > 0 {
+			return retryError(
+				codes.Unavailable,
+				"SIGN_IN_THROTTLED",
+				fmt.Sprintf("too many failed sign in attempts; try again in %s", ttl.Round(time.Second)),
+				ttl,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (accountAPI *accountAPIServer) incrFailures(ctx context.Context, subject string) (int64, error) {
+	var incr *redis.IntCmd
+	_, err := accountAPI.RedisDBWrites.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, signInFailuresKey(subject))
+		pipe.Expire(ctx, signInFailuresKey(subject), accountAPI.lockout.FailureWindow)
+		return nil
+	})
+	if err != nil {
+		return 0, errs.RedisCmdFailed(err, "INCR")
+	}
+	return incr.Val(), nil
+}
+
+// records a failed sign in; returns an error if the account became locked.
+// db is nil when the username did not match any account.
+func (accountAPI *accountAPIServer) signInFailed(ctx context.Context, db *Account, projectID, username string) error {
+	var (
+		subject = signInSubject(projectID, username)
+		ipAddr  = clientIP(ctx, accountAPI.trustedProxies)
+		lockout = accountAPI.lockout
+	)
+
+	if ipAddr != "" {
+		failures, err := accountAPI.incrFailures(ctx, ipSubject(ipAddr))
+		if err != nil {
+			return err
+		}
+		// Clients behind a shared address are only slowed down past the threshold
+		if over := failures - int64(lockout.MaxFailedAttemptsPerIP); over >= 0 {
+			err = accountAPI.RedisDBWrites.Set(
+				ctx, signInDelayKey(ipSubject(ipAddr)), failures, lockout.delay(over+2),
+			).Err()
+			if err != nil {
+				return errs.RedisCmdFailed(err, "SET")
+			}
+		}
+	}
+
+	failures, err := accountAPI.incrFailures(ctx, subject)
+	if err != nil {
+		return err
+	}
+
+	if failures < int64(lockout.MaxFailedAttempts) {
+		if delay := lockout.delay(failures); delay > 0 {
+			err = accountAPI.RedisDBWrites.Set(ctx, signInDelayKey(subject), failures, delay).Err()
+			if err != nil {
+				return errs.RedisCmdFailed(err, "SET")
+			}
+		}
+		return nil
+	}
+
+	// Lock the account
+	_, err = accountAPI.RedisDBWrites.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, signInLockKey(subject), failures, lockout.LockDuration)
+		pipe.Del(ctx, signInFailuresKey(subject), signInDelayKey(subject))
+		return nil
+	})
+	if err != nil {
+		return errs.RedisCmdFailed(err, "SET")
+	}
+
+	accountAPI.Logger.Warningf(
+		"SECURITY account %s in project %s locked after %d failed sign in attempts from %s", username, projectID, failures, ipAddr,
+	)
+
+	if db != nil && lockout.NotifyOnLock {
+		go accountAPI.notifyLocked(db, lockout.LockDuration)
+	}
+
+	return accountLockedError(lockout.LockDuration)
+}
+
+// clears failed attempts after a successful sign in
+func (accountAPI *accountAPIServer) signInSucceeded(ctx context.Context, projectID, username string) error {
+	subject := signInSubject(projectID, username)
+	err := accountAPI.RedisDBWrites.Del(ctx, signInFailuresKey(subject), signInDelayKey(subject)).Err()
+	if err != nil {
+		return errs.RedisCmdFailed(err, "DEL")
+	}
+	return nil
+}
+
+func (accountAPI *accountAPIServer) notifyLocked(db *Account, lockDuration time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	accountID := fmt.Sprint(db.AccountID)
+
+	jwt, err := accountAPI.AuthAPI.GenToken(ctx, &auth.Payload{
+		ID:           accountID,
+		ProjectID:    db.ProjectID,
+		Names:        db.Names,
+		EmailAddress: db.Email,
+		PhoneNumber:  db.Phone,
+		Group:        db.PrimaryGroup,
+	}, time.Now().Add(5*time.Minute))
+	if err != nil {
+		accountAPI.Logger.Errorf("failed to generate token for account locked message: %v", err)
+		return
+	}
+
+	ctxExt := metadata.NewOutgoingContext(ctx, metadata.Pairs(auth.Header(), fmt.Sprintf("Bearer %s", jwt)))
+
+	data := fmt.Sprintf(
+		"Hello %s, your account has been locked for %s after several failed sign in attempts. "+
+			"If this was not you, please change your password.",
+		db.Names, lockDuration.Round(time.Minute),
+	)
+
+	_, err = accountAPI.MessagingClient.SendMessage(ctxExt, &messaging.SendMessageRequest{
+		Message: &messaging.Message{
+			UserId:      accountID,
+			Title:       "Your Account Has Been Temporarily Locked",
+			Data:        data,
+			Save:        true,
+			Type:        messaging.MessageType_ALERT,
+			SendMethods: []messaging.SendMethod{messaging.SendMethod_EMAIL, messaging.SendMethod_SMSV2},
+		},
+	})
+	if err != nil {
+		accountAPI.Logger.Errorf("error while sending account locked message: %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/gidyon/services/pkg/api/account"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Locking out failed sign ins @lockout", func() {
+	var (
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	Describe("Computing delays between attempts", func() {
+		It("should not delay a single failure and double afterwards up to the maximum", func() {
+			opt := (&LockoutOptions{BaseDelay: time.Second, MaxDelay: 5 * time.Second}).withDefaults()
+			Expect(opt.delay(0)).Should(BeZero())
+			Expect(opt.delay(1)).Should(BeZero())
+			Expect(opt.delay(2)).Should(Equal(time.Second))
+			Expect(opt.delay(3)).Should(Equal(2 * time.Second))
+			Expect(opt.delay(4)).Should(Equal(4 * time.Second))
+			Expect(opt.delay(5)).Should(Equal(5 * time.Second))
+			Expect(opt.delay(50)).Should(Equal(5 * time.Second))
+		})
+		It("should use defaults for missing values", func() {
+			opt := (*LockoutOptions)(nil).withDefaults()
+			Expect(*opt).Should(Equal(DefaultLockoutOptions))
+		})
+	})
+
+	Describe("Signing in with wrong password many times", func() {
+		var email, password, group string
+		var lockout *LockoutOptions
+
+		BeforeEach(func() {
+			lockout = AccountAPIServer.lockout
+			AccountAPIServer.lockout = (&LockoutOptions{
+				MaxFailedAttempts: 3,
+				BaseDelay:         time.Millisecond,
+				MaxDelay:          time.Millisecond,
+			}).withDefaults()
+		})
+
+		AfterEach(func() {
+			AccountAPIServer.lockout = lockout
+		})
+
+		It("should create an account without error", func() {
+			createReq := &account.CreateAccountRequest{
+				Account:        fakeAccount(),
+				PrivateAccount: fakePrivateAccount(),
+				ProjectId:      projectID,
+			}
+			_, err := AccountAPI.CreateAccount(ctx, createReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			email = createReq.Account.Email
+			password = createReq.PrivateAccount.Password
+			group = createReq.Account.Group
+		})
+
+		It("should lock the account after reaching the threshold", func() {
+			signInReq := &account.SignInRequest{
+				Username:  email,
+				Password:  "incorrect",
+				Group:     group,
+				ProjectId: projectID,
+			}
+
+			for i := 0; i < 2; i++ {
+				_, err := AccountAPI.SignIn(ctx, signInReq)
+				Expect(err).Should(HaveOccurred())
+				Expect(status.Code(err)).ShouldNot(Equal(codes.ResourceExhausted))
+				time.Sleep(5 * time.Millisecond)
+			}
+
+			_, err := AccountAPI.SignIn(ctx, signInReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.ResourceExhausted))
+
+			// Correct password is refused while locked
+			signInReq.Password = password
+			signInRes, err := AccountAPI.SignIn(ctx, signInReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.ResourceExhausted))
+			Expect(signInRes).Should(BeNil())
+		})
+	})
+})
@@ -6,24 +6,16 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"os"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
 	"github.com/gidyon/micro/v2/utils/errs"
 	"github.com/gidyon/services/pkg/api/account"
-	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"gorm.io/gorm"
 )
 
-func refreshTokenSet() string {
-	year, month, day := time.Now().Date()
-	return fmt.Sprintf("refreshtokens:%d-%d-%d", year, month, day)
-}
-
 func (accountAPI *accountAPIServer) SignIn(
 	ctx context.Context, req *account.SignInRequest,
 ) (*account.SignInResponse, error) {
@@ -46,12 +38,21 @@ func (accountAPI *accountAPIServer) SignIn(
 		return nil, err
 	}
 
+	// Phone usernames are compared and locked out in E.164
+	username := accountAPI.usernamePhone(req.ProjectId, req.Username)
+
+	// Check whether too many failed attempts have been made
+	err = accountAPI.checkSignInAllowed(ctx, req.ProjectId, username)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check whtether account exist
 	db := &Account{}
 
 	// Query for user with email or phone or huduma id
 	err = accountAPI.SQLDBWrites.First(
-		db, "(phone=? OR email=?) AND project_id=?", req.Username, req.Username, req.ProjectId,
+		db, "(phone=? OR email=?) AND project_id=?", username, req.Username, req.ProjectId,
 	).Error
 	switch {
 	case err == nil:
@@ -65,6 +66,10 @@ func (accountAPI *accountAPIServer) SignIn(
 			}
 			return "username " + req.Username
 		}
+		err = accountAPI.signInFailed(ctx, nil, req.ProjectId, username)
+		if err != nil {
+			return nil, err
+		}
 		return nil, errs.WrapMessagef(codes.NotFound, "account with %s not found", emailOrPhone())
 	default:
 		return nil, errs.SQLQueryFailed(err, "LOGIN")
@@ -87,12 +92,45 @@ func (accountAPI *accountAPIServer) SignIn(
 		return nil, errs.WrapMessage(codes.FailedPrecondition, "account blocked")
 	}
 
+	// Failed second factors lock the account for every username
+	err = accountAPI.checkSignInAllowed(ctx, req.ProjectId, accountUsername(db.AccountID))
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if password match if they logged in with Phone or Email
-	err = compareHash(db.Password, req.Password)
+	err = accountAPI.compareHash(db.Password, req.Password)
 	if err != nil {
+		err = accountAPI.signInFailed(ctx, db, req.ProjectId, username)
+		if err != nil {
+			return nil, err
+		}
 		return nil, errs.WrapMessage(codes.Internal, "wrong password")
 	}
 
+	// Raise hashing cost of stored password if policy changed
+	accountAPI.rehashPassword(db, req.Password)
+
+	// Accounts with two-factor authentication must complete a second step before anything is issued.
+	// Failed attempts are cleared once the second factor passes.
+	mfaDB, err := accountAPI.getMFA(ctx, db.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	if mfaDB != nil && mfaDB.Enabled {
+		return accountAPI.mfaChallenge(ctx, db, req.GetGroup(), username)
+	}
+
+	err = accountAPI.signInSucceeded(ctx, req.ProjectId, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Password must be changed before signing in
+	if accountAPI.passwordExpired(db) {
+		return accountAPI.passwordExpiredResponse(ctx, db)
+	}
+
 	// Update last login
 	err = accountAPI.SQLDBWrites.Model(db).Update("last_login", time.Now()).Error
 	if err != nil {
@@ -102,14 +140,32 @@ func (accountAPI *accountAPIServer) SignIn(
 	return accountAPI.updateSession(ctx, db, req.GetGroup())
 }
 
+// starts a new session for the account and issues its tokens
 func (accountAPI *accountAPIServer) updateSession(
 	ctx context.Context, db *Account, signInGroup string,
+) (*account.SignInResponse, error) {
+	sess, refreshToken, err := accountAPI.createSession(ctx, db.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountAPI.recordSignIn(db)
+
+	return accountAPI.sessionResponse(ctx, db, signInGroup, sess.ID, refreshToken)
+}
+
+// lifetime of jwt issued on sign in
+func tokenLifetime() time.Duration {
+	return envMinutes("TOKEN_EXPIRATION_MINUTES", 30)
+}
+
+func (accountAPI *accountAPIServer) sessionResponse(
+	ctx context.Context, db *Account, signInGroup, sessionID, refreshToken string,
 ) (*account.SignInResponse, error) {
 	var (
-		accountID    = fmt.Sprint(db.AccountID)
-		refreshToken = uuid.New().String()
-		token        string
-		err          error
+		accountID = fmt.Sprint(db.AccountID)
+		token     string
+		err       error
 	)
 
 	// Secondary groups
@@ -123,12 +179,6 @@ func (accountAPI *accountAPIServer) updateSession(
 
 	signInGroup = strings.ToUpper(signInGroup)
 
-	durStr := os.Getenv("TOKEN_EXPIRATION_MINUTES")
-	dur, err := strconv.Atoi(durStr)
-	if err != nil {
-		dur = 30
-	}
-
 	if signInGroup != "" {
 		var found bool
 		for _, group := range append(secondaryGroups, db.PrimaryGroup) {
@@ -144,7 +194,7 @@ func (accountAPI *accountAPIServer) updateSession(
 					EmailAddress: db.Email,
 					PhoneNumber:  db.Phone,
 					Roles:        secondaryGroups,
-				}, time.Now().Add(time.Duration(dur)*time.Minute))
+				}, time.Now().Add(tokenLifetime()))
 				if err != nil {
 					return nil,
 						errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate token")
@@ -167,19 +217,13 @@ func (accountAPI *accountAPIServer) updateSession(
 			EmailAddress: db.Email,
 			PhoneNumber:  db.Phone,
 			Roles:        secondaryGroups,
-		}, time.Now().Add(time.Duration(dur)*time.Minute))
+		}, time.Now().Add(tokenLifetime()))
 		if err != nil {
 			return nil,
 				errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate token")
 		}
 	}
 
-	// Set refresh token ~ Needs cleanup
-	err = accountAPI.RedisDBWrites.SAdd(ctx, refreshTokenSet(), refreshToken, 0).Err()
-	if err != nil {
-		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to set refresh token")
-	}
-
 	// Get account
 	pb, err := AccountProto(db)
 	if err != nil {
@@ -225,6 +269,7 @@ func (accountAPI *accountAPIServer) updateSession(
 
 	// Return token
 	return &account.SignInResponse{
+		SessionId:       sessionID,
 		AccountId:       accountID,
 		Token:           token,
 		RefreshToken:    refreshToken,
@@ -7,6 +7,7 @@ import (
 	"github.com/Pallinder/go-randomdata"
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
 	"github.com/gidyon/services/pkg/api/account"
+	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -158,4 +159,42 @@ var _ = Describe("SignIn Account @signIn", func() {
 			})
 		})
 	})
+
+	Describe("SignIn to an account with a legacy password hash", func() {
+		var accountID, email, password, group string
+		It("should create account without error", func() {
+			createReq := &account.CreateAccountRequest{
+				Account:        fakeAccount(),
+				PrivateAccount: fakePrivateAccount(),
+				ProjectId:      "test",
+			}
+			createRes, err := AccountAPI.CreateAccount(ctx, createReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			accountID = createRes.AccountId
+			email = createReq.Account.Email
+			password = createReq.PrivateAccount.Password
+			group = createReq.Account.Group
+
+			legacy, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+			Expect(err).ShouldNot(HaveOccurred())
+			err = AccountAPIServer.SQLDBWrites.Model(&Account{}).Where("account_id=?", accountID).
+				Update("password", string(legacy)).Error
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+		It("should sign in and upgrade the password hash", func() {
+			signInRes, err := AccountAPI.SignIn(ctx, &account.SignInRequest{
+				Username:  email,
+				Password:  password,
+				Group:     group,
+				ProjectId: "test",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(signInRes.AccountId).Should(Equal(accountID))
+
+			db := &Account{}
+			err = AccountAPIServer.SQLDBWrites.Select("password").First(db, "account_id=?", accountID).Error
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(db.Password).Should(HavePrefix("$argon2id$"))
+		})
+	})
 })
@@ -0,0 +1,223 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/micro/v2/utils/mdutil"
+	"github.com/gidyon/micro/v2/utils/templateutil"
+	"github.com/gidyon/services/pkg/api/account"
+	"github.com/gidyon/services/pkg/api/messaging"
+	"github.com/go-redis/redis/v8"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const magicLinkTemplate = "magiclink"
+
+// lifetime of magic links
+func magicLinkLifetime() time.Duration {
+	return envMinutes("MAGIC_LINK_MINUTES", 15)
+}
+
+func magicLinkKey(linkID string) string {
+	return "magiclink:" + linkID
+}
+
+// signs the link for the account and device so that a link only works on the device that requested it
+func (accountAPI *accountAPIServer) magicLinkSignature(linkID, accountID, deviceID string) []byte {
+	mac := hmac.New(sha256.New, accountAPI.TokenSigningKey)
+	fmt.Fprintf(mac, "magiclink|%s|%s|%s", linkID, accountID, deviceID)
+	return mac.Sum(nil)
+}
+
+func (accountAPI *accountAPIServer) RequestMagicLink(
+	ctx context.Context, req *account.RequestMagicLinkRequest,
+) (*empty.Empty, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("request magic link request")
+	case req.Email == "":
+		return nil, errs.MissingField("email")
+	case req.DeviceId == "":
+		return nil, errs.MissingField("device id")
+	}
+
+	if len(accountAPI.TokenSigningKey) == 0 || accountAPI.MagicLinkURL == "" {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "magic link sign in is not enabled")
+	}
+
+	db := &Account{}
+	query := accountAPI.SQLDBWrites.Where("email=?", req.Email)
+	if req.Project != "" {
+		query = query.Where("project_id=?", req.Project)
+	}
+	err := query.First(db).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		db = nil
+	default:
+		return nil, errs.FailedToFind("account", err)
+	}
+
+	// No link is sent to blocked accounts
+	if db != nil && db.AccountState == blockedState {
+		db = nil
+	}
+
+	// Every request gets the same response so that emails cannot be enumerated. Requests still count
+	// against the OTP limits and nothing is sent once they are exceeded.
+	err = accountAPI.checkOTPAllowed(ctx, db)
+	switch {
+	case status.Code(err) == codes.ResourceExhausted:
+		return &empty.Empty{}, nil
+	case err != nil:
+		return nil, err
+	case db == nil:
+		return &empty.Empty{}, nil
+	}
+
+	linkID, err := randomToken(24)
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate magic link")
+	}
+
+	var (
+		accountID = fmt.Sprint(db.AccountID)
+		lifetime  = magicLinkLifetime()
+		signature = accountAPI.magicLinkSignature(linkID, accountID, req.DeviceId)
+		token     = linkID + "." + base64.RawURLEncoding.EncodeToString(signature)
+		link      = fmt.Sprintf("%s?token=%s", accountAPI.MagicLinkURL, token)
+		appName   = firstVal(req.GetSender().GetAppName(), accountAPI.AppName)
+	)
+
+	// The key is deleted on the first sign in so that links cannot be replayed
+	err = accountAPI.RedisDBWrites.Set(ctx, magicLinkKey(linkID), accountID, lifetime).Err()
+	if err != nil {
+		return nil, errs.RedisCmdFailed(err, "SET")
+	}
+
+	data := fmt.Sprintf(
+		"Use the following link to sign in to your %s account. The link can be used once and expires in %s. <br> <a href=\"%s\" target=\"blank\">Sign in</a>",
+		appName, lifetime, link,
+	)
+
+	emailData := data
+	if accountAPI.tpl != nil {
+		content := bytes.NewBuffer(make([]byte, 0, 64))
+		err = accountAPI.tpl.ExecuteTemplate(content, templateName, templateutil.EmailData{
+			Names:        db.Names,
+			AccountID:    accountID,
+			Link:         link,
+			Label:        lifetime.String(),
+			AppName:      appName,
+			TemplateName: magicLinkTemplate,
+		})
+		if err != nil {
+			return nil, errs.WrapMessage(codes.Internal, "failed to execute template")
+		}
+		emailData = content.String()
+	}
+
+	ctx, cancel := context.WithTimeout(mdutil.AddFromCtx(ctx), 5*time.Second)
+	defer cancel()
+
+	_, err = accountAPI.MessagingClient.SendMessage(ctx, &messaging.SendMessageRequest{
+		Message: &messaging.Message{
+			Title:       fmt.Sprintf("Sign in to %s", appName),
+			Data:        data,
+			EmailData:   emailData,
+			Link:        link,
+			Type:        messaging.MessageType_ALERT,
+			SendMethods: []messaging.SendMethod{messaging.SendMethod_EMAIL},
+		},
+		Sender: req.GetSender(),
+		Email:  db.Email,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, errs.WrapErrorWithMsg(err, "failed to send magic link")
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (accountAPI *accountAPIServer) SignInMagicLink(
+	ctx context.Context, req *account.SignInMagicLinkRequest,
+) (*account.SignInResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("sign in magic link request")
+	case req.Token == "":
+		return nil, errs.MissingField("token")
+	case req.DeviceId == "":
+		return nil, errs.MissingField("device id")
+	}
+
+	if len(accountAPI.TokenSigningKey) == 0 {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "magic link sign in is not enabled")
+	}
+
+	parts := strings.SplitN(req.Token, ".", 2)
+	if len(parts) != 2 {
+		return nil, errs.WrapMessage(codes.Unauthenticated, "invalid magic link")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errs.WrapMessage(codes.Unauthenticated, "invalid magic link")
+	}
+
+	linkID := parts[0]
+
+	accountID, err := accountAPI.RedisDBWrites.Get(ctx, magicLinkKey(linkID)).Result()
+	switch {
+	case err == nil:
+	case errors.Is(err, redis.Nil):
+		return nil, errs.WrapMessage(codes.Unauthenticated, "magic link is invalid, expired or already used")
+	default:
+		return nil, errs.RedisCmdFailed(err, "GET")
+	}
+
+	// Links opened on other devices are not consumed so the requesting device can still use them
+	if !hmac.Equal(signature, accountAPI.magicLinkSignature(linkID, accountID, req.DeviceId)) {
+		return nil, errs.WrapMessage(codes.PermissionDenied, "magic link was requested from another device")
+	}
+
+	// Only the request that deletes the key signs in
+	deleted, err := accountAPI.RedisDBWrites.Del(ctx, magicLinkKey(linkID)).Result()
+	if err != nil {
+		return nil, errs.RedisCmdFailed(err, "DEL")
+	}
+	if deleted == 0 {
+		return nil, errs.WrapMessage(codes.Unauthenticated, "magic link is invalid, expired or already used")
+	}
+
+	db := &Account{}
+	err = accountAPI.SQLDBWrites.First(db, "account_id=?", accountID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.WrapMessage(codes.NotFound, "account does not exist")
+	default:
+		return nil, errs.FailedToFind("account", err)
+	}
+
+	if db.AccountState == blockedState {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "account blocked")
+	}
+
+	return accountAPI.signInSession(ctx, db, req.Group)
+}
@@ -0,0 +1,161 @@
+package account
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/Pallinder/go-randomdata"
+	micro_mocks "github.com/gidyon/micro/v2/pkg/mocks/mocks"
+	"github.com/gidyon/services/pkg/api/account"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Signing in with magic links @magiclink", func() {
+	var (
+		ctx       context.Context
+		email     string
+		accountID string
+		deviceID  = randomdata.RandStringRunes(16)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	// ids of links sent to the account
+	linkIDs := func(accountID string) []string {
+		keys, err := AccountAPIServer.RedisDBWrites.Keys(ctx, magicLinkKey("*")).Result()
+		Expect(err).ShouldNot(HaveOccurred())
+		ids := make([]string, 0)
+		for _, key := range keys {
+			val, err := AccountAPIServer.RedisDBWrites.Get(ctx, key).Result()
+			if err == nil && val == accountID {
+				ids = append(ids, strings.TrimPrefix(key, magicLinkKey("")))
+			}
+		}
+		return ids
+	}
+
+	// finds the link sent to the account and signs it for the device like the emailed link
+	linkToken := func(device string) string {
+		ids := linkIDs(accountID)
+		if len(ids) == 0 {
+			Fail("magic link of the account not found")
+		}
+		signature := AccountAPIServer.magicLinkSignature(ids[0], accountID, device)
+		return ids[0] + "." + base64.RawURLEncoding.EncodeToString(signature)
+	}
+
+	It("should create an account", func() {
+		AccountAPIServer.TokenSigningKey = []byte(randomdata.RandStringRunes(32))
+		AccountAPIServer.MagicLinkURL = "https://example.com/magic-link"
+
+		pb := fakeAccount()
+		pb.ProjectId = projectID
+		db, err := AccountModel(pb)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(AccountAPIServer.SQLDBWrites.Create(db).Error).ShouldNot(HaveOccurred())
+		accountID = fmt.Sprint(db.AccountID)
+		email = db.Email
+	})
+
+	It("should fail when the request is malformed", func() {
+		_, err := AccountAPI.RequestMagicLink(ctx, &account.RequestMagicLinkRequest{DeviceId: deviceID})
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+
+		_, err = AccountAPI.RequestMagicLink(ctx, &account.RequestMagicLinkRequest{Email: email})
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+
+		_, err = AccountAPI.SignInMagicLink(ctx, &account.SignInMagicLinkRequest{Token: "token"})
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("should respond the same without sending links to accounts that do not exist or are blocked", func() {
+		_, err := AccountAPI.RequestMagicLink(ctx, &account.RequestMagicLinkRequest{
+			Email:    randomdata.Email(),
+			DeviceId: deviceID,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		pb := fakeAccount()
+		pb.ProjectId = projectID
+		db, err := AccountModel(pb)
+		Expect(err).ShouldNot(HaveOccurred())
+		db.AccountState = blockedState
+		Expect(AccountAPIServer.SQLDBWrites.Create(db).Error).ShouldNot(HaveOccurred())
+
+		_, err = AccountAPI.RequestMagicLink(ctx, &account.RequestMagicLinkRequest{
+			Email:    db.Email,
+			Project:  projectID,
+			DeviceId: deviceID,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(linkIDs(fmt.Sprint(db.AccountID))).Should(BeEmpty())
+	})
+
+	It("should send a magic link and sign in once from the requesting device", func() {
+		_, err := AccountAPI.RequestMagicLink(ctx, &account.RequestMagicLinkRequest{
+			Email:    email,
+			Project:  projectID,
+			DeviceId: deviceID,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		// Another device cannot use the link
+		_, err = AccountAPI.SignInMagicLink(ctx, &account.SignInMagicLinkRequest{
+			Token:    linkToken(deviceID),
+			DeviceId: "another device",
+		})
+		Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+
+		token := linkToken(deviceID)
+		res, err := AccountAPI.SignInMagicLink(ctx, &account.SignInMagicLinkRequest{
+			Token:    token,
+			DeviceId: deviceID,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(res.AccountId).Should(Equal(accountID))
+		Expect(res.Token).ShouldNot(BeEmpty())
+
+		// Links cannot be replayed
+		_, err = AccountAPI.SignInMagicLink(ctx, &account.SignInMagicLinkRequest{
+			Token:    token,
+			DeviceId: deviceID,
+		})
+		Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+	})
+
+	It("should respond the same without sending a link while the OTP cooldown lasts", func() {
+		_, err := AccountAPI.RequestMagicLink(ctx, &account.RequestMagicLinkRequest{
+			Email:    email,
+			Project:  projectID,
+			DeviceId: deviceID,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(linkIDs(accountID)).Should(BeEmpty())
+	})
+
+	It("should not require an access token to request or use a link", func() {
+		authAPI := &micro_mocks.AuthAPIMock{}
+		authAPI.On("AuthorizeFunc", mock.Anything).Return(nil, status.Error(codes.Unauthenticated, "no token"))
+		accountAPI := &accountAPIServer{Options: &Options{AuthAPI: authAPI}}
+
+		for _, method := range []string{"RequestMagicLink", "SignInMagicLink"} {
+			_, err := accountAPI.AuthFuncOverride(ctx, "/gidyon.apis.AccountAPI/"+method)
+			Expect(err).ShouldNot(HaveOccurred())
+		}
+		authAPI.AssertNotCalled(GinkgoT(), "AuthorizeFunc", mock.Anything)
+	})
+
+	It("should fail for forged tokens", func() {
+		_, err := AccountAPI.SignInMagicLink(ctx, &account.SignInMagicLinkRequest{
+			Token:    "forged.token",
+			DeviceId: deviceID,
+		})
+		Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+	})
+})
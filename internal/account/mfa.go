@@ -0,0 +1,630 @@
+package account
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/internal/pkg/sqldb"
+	"github.com/gidyon/services/internal/pkg/totp"
+	"github.com/gidyon/services/pkg/api/account"
+	redis "github.com/go-redis/redis/v8"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	mfaTable         = "account_mfa"
+	mfaChallengeTTL  = 5 * time.Minute
+	backupCodesCount = 10
+)
+
+// MFA contains the two-factor authentication settings of an account
+type MFA struct {
+	AccountID   uint   `gorm:"primaryKey;autoIncrement:false"`
+	TOTPSecret  string `gorm:"type:varchar(256);not null"`
+	BackupCodes []byte `gorm:"type:json"`
+	Enabled     bool   `gorm:"index;not null;default:false"`
+	ConfirmedAt *time.Time
+	CreatedAt   time.Time `gorm:"precision:6;not null"`
+	UpdatedAt   time.Time `gorm:"precision:6"`
+}
+
+// TableName is the name of the table
+func (*MFA) TableName() string {
+	return mfaTable
+}
+
+type mfaChallenge struct {
+	AccountID uint   `json:"account_id"`
+	Group     string `json:"group"`
+	// Username of a password sign in. The age of the password is checked and failed attempts
+	// on the username are cleared once the second factor passes.
+	Username string `json:"username,omitempty"`
+}
+
+func mfaChallengeKey(token string) string {
+	return "mfachallenge:" + token
+}
+
+func mfaTrialsKey(token string) string {
+	return "mfatrials:" + token
+}
+
+func totpUsedKey(accountID uint, counter uint64) string {
+	return fmt.Sprintf("totpused:%d:%d", accountID, counter)
+}
+
+func (accountAPI *accountAPIServer) getMFA(ctx context.Context, accountID uint) (*MFA, error) {
+	mfaDB := &MFA{}
+	err := accountAPI.SQLDBWrites.First(mfaDB, "account_id=?", accountID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, nil
+	default:
+		return nil, errs.FailedToFind("mfa", err)
+	}
+	return mfaDB, nil
+}
+
+// issues a short-lived challenge that must be completed through SignInMFA.
+// username is empty when the first factor was not a password.
+func (accountAPI *accountAPIServer) mfaChallenge(
+	ctx context.Context, db *Account, signInGroup, username string,
+) (*account.SignInResponse, error) {
+	bs, err := json.Marshal(&mfaChallenge{
+		AccountID: db.AccountID,
+		Group:     signInGroup,
+		Username:  username,
+	})
+	if err != nil {
+		return nil, errs.FromJSONMarshal(err, "mfa challenge")
+	}
+
+	token := uuid.New().String()
+
+	err = accountAPI.RedisDBWrites.Set(ctx, mfaChallengeKey(token), bs, mfaChallengeTTL).Err()
+	if err != nil {
+		return nil, errs.RedisCmdFailed(err, "SET")
+	}
+
+	return &account.SignInResponse{
+		AccountId:   fmt.Sprint(db.AccountID),
+		State:       account.AccountState(account.AccountState_value[db.AccountState]),
+		MfaRequired: true,
+		MfaToken:    token,
+	}, nil
+}
+
+// starts a session for an account signed in without a password, or issues a challenge
+// when the account has two-factor authentication
+func (accountAPI *accountAPIServer) signInSession(
+	ctx context.Context, db *Account, signInGroup string,
+) (*account.SignInResponse, error) {
+	mfaDB, err := accountAPI.getMFA(ctx, db.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	if mfaDB != nil && mfaDB.Enabled {
+		return accountAPI.mfaChallenge(ctx, db, signInGroup, "")
+	}
+
+	// Update last login
+	err = accountAPI.SQLDBWrites.Model(db).Update("last_login", time.Now()).Error
+	if err != nil {
+		return nil, errs.WrapMessage(codes.Internal, "failed to update last login")
+	}
+
+	return accountAPI.updateSession(ctx, db, signInGroup)
+}
+
+func (accountAPI *accountAPIServer) encryptSecret(secret string) (string, error) {
+	bs, err := accountAPI.EncryptionAPI.Encrypt([]byte(secret))
+	if err != nil {
+		return "", errs.FailedToEncrypt(err)
+	}
+	return base64.StdEncoding.EncodeToString(bs), nil
+}
+
+func (accountAPI *accountAPIServer) decryptSecret(secret string) (string, error) {
+	if accountAPI.EncryptionAPI == nil {
+		return "", errs.WrapMessage(codes.FailedPrecondition, "two-factor authentication not available")
+	}
+	bs, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		return "", errs.FailedToDecrypt(err)
+	}
+	bs, err = accountAPI.EncryptionAPI.Decrypt(bs)
+	if err != nil {
+		return "", errs.FailedToDecrypt(err)
+	}
+	return string(bs), nil
+}
+
+func normalizeBackupCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+func hashBackupCode(code string) string {
+	sum := sha256.Sum256([]byte(normalizeBackupCode(code)))
+	return hex.EncodeToString(sum[:])
+}
+
+// generates backup codes returning the codes and their json encoded hashes
+func newBackupCodes() ([]string, []byte, error) {
+	var (
+		enc    = base32.StdEncoding.WithPadding(base32.NoPadding)
+		codes  = make([]string, 0, backupCodesCount)
+		hashes = make([]string, 0, backupCodesCount)
+	)
+
+	for i := 0; i < backupCodesCount; i++ {
+		bs := make([]byte, 7)
+		_, err := rand.Read(bs)
+		if err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(enc.EncodeToString(bs))[:10]
+		codes = append(codes, code[:5]+"-"+code[5:])
+		hashes = append(hashes, hashBackupCode(code))
+	}
+
+	bs, err := json.Marshal(hashes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return codes, bs, nil
+}
+
+// verifies a TOTP code or, if allowed, a backup code which is then consumed
+func (accountAPI *accountAPIServer) verifySecondFactor(
+	ctx context.Context, mfaDB *MFA, code string, allowBackupCode bool,
+) error {
+	secret, err := accountAPI.decryptSecret(mfaDB.TOTPSecret)
+	if err != nil {
+		return err
+	}
+
+	counter, ok := totp.Validate(secret, code, time.Now())
+	if ok {
+		// A code can only be used once
+		ok, err = accountAPI.RedisDBWrites.SetNX(
+			ctx, totpUsedKey(mfaDB.AccountID, counter), 1, (2*totp.Skew+1)*totp.Period*time.Second,
+		).Result()
+		if err != nil {
+			return errs.RedisCmdFailed(err, "SETNX")
+		}
+		if !ok {
+			return errs.WrapMessage(codes.Unauthenticated, "two-factor authentication code already used")
+		}
+		return nil
+	}
+
+	if allowBackupCode && len(mfaDB.BackupCodes) != 0 {
+		hashes := make([]string, 0, backupCodesCount)
+		err = json.Unmarshal(mfaDB.BackupCodes, &hashes)
+		if err != nil {
+			return errs.FromJSONUnMarshal(err, "backup codes")
+		}
+
+		hashed := hashBackupCode(code)
+
+		for i, hash := range hashes {
+			if subtle.ConstantTimeCompare([]byte(hash), []byte(hashed)) != 1 {
+				continue
+			}
+
+			// Backup codes are single use
+			bs, err := json.Marshal(append(hashes[:i:i], hashes[i+1:]...))
+			if err != nil {
+				return errs.FromJSONMarshal(err, "backup codes")
+			}
+
+			// Only one of concurrent requests with the same code removes it
+			tx := accountAPI.SQLDBWrites.Model(&MFA{}).
+				Where("account_id=?", mfaDB.AccountID).
+				Where(sqldb.JSONEqual(accountAPI.SQLDBWrites, "backup_codes"), string(mfaDB.BackupCodes)).
+				Update("backup_codes", bs)
+			if tx.Error != nil {
+				return errs.FailedToUpdate("backup codes", tx.Error)
+			}
+			if tx.RowsAffected != 1 {
+				return errs.WrapMessage(codes.Unauthenticated, "backup code already used")
+			}
+			return nil
+		}
+	}
+
+	return errs.WrapMessage(codes.Unauthenticated, "incorrect two-factor authentication code")
+}
+
+func (accountAPI *accountAPIServer) SignInMFA(
+	ctx context.Context, req *account.SignInMFARequest,
+) (*account.SignInResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("sign in mfa request")
+	case req.MfaToken == "":
+		return nil, errs.MissingField("mfa token")
+	case req.Code == "":
+		return nil, errs.MissingField("code")
+	}
+
+	// Get the challenge
+	bs, err := accountAPI.RedisDBWrites.Get(ctx, mfaChallengeKey(req.MfaToken)).Bytes()
+	switch {
+	case err == nil:
+	case errors.Is(err, redis.Nil):
+		return nil, errs.WrapMessage(codes.Unauthenticated, "sign in challenge expired, sign in again")
+	default:
+		return nil, errs.RedisCmdFailed(err, "GET")
+	}
+
+	challenge := &mfaChallenge{}
+	err = json.Unmarshal(bs, challenge)
+	if err != nil {
+		return nil, errs.FromJSONUnMarshal(err, "mfa challenge")
+	}
+
+	// Increment trials by 1
+	trialsKey := mfaTrialsKey(req.MfaToken)
+	trials, err := accountAPI.RedisDBWrites.Incr(ctx, trialsKey).Result()
+	if err != nil {
+		return nil, errs.RedisCmdFailed(err, "INCR")
+	}
+	accountAPI.RedisDBWrites.Expire(ctx, trialsKey, mfaChallengeTTL)
+
+	// Check if exceed trials
+	if trials > maxTrials {
+		err = accountAPI.RedisDBWrites.Del(ctx, mfaChallengeKey(req.MfaToken), trialsKey).Err()
+		if err != nil {
+			return nil, errs.RedisCmdFailed(err, "DEL")
+		}
+		return nil, errs.WrapMessage(codes.PermissionDenied, "too many attempts, sign in again")
+	}
+
+	// Get the account
+	db := &Account{}
+	err = accountAPI.SQLDBWrites.First(db, "account_id=?", challenge.AccountID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.WrapMessage(codes.NotFound, "account does not exist")
+	default:
+		return nil, errs.FailedToFind("account", err)
+	}
+
+	if db.AccountState == blockedState {
+		return nil, errs.WrapMessage(codes.PermissionDenied, "account is blocked")
+	}
+
+	mfaDB, err := accountAPI.getMFA(ctx, db.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	if mfaDB == nil || !mfaDB.Enabled {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	}
+
+	// Failed second factors are counted for the account across challenges
+	err = accountAPI.checkSignInAllowed(ctx, db.ProjectID, accountUsername(db.AccountID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = accountAPI.verifySecondFactor(ctx, mfaDB, req.Code, true)
+	if err != nil {
+		if status.Code(err) != codes.Unauthenticated {
+			return nil, err
+		}
+		errLock := accountAPI.signInFailed(ctx, db, db.ProjectID, accountUsername(db.AccountID))
+		if errLock != nil {
+			return nil, errLock
+		}
+		return nil, err
+	}
+
+	// Challenge can only be completed once
+	err = accountAPI.RedisDBWrites.Del(ctx, mfaChallengeKey(req.MfaToken), trialsKey).Err()
+	if err != nil {
+		return nil, errs.RedisCmdFailed(err, "DEL")
+	}
+
+	err = accountAPI.signInSucceeded(ctx, db.ProjectID, accountUsername(db.AccountID))
+	if err != nil {
+		return nil, err
+	}
+	if challenge.Username != "" {
+		err = accountAPI.signInSucceeded(ctx, db.ProjectID, challenge.Username)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Password must be changed before signing in
+	if challenge.Username != "" && accountAPI.passwordExpired(db) {
+		return accountAPI.passwordExpiredResponse(ctx, db)
+	}
+
+	// Update last login
+	err = accountAPI.SQLDBWrites.Model(db).Update("last_login", time.Now()).Error
+	if err != nil {
+		return nil, errs.WrapMessage(codes.Internal, "failed to update last login")
+	}
+
+	return accountAPI.updateSession(ctx, db, challenge.Group)
+}
+
+func (accountAPI *accountAPIServer) EnrollTOTP(
+	ctx context.Context, req *account.EnrollTOTPRequest,
+) (*account.EnrollTOTPResponse, error) {
+	if accountAPI.EncryptionAPI == nil {
+		return nil, errs.WrapMessage(codes.Unavailable, "two-factor authentication not available")
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("enroll totp request")
+	case req.AccountId == "":
+		return nil, errs.MissingField("account id")
+	}
+
+	if err := denyImpersonation(ctx); err != nil {
+		return nil, err
+	}
+
+	// Only the account owner can enroll
+	_, err := accountAPI.AuthAPI.AuthorizeActor(ctx, req.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	db := &Account{}
+	err = accountAPI.SQLDBWrites.Select("account_id,email,phone,account_state").
+		First(db, "account_id=?", req.AccountId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("account", req.AccountId)
+	default:
+		return nil, errs.FailedToFind("account", err)
+	}
+
+	if db.AccountState == blockedState {
+		return nil, errs.WrapMessage(codes.PermissionDenied, "account is blocked")
+	}
+
+	mfaDB, err := accountAPI.getMFA(ctx, db.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	if mfaDB != nil && mfaDB.Enabled {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate secret")
+	}
+
+	encrypted, err := accountAPI.encryptSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pending until confirmed
+	err = accountAPI.SQLDBWrites.Save(&MFA{
+		AccountID:  db.AccountID,
+		TOTPSecret: encrypted,
+		Enabled:    false,
+	}).Error
+	if err != nil {
+		return nil, errs.FailedToSave("mfa", err)
+	}
+
+	return &account.EnrollTOTPResponse{
+		Secret:     secret,
+		OtpauthUri: totp.URI(accountAPI.AppName, firstVal(db.Email, db.Phone, req.AccountId), secret),
+	}, nil
+}
+
+func (accountAPI *accountAPIServer) ConfirmTOTP(
+	ctx context.Context, req *account.ConfirmTOTPRequest,
+) (*account.BackupCodes, error) {
+	if accountAPI.EncryptionAPI == nil {
+		return nil, errs.WrapMessage(codes.Unavailable, "two-factor authentication not available")
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("confirm totp request")
+	case req.AccountId == "":
+		return nil, errs.MissingField("account id")
+	case req.Code == "":
+		return nil, errs.MissingField("code")
+	}
+
+	if err := denyImpersonation(ctx); err != nil {
+		return nil, err
+	}
+
+	// Only the account owner can confirm
+	_, err := accountAPI.AuthAPI.AuthorizeActor(ctx, req.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	mfaDB := &MFA{}
+	err = accountAPI.SQLDBWrites.First(mfaDB, "account_id=?", req.AccountId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "account is not enrolled to two-factor authentication")
+	default:
+		return nil, errs.FailedToFind("mfa", err)
+	}
+
+	if mfaDB.Enabled {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+
+	err = accountAPI.verifySecondFactor(ctx, mfaDB, req.Code, false)
+	if err != nil {
+		return nil, err
+	}
+
+	backupCodes, hashes, err := newBackupCodes()
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate backup codes")
+	}
+
+	now := time.Now()
+
+	err = accountAPI.SQLDBWrites.Model(mfaDB).Updates(map[string]interface{}{
+		"enabled":      true,
+		"confirmed_at": &now,
+		"backup_codes": hashes,
+	}).Error
+	if err != nil {
+		return nil, errs.FailedToUpdate("mfa", err)
+	}
+
+	return &account.BackupCodes{
+		BackupCodes: backupCodes,
+	}, nil
+}
+
+func (accountAPI *accountAPIServer) DisableTOTP(
+	ctx context.Context, req *account.DisableTOTPRequest,
+) (*empty.Empty, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("disable totp request")
+	case req.AccountId == "":
+		return nil, errs.MissingField("account id")
+	}
+
+	if err := denyImpersonation(ctx); err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	payload, err := accountAPI.AuthAPI.AuthorizeActorOrGroup(ctx, req.AccountId, accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	mfaDB := &MFA{}
+	err = accountAPI.SQLDBWrites.First(mfaDB, "account_id=?", req.AccountId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	default:
+		return nil, errs.FailedToFind("mfa", err)
+	}
+
+	// Owners must prove possession of the second factor; admins may disable it for recovery
+	if !accountAPI.AuthAPI.IsAdmin(payload.Group) || payload.ID == req.AccountId {
+		if mfaDB.Enabled {
+			if req.Code == "" {
+				return nil, errs.MissingField("code")
+			}
+			if accountAPI.EncryptionAPI == nil {
+				return nil, errs.WrapMessage(codes.Unavailable, "two-factor authentication not available")
+			}
+			err = accountAPI.verifySecondFactor(ctx, mfaDB, req.Code, true)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	err = accountAPI.SQLDBWrites.Delete(&MFA{}, "account_id=?", req.AccountId).Error
+	if err != nil {
+		return nil, errs.FailedToDelete("mfa", err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (accountAPI *accountAPIServer) RegenerateBackupCodes(
+	ctx context.Context, req *account.RegenerateBackupCodesRequest,
+) (*account.BackupCodes, error) {
+	if accountAPI.EncryptionAPI == nil {
+		return nil, errs.WrapMessage(codes.Unavailable, "two-factor authentication not available")
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("regenerate backup codes request")
+	case req.AccountId == "":
+		return nil, errs.MissingField("account id")
+	case req.Code == "":
+		return nil, errs.MissingField("code")
+	}
+
+	if err := denyImpersonation(ctx); err != nil {
+		return nil, err
+	}
+
+	// Only the account owner can regenerate codes
+	_, err := accountAPI.AuthAPI.AuthorizeActor(ctx, req.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	mfaDB := &MFA{}
+	err = accountAPI.SQLDBWrites.First(mfaDB, "account_id=?", req.AccountId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	default:
+		return nil, errs.FailedToFind("mfa", err)
+	}
+
+	if !mfaDB.Enabled {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	}
+
+	err = accountAPI.verifySecondFactor(ctx, mfaDB, req.Code, false)
+	if err != nil {
+		return nil, err
+	}
+
+	backupCodes, hashes, err := newBackupCodes()
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate backup codes")
+	}
+
+	err = accountAPI.SQLDBWrites.Model(mfaDB).Update("backup_codes", hashes).Error
+	if err != nil {
+		return nil, errs.FailedToUpdate("backup codes", err)
+	}
+
+	return &account.BackupCodes{
+		BackupCodes: backupCodes,
+	}, nil
+}
@@ -0,0 +1,263 @@
+package account
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/gidyon/services/internal/pkg/totp"
+	"github.com/gidyon/services/pkg/api/account"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Two-factor authentication @mfa", func() {
+	var (
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	When("Enrolling TOTP with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			enrollRes, err := AccountAPI.EnrollTOTP(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(enrollRes).Should(BeNil())
+		})
+		It("should fail when account id is missing", func() {
+			enrollRes, err := AccountAPI.EnrollTOTP(ctx, &account.EnrollTOTPRequest{})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(enrollRes).Should(BeNil())
+		})
+		It("should fail when the account does not exist", func() {
+			enrollRes, err := AccountAPI.EnrollTOTP(ctx, &account.EnrollTOTPRequest{AccountId: "0"})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+			Expect(enrollRes).Should(BeNil())
+		})
+	})
+
+	When("Signing in with malformed mfa request", func() {
+		It("should fail when mfa token is missing", func() {
+			signInRes, err := AccountAPI.SignInMFA(ctx, &account.SignInMFARequest{Code: "123456"})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(signInRes).Should(BeNil())
+		})
+		It("should fail when code is missing", func() {
+			signInRes, err := AccountAPI.SignInMFA(ctx, &account.SignInMFARequest{MfaToken: "token"})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(signInRes).Should(BeNil())
+		})
+		It("should fail when mfa token is unknown", func() {
+			signInRes, err := AccountAPI.SignInMFA(ctx, &account.SignInMFARequest{MfaToken: "token", Code: "123456"})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+			Expect(signInRes).Should(BeNil())
+		})
+	})
+
+	When("Enabling two-factor authentication on an account", func() {
+		var accountID, email, password, group, secret, mfaToken string
+		var backupCodes []string
+
+		It("should create account without error", func() {
+			createReq := &account.CreateAccountRequest{
+				Account:        fakeAccount(),
+				PrivateAccount: fakePrivateAccount(),
+				ProjectId:      "test",
+			}
+			createRes, err := AccountAPI.CreateAccount(ctx, createReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(createRes).ShouldNot(BeNil())
+			accountID = createRes.AccountId
+			email = createReq.Account.Email
+			password = createReq.PrivateAccount.Password
+			group = createReq.Account.Group
+		})
+
+		It("should enroll the account and return a secret", func() {
+			enrollRes, err := AccountAPI.EnrollTOTP(ctx, &account.EnrollTOTPRequest{AccountId: accountID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(enrollRes).ShouldNot(BeNil())
+			Expect(enrollRes.Secret).ShouldNot(BeZero())
+			Expect(enrollRes.OtpauthUri).Should(HavePrefix("otpauth://totp/"))
+			secret = enrollRes.Secret
+		})
+
+		It("should fail to confirm with an incorrect code", func() {
+			confirmRes, err := AccountAPI.ConfirmTOTP(ctx, &account.ConfirmTOTPRequest{
+				AccountId: accountID,
+				Code:      "000000",
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+			Expect(confirmRes).Should(BeNil())
+		})
+
+		It("should confirm with a valid code and return backup codes", func() {
+			code, err := totp.GenerateCode(secret, totp.Counter(time.Now()))
+			Expect(err).ShouldNot(HaveOccurred())
+			confirmRes, err := AccountAPI.ConfirmTOTP(ctx, &account.ConfirmTOTPRequest{
+				AccountId: accountID,
+				Code:      code,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(confirmRes.BackupCodes).Should(HaveLen(backupCodesCount))
+			backupCodes = confirmRes.BackupCodes
+		})
+
+		It("should require a second factor when signing in", func() {
+			signInRes, err := AccountAPI.SignIn(ctx, &account.SignInRequest{
+				Username:  email,
+				Password:  password,
+				Group:     group,
+				ProjectId: "test",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(signInRes.MfaRequired).Should(BeTrue())
+			Expect(signInRes.MfaToken).ShouldNot(BeZero())
+			Expect(signInRes.Token).Should(BeZero())
+			mfaToken = signInRes.MfaToken
+		})
+
+		It("should sign in using a backup code", func() {
+			signInRes, err := AccountAPI.SignInMFA(ctx, &account.SignInMFARequest{
+				MfaToken: mfaToken,
+				Code:     backupCodes[0],
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(signInRes.Token).ShouldNot(BeZero())
+			Expect(signInRes.AccountId).Should(Equal(accountID))
+		})
+
+		It("should not complete the same challenge twice", func() {
+			signInRes, err := AccountAPI.SignInMFA(ctx, &account.SignInMFARequest{
+				MfaToken: mfaToken,
+				Code:     backupCodes[1],
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+			Expect(signInRes).Should(BeNil())
+		})
+
+		It("should not accept a used backup code", func() {
+			signInRes, err := AccountAPI.SignIn(ctx, &account.SignInRequest{
+				Username:  email,
+				Password:  password,
+				Group:     group,
+				ProjectId: "test",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			mfaRes, err := AccountAPI.SignInMFA(ctx, &account.SignInMFARequest{
+				MfaToken: signInRes.MfaToken,
+				Code:     backupCodes[0],
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+			Expect(mfaRes).Should(BeNil())
+		})
+
+		It("should accept a backup code once when it is used concurrently", func() {
+			mfaDB := &MFA{}
+			Expect(AccountAPIServer.SQLDBWrites.First(mfaDB, "account_id=?", accountID).Error).ShouldNot(HaveOccurred())
+			stale := *mfaDB
+
+			err := AccountAPIServer.verifySecondFactor(ctx, mfaDB, backupCodes[3], true)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = AccountAPIServer.verifySecondFactor(ctx, &stale, backupCodes[3], true)
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+		})
+
+		It("should fail to complete sign in when secrets cannot be decrypted", func() {
+			signInRes, err := AccountAPI.SignIn(ctx, &account.SignInRequest{
+				Username:  email,
+				Password:  password,
+				Group:     group,
+				ProjectId: "test",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			encryptionAPI := AccountAPIServer.EncryptionAPI
+			AccountAPIServer.EncryptionAPI = nil
+			defer func() { AccountAPIServer.EncryptionAPI = encryptionAPI }()
+
+			_, err = AccountAPI.SignInMFA(ctx, &account.SignInMFARequest{
+				MfaToken: signInRes.MfaToken,
+				Code:     backupCodes[4],
+			})
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("should lock the account after failed second factors across challenges", func() {
+			lockout := AccountAPIServer.lockout
+			AccountAPIServer.lockout = (&LockoutOptions{
+				MaxFailedAttempts: 3,
+				BaseDelay:         time.Millisecond,
+				MaxDelay:          time.Millisecond,
+			}).withDefaults()
+			defer func() { AccountAPIServer.lockout = lockout }()
+
+			id, err := strconv.ParseUint(accountID, 10, 64)
+			Expect(err).ShouldNot(HaveOccurred())
+			subject := signInSubject("test", accountUsername(uint(id)))
+			Expect(AccountAPIServer.signInSucceeded(ctx, "test", accountUsername(uint(id)))).ShouldNot(HaveOccurred())
+
+			signInReq := &account.SignInRequest{
+				Username:  email,
+				Password:  password,
+				Group:     group,
+				ProjectId: "test",
+			}
+
+			for i := 0; i < 3; i++ {
+				signInRes, err := AccountAPI.SignIn(ctx, signInReq)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(signInRes.MfaRequired).Should(BeTrue())
+
+				_, err = AccountAPI.SignInMFA(ctx, &account.SignInMFARequest{
+					MfaToken: signInRes.MfaToken,
+					Code:     "000000",
+				})
+				Expect(err).Should(HaveOccurred())
+				if i < 2 {
+					Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+				} else {
+					Expect(status.Code(err)).Should(Equal(codes.ResourceExhausted))
+				}
+				time.Sleep(5 * time.Millisecond)
+			}
+
+			// The correct password does not clear the lock
+			_, err = AccountAPI.SignIn(ctx, signInReq)
+			Expect(status.Code(err)).Should(Equal(codes.ResourceExhausted))
+
+			Expect(AccountAPIServer.RedisDBWrites.Del(ctx, signInLockKey(subject)).Err()).ShouldNot(HaveOccurred())
+		})
+
+		It("should disable two-factor authentication", func() {
+			_, err := AccountAPI.DisableTOTP(ctx, &account.DisableTOTPRequest{
+				AccountId: accountID,
+				Code:      backupCodes[2],
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			signInRes, err := AccountAPI.SignIn(ctx, &account.SignInRequest{
+				Username:  email,
+				Password:  password,
+				Group:     group,
+				ProjectId: "test",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(signInRes.MfaRequired).Should(BeFalse())
+			Expect(signInRes.Token).ShouldNot(BeZero())
+		})
+	})
+})
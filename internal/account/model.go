@@ -14,32 +14,35 @@ const accountsTable = "accounts"
 
 // Account contains profile information stored in the database
 type Account struct {
-	AccountID        uint   `gorm:"primaryKey;autoIncrement"`
-	ProjectID        string `gorm:"index;type:varchar(50);not null"`
-	GroupID          string `gorm:"index;type:varchar(50)"`
-	ParentID         string `gorm:"index;type:varchar(50)"`
-	Email            string `gorm:"index;type:varchar(50);not null"`
-	Phone            string `gorm:"index;type:varchar(50);not null"`
-	DeviceToken      string `gorm:"type:varchar(256)"`
-	Names            string `gorm:"type:varchar(50);not null"`
-	BirthDate        string `gorm:"type:varchar(30);"`
-	Gender           string `gorm:"index;type:enum('GENDER_UNSPECIFIED', 'MALE', 'FEMALE');default:'GENDER_UNSPECIFIED';not null"`
-	IDNumber         string `gorm:"index;type:varchar(15)"`
-	Profession       string `gorm:"type:varchar(50)"`
-	Residence        string `gorm:"type:varchar(100)"`
-	Nationality      string `gorm:"type:varchar(50);default:'Kenyan'"`
-	ProfileURL       string `gorm:"type:varchar(256)"`
-	LinkedAccounts   string `gorm:"type:varchar(256)"`
-	SecurityQuestion string `gorm:"type:varchar(50)"`
-	SecurityAnswer   string `gorm:"type:varchar(50)"`
-	Password         string `gorm:"type:text"`
-	PrimaryGroup     string `gorm:"index;type:varchar(50);not null"`
-	SecondaryGroups  []byte `gorm:"type:json"`
-	AccountState     string `gorm:"index;type:enum('BLOCKED','ACTIVE', 'INACTIVE');not null;default:'INACTIVE'"`
-	LastLogin        *time.Time
-	CreatedAt        time.Time `gorm:"index;type:datetime(6);not null"`
-	UpdatedAt        time.Time `gorm:"type:datetime(6)"`
-	DeletedAt        gorm.DeletedAt
+	AccountID         uint   `gorm:"primaryKey;autoIncrement"`
+	ProjectID         string `gorm:"index;type:varchar(50);not null"`
+	GroupID           string `gorm:"index;type:varchar(50)"`
+	ParentID          string `gorm:"index;type:varchar(50)"`
+	Email             string `gorm:"index;type:varchar(50);not null"`
+	Phone             string `gorm:"index;type:varchar(50);not null"`
+	DeviceToken       string `gorm:"type:varchar(256)"`
+	Names             string `gorm:"type:varchar(50);not null"`
+	BirthDate         string `gorm:"type:varchar(30);"`
+	Gender            string `gorm:"index;type:varchar(20);default:'GENDER_UNSPECIFIED';not null"`
+	IDNumber          string `gorm:"index;type:varchar(15)"`
+	Profession        string `gorm:"type:varchar(50)"`
+	Residence         string `gorm:"type:varchar(100)"`
+	Nationality       string `gorm:"type:varchar(50);default:'Kenyan'"`
+	ProfileURL        string `gorm:"type:varchar(256)"`
+	LinkedAccounts    string `gorm:"type:varchar(256)"`
+	SecurityQuestion  string `gorm:"type:varchar(50)"`
+	SecurityAnswer    string `gorm:"type:varchar(50)"`
+	Password          string `gorm:"type:text"`
+	PrimaryGroup      string `gorm:"index;type:varchar(50);not null"`
+	SecondaryGroups   []byte `gorm:"type:json"`
+	Attributes        []byte `gorm:"type:json"`
+	AccountState      string `gorm:"index;type:varchar(20);not null;default:'INACTIVE'"`
+	LastLogin         *time.Time
+	PasswordChangedAt *time.Time
+	PurgedAt          *time.Time `gorm:"index"`
+	CreatedAt         time.Time  `gorm:"index;precision:6;not null"`
+	UpdatedAt         time.Time  `gorm:"precision:6"`
+	DeletedAt         gorm.DeletedAt
 }
 
 // TableName is the name of the tables
@@ -114,6 +117,15 @@ func AccountProto(db *Account) (*account.Account, error) {
 		}
 	}
 
+	// Custom attributes
+	var attributes map[string]string
+	if len(db.Attributes) != 0 {
+		err := json.Unmarshal(db.Attributes, &attributes)
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to json unmarshal")
+		}
+	}
+
 	pb := &account.Account{
 		AccountId:       fmt.Sprint(db.AccountID),
 		ProjectId:       db.ProjectID,
@@ -135,6 +147,7 @@ func AccountProto(db *Account) (*account.Account, error) {
 		SecondaryGroups: secondaryGroups,
 		GroupId:         db.GroupID,
 		ParentId:        db.ParentID,
+		Attributes:      attributes,
 	}
 
 	if db.LastLogin != nil {
@@ -178,6 +191,14 @@ func AccountModel(pb *account.Account) (*Account, error) {
 		db.SecondaryGroups = bs
 	}
 
+	if len(pb.Attributes) > 0 {
+		bs, err := json.Marshal(pb.Attributes)
+		if err != nil {
+			return nil, errs.FromJSONMarshal(err, "attributes")
+		}
+		db.Attributes = bs
+	}
+
 	return db, nil
 }
 
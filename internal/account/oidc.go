@@ -0,0 +1,224 @@
+package account
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/pkg/api/account"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+const oidcClientsTable = "oidc_clients"
+
+// OIDCClient is an application registered to sign in users of a project through OpenID Connect
+type OIDCClient struct {
+	ClientID     string    `gorm:"primaryKey;type:varchar(50)"`
+	ProjectID    string    `gorm:"index;type:varchar(50);not null"`
+	Name         string    `gorm:"type:varchar(100);not null"`
+	SecretHash   string    `gorm:"type:varchar(64)"`
+	RedirectURIs []byte    `gorm:"type:json"`
+	Public       bool      `gorm:"not null"`
+	CreatedAt    time.Time `gorm:"precision:6;not null"`
+}
+
+// TableName is the name of the table
+func (*OIDCClient) TableName() string {
+	return oidcClientsTable
+}
+
+func (c *OIDCClient) redirectURIs() []string {
+	uris := make([]string, 0)
+	if len(c.RedirectURIs) != 0 {
+		_ = json.Unmarshal(c.RedirectURIs, &uris)
+	}
+	return uris
+}
+
+// reports whether uri exactly matches one of the registered redirect uris
+func (c *OIDCClient) allowsRedirect(uri string) bool {
+	for _, registered := range c.redirectURIs() {
+		if registered == uri {
+			return true
+		}
+	}
+	return false
+}
+
+// authenticates a confidential client with its secret
+func (c *OIDCClient) checkSecret(secret string) bool {
+	if c.Public {
+		return true
+	}
+	return secret != "" && subtle.ConstantTimeCompare([]byte(hashToken(secret)), []byte(c.SecretHash)) == 1
+}
+
+func oidcClientProto(c *OIDCClient) *account.OIDCClient {
+	return &account.OIDCClient{
+		ClientId:     c.ClientID,
+		ProjectId:    c.ProjectID,
+		Name:         c.Name,
+		RedirectUris: c.redirectURIs(),
+		Public:       c.Public,
+		CreatedAt:    c.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func randomToken(n int) (string, error) {
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func (accountAPI *accountAPIServer) getOIDCClient(ctx context.Context, clientID string) (*OIDCClient, error) {
+	c := &OIDCClient{}
+	err := accountAPI.SQLDBReads.First(c, "client_id=?", clientID).Error
+	switch {
+	case err == nil:
+		return c, nil
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("oidc client", clientID)
+	default:
+		return nil, errs.FailedToFind("oidc client", err)
+	}
+}
+
+func (accountAPI *accountAPIServer) CreateOIDCClient(
+	ctx context.Context, req *account.CreateOIDCClientRequest,
+) (*account.OIDCClient, error) {
+	// Validation
+	var err error
+	switch {
+	case req == nil:
+		err = errs.NilObject("create oidc client request")
+	case req.Client == nil:
+		err = errs.NilObject("client")
+	case req.Client.ProjectId == "":
+		err = errs.MissingField("project id")
+	case req.Client.Name == "":
+		err = errs.MissingField("name")
+	case len(req.Client.RedirectUris) == 0:
+		err = errs.MissingField("redirect uris")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, uri := range req.Client.RedirectUris {
+		u, err := url.Parse(uri)
+		if err != nil || u.Scheme == "" || u.Fragment != "" {
+			return nil, errs.IncorrectVal("redirect uri")
+		}
+	}
+
+	// Authorization
+	_, err = accountAPI.AuthAPI.AuthorizeGroup(ctx, accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	redirectURIs, err := json.Marshal(req.Client.RedirectUris)
+	if err != nil {
+		return nil, errs.FromJSONMarshal(err, "redirect uris")
+	}
+
+	c := &OIDCClient{
+		ClientID:     uuid.New().String(),
+		ProjectID:    req.Client.ProjectId,
+		Name:         req.Client.Name,
+		RedirectURIs: redirectURIs,
+		Public:       req.Client.Public,
+	}
+
+	var secret string
+	if !c.Public {
+		secret, err = randomToken(32)
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate client secret")
+		}
+		c.SecretHash = hashToken(secret)
+	}
+
+	err = accountAPI.SQLDBWrites.Create(c).Error
+	if err != nil {
+		return nil, errs.FailedToSave("oidc client", err)
+	}
+
+	pb := oidcClientProto(c)
+	pb.ClientSecret = secret
+
+	return pb, nil
+}
+
+func (accountAPI *accountAPIServer) ListOIDCClients(
+	ctx context.Context, req *account.ListOIDCClientsRequest,
+) (*account.ListOIDCClientsResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("list oidc clients request")
+	case req.ProjectId == "":
+		return nil, errs.MissingField("project id")
+	}
+
+	// Authorization
+	_, err := accountAPI.AuthAPI.AuthorizeGroup(ctx, accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	clients := make([]*OIDCClient, 0)
+	err = accountAPI.SQLDBReads.Where("project_id=?", req.ProjectId).Order("created_at").Find(&clients).Error
+	if err != nil {
+		return nil, errs.FailedToFind("oidc clients", err)
+	}
+
+	pbs := make([]*account.OIDCClient, 0, len(clients))
+	for _, c := range clients {
+		pbs = append(pbs, oidcClientProto(c))
+	}
+
+	return &account.ListOIDCClientsResponse{
+		Clients: pbs,
+	}, nil
+}
+
+func (accountAPI *accountAPIServer) DeleteOIDCClient(
+	ctx context.Context, req *account.DeleteOIDCClientRequest,
+) (*empty.Empty, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("delete oidc client request")
+	case req.ClientId == "":
+		return nil, errs.MissingField("client id")
+	}
+
+	// Authorization
+	_, err := accountAPI.AuthAPI.AuthorizeGroup(ctx, accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := accountAPI.SQLDBWrites.Delete(&OIDCClient{}, "client_id=?", req.ClientId)
+	switch {
+	case tx.Error != nil:
+		return nil, errs.FailedToDelete("oidc client", tx.Error)
+	case tx.RowsAffected == 0:
+		return nil, errs.DoesNotExist("oidc client", req.ClientId)
+	}
+
+	return &empty.Empty{}, nil
+}
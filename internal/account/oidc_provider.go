@@ -0,0 +1,680 @@
+package account
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/internal/pkg/jwks"
+	"github.com/gidyon/services/pkg/api/account"
+	redis "github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"gorm.io/gorm"
+)
+
+// OpenID Connect endpoints
+const (
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+	oidcAuthorizePath = "/api/accounts/oidc/authorize"
+	oidcTokenPath     = "/api/accounts/oidc/token"
+	oidcUserInfoPath  = "/api/accounts/oidc/userinfo"
+	oidcJWKSPath      = "/api/accounts/oidc/jwks"
+)
+
+// OIDCPaths are the paths served by the handler returned by NewOIDCProvider
+var OIDCPaths = []string{oidcDiscoveryPath, oidcAuthorizePath, oidcTokenPath, oidcUserInfoPath, oidcJWKSPath}
+
+const (
+	oidcCodeTTL      = 2 * time.Minute
+	oidcIDTokenTTL   = time.Hour
+	oidcAccessType   = "at+jwt"
+	oidcScopeOpenID  = "openid"
+	oidcScopeProfile = "profile"
+	oidcScopeEmail   = "email"
+	oidcScopePhone   = "phone"
+)
+
+// OIDCOptions contain parameters for NewOIDCProvider
+type OIDCOptions struct {
+	// Public base url of the account service, e.g https://accounts.example.com
+	Issuer string
+	// Key that signs ID tokens and access tokens of clients
+	SigningKey *rsa.PrivateKey
+	// Page where users without a session sign in. It receives the authorization
+	// url to return to after sign in in the return_to query parameter.
+	LoginURL string
+}
+
+type oidcProvider struct {
+	*accountAPIServer
+	opt   *OIDCOptions
+	keyID string
+}
+
+// NewOIDCProvider creates an http handler for the OpenID Connect discovery,
+// authorization, token, userinfo and JWKS endpoints of the account API.
+// Users are authenticated with the session cookie or jwt issued by SignIn.
+func NewOIDCProvider(accountAPI account.AccountAPIServer, opt *OIDCOptions) (http.Handler, error) {
+	// Validation
+	var err error
+	switch {
+	case accountAPI == nil:
+		err = errs.NilObject("account API")
+	case opt == nil:
+		err = errs.NilObject("oidc options")
+	case opt.Issuer == "":
+		err = errs.MissingField("issuer")
+	case opt.SigningKey == nil:
+		err = errs.NilObject("signing key")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	accountAPIImpl, ok := accountAPI.(*accountAPIServer)
+	if !ok {
+		return nil, errs.WrapMessage(codes.InvalidArgument, "account API was not created by NewAccountAPI")
+	}
+
+	sum := sha256.Sum256(opt.SigningKey.PublicKey.N.Bytes())
+
+	p := &oidcProvider{
+		accountAPIServer: accountAPIImpl,
+		opt: &OIDCOptions{
+			Issuer:     strings.TrimSuffix(opt.Issuer, "/"),
+			SigningKey: opt.SigningKey,
+			LoginURL:   opt.LoginURL,
+		},
+		keyID: hex.EncodeToString(sum[:8]),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(oidcDiscoveryPath, p.discovery)
+	mux.HandleFunc(oidcJWKSPath, p.jwks)
+	mux.HandleFunc(oidcAuthorizePath, p.authorize)
+	mux.HandleFunc(oidcTokenPath, p.token)
+	mux.HandleFunc(oidcUserInfoPath, p.userInfo)
+
+	return mux, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writes an OAuth2 error response (RFC 6749 section 5.2)
+func oauthError(w http.ResponseWriter, status int, code, description string) {
+	if status == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer error=%q, error_description=%q`, code, description))
+	}
+	writeJSON(w, status, map[string]string{
+		"error":             code,
+		"error_description": description,
+	})
+}
+
+// redirects an authorization error back to the client
+func redirectError(w http.ResponseWriter, r *http.Request, redirectURI, state, code, description string) {
+	u, _ := url.Parse(redirectURI)
+	q := u.Query()
+	q.Set("error", code)
+	q.Set("error_description", description)
+	if state != "" {
+		q.Set("state", state)
+	}
+	u.RawQuery = q.Encode()
+	http.Redirect(w, r, u.String(), http.StatusFound)
+}
+
+func hasScope(scope, want string) bool {
+	for _, s := range strings.Fields(scope) {
+		if s == want {
+			return true
+		}
+	}
+	return false
+}
+
+// verifies a PKCE code verifier against the S256 challenge (RFC 7636)
+func verifyCodeChallenge(challenge, verifier string) bool {
+	sum := sha256.Sum256([]byte(verifier))
+	return subtle.ConstantTimeCompare([]byte(base64.RawURLEncoding.EncodeToString(sum[:])), []byte(challenge)) == 1
+}
+
+func bearerToken(r *http.Request) string {
+	splits := strings.SplitN(r.Header.Get(auth.Header()), " ", 2)
+	if len(splits) != 2 || !strings.EqualFold(splits[0], auth.Scheme()) {
+		return ""
+	}
+	return strings.TrimSpace(splits[1])
+}
+
+// returns the token of the signed in user from the authorization header or the session cookie set by SignIn
+func (p *oidcProvider) sessionToken(r *http.Request) string {
+	if token := bearerToken(r); token != "" {
+		return token
+	}
+	if p.SecureCookie == nil {
+		return ""
+	}
+	cookie, err := r.Cookie(auth.JWTCookie())
+	if err != nil {
+		return ""
+	}
+	var token string
+	err = p.SecureCookie.Decode(auth.JWTCookie(), cookie.Value, &token)
+	if err != nil {
+		return ""
+	}
+	return token
+}
+
+// context carrying client details of the request for the session registry
+func requestContext(r *http.Request) context.Context {
+	md := metadata.Pairs("user-agent", r.UserAgent())
+	if v := r.Header.Get("Device"); v != "" {
+		md.Set("device", v)
+	}
+	// Append the peer address as the gateway does for gRPC requests
+	forwarded := r.Header.Get("X-Forwarded-For")
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		if forwarded != "" {
+			forwarded += ", "
+		}
+		forwarded += host
+	}
+	if forwarded != "" {
+		md.Set("x-forwarded-for", forwarded)
+	}
+	return metadata.NewIncomingContext(r.Context(), md)
+}
+
+func (p *oidcProvider) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                p.opt.Issuer,
+		"authorization_endpoint":                p.opt.Issuer + oidcAuthorizePath,
+		"token_endpoint":                        p.opt.Issuer + oidcTokenPath,
+		"userinfo_endpoint":                     p.opt.Issuer + oidcUserInfoPath,
+		"jwks_uri":                              p.opt.Issuer + oidcJWKSPath,
+		"response_types_supported":              []string{"code"},
+		"grant_types_supported":                 []string{"authorization_code", "refresh_token"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"scopes_supported":                      []string{oidcScopeOpenID, oidcScopeProfile, oidcScopeEmail, oidcScopePhone},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post", "none"},
+		"code_challenge_methods_supported":      []string{"S256"},
+		"claims_supported": []string{
+			"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce", "at_hash",
+			"name", "gender", "birthdate", "picture", "updated_at", "email", "phone_number",
+		},
+	})
+}
+
+func (p *oidcProvider) jwks(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, &jwks.Set{
+		Keys: []jwks.Key{jwks.NewRSAKey(p.keyID, &p.opt.SigningKey.PublicKey)},
+	})
+}
+
+type authorizationCode struct {
+	ClientID      string `json:"client_id"`
+	AccountID     uint   `json:"account_id"`
+	RedirectURI   string `json:"redirect_uri"`
+	Scope         string `json:"scope"`
+	Nonce         string `json:"nonce"`
+	CodeChallenge string `json:"code_challenge"`
+}
+
+func authorizationCodeKey(code string) string {
+	return "oidccode:" + hashToken(code)
+}
+
+func (p *oidcProvider) authorize(w http.ResponseWriter, r *http.Request) {
+	var (
+		q           = r.URL.Query()
+		ctx         = r.Context()
+		redirectURI = q.Get("redirect_uri")
+		state       = q.Get("state")
+	)
+
+	// The redirect uri must be verified before any error is sent to it
+	client, err := p.getOIDCClient(ctx, q.Get("client_id"))
+	if err != nil {
+		http.Error(w, "unknown client", http.StatusBadRequest)
+		return
+	}
+	if !client.allowsRedirect(redirectURI) {
+		http.Error(w, "redirect uri not registered for client", http.StatusBadRequest)
+		return
+	}
+
+	switch {
+	case q.Get("response_type") != "code":
+		redirectError(w, r, redirectURI, state, "unsupported_response_type", "only the code response type is supported")
+		return
+	case !hasScope(q.Get("scope"), oidcScopeOpenID):
+		redirectError(w, r, redirectURI, state, "invalid_scope", "openid scope is required")
+		return
+	case q.Get("code_challenge") == "" && client.Public:
+		redirectError(w, r, redirectURI, state, "invalid_request", "public clients must use PKCE")
+		return
+	case q.Get("code_challenge") != "" && q.Get("code_challenge_method") != "S256":
+		redirectError(w, r, redirectURI, state, "invalid_request", "code challenge method must be S256")
+		return
+	}
+
+	// Users are authenticated by their existing session
+	var payload *auth.Payload
+	if token := p.sessionToken(r); token != "" {
+		payload, err = p.AuthAPI.GetPayloadFromJwt(token)
+		// Impersonation must not leave the account API as sessions of clients
+		if err == nil && tokenImpersonator(token) != "" {
+			redirectError(w, r, redirectURI, state, "access_denied", "cannot sign in to clients while impersonating the account")
+			return
+		}
+	}
+	if payload == nil || err != nil || payload.ID == "" || hasScope(q.Get("prompt"), "login") {
+		switch {
+		case hasScope(q.Get("prompt"), "none"):
+			redirectError(w, r, redirectURI, state, "login_required", "user is not signed in")
+		case p.opt.LoginURL == "":
+			http.Error(w, "sign in required", http.StatusUnauthorized)
+		default:
+			u, err := url.Parse(p.opt.LoginURL)
+			if err != nil {
+				http.Error(w, "bad login url", http.StatusInternalServerError)
+				return
+			}
+			// Avoid looping back to the login page
+			q.Del("prompt")
+			lq := u.Query()
+			lq.Set("return_to", p.opt.Issuer+oidcAuthorizePath+"?"+q.Encode())
+			u.RawQuery = lq.Encode()
+			http.Redirect(w, r, u.String(), http.StatusFound)
+		}
+		return
+	}
+
+	db := &Account{}
+	err = p.SQLDBReads.First(db, "account_id=?", payload.ID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		redirectError(w, r, redirectURI, state, "access_denied", "account not found")
+		return
+	default:
+		redirectError(w, r, redirectURI, state, "server_error", "failed to get account")
+		return
+	}
+
+	// Clients only sign in users of their own project
+	if db.ProjectID != client.ProjectID || db.AccountState == account.AccountState_BLOCKED.String() {
+		redirectError(w, r, redirectURI, state, "access_denied", "account cannot sign in to this client")
+		return
+	}
+
+	code, err := randomToken(32)
+	if err != nil {
+		redirectError(w, r, redirectURI, state, "server_error", "failed to generate code")
+		return
+	}
+
+	bs, err := json.Marshal(&authorizationCode{
+		ClientID:      client.ClientID,
+		AccountID:     db.AccountID,
+		RedirectURI:   redirectURI,
+		Scope:         q.Get("scope"),
+		Nonce:         q.Get("nonce"),
+		CodeChallenge: q.Get("code_challenge"),
+	})
+	if err != nil {
+		redirectError(w, r, redirectURI, state, "server_error", "failed to marshal code")
+		return
+	}
+
+	err = p.RedisDBWrites.Set(ctx, authorizationCodeKey(code), bs, oidcCodeTTL).Err()
+	if err != nil {
+		redirectError(w, r, redirectURI, state, "server_error", "failed to save code")
+		return
+	}
+
+	u, _ := url.Parse(redirectURI)
+	rq := u.Query()
+	rq.Set("code", code)
+	if state != "" {
+		rq.Set("state", state)
+	}
+	u.RawQuery = rq.Encode()
+
+	http.Redirect(w, r, u.String(), http.StatusFound)
+}
+
+// authenticates the client of a token request
+func (p *oidcProvider) tokenClient(r *http.Request) (*OIDCClient, error) {
+	clientID, secret, ok := r.BasicAuth()
+	if ok {
+		// Credentials are form encoded before basic encoding
+		clientID, _ = url.QueryUnescape(clientID)
+		secret, _ = url.QueryUnescape(secret)
+	} else {
+		clientID, secret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+
+	client, err := p.getOIDCClient(r.Context(), clientID)
+	if err != nil {
+		return nil, err
+	}
+	if !client.checkSecret(secret) {
+		return nil, errs.WrapMessage(codes.Unauthenticated, "bad client secret")
+	}
+
+	return client, nil
+}
+
+// takes an authorization code so that it can only be exchanged once
+func (p *oidcProvider) redeemCode(ctx context.Context, code string) (*authorizationCode, error) {
+	var get *redis.StringCmd
+	_, err := p.RedisDBWrites.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.Get(ctx, authorizationCodeKey(code))
+		pipe.Del(ctx, authorizationCodeKey(code))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	authCode := &authorizationCode{}
+	err = json.Unmarshal([]byte(get.Val()), authCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return authCode, nil
+}
+
+func (p *oidcProvider) token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		oauthError(w, http.StatusMethodNotAllowed, "invalid_request", "token requests must use POST")
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		oauthError(w, http.StatusBadRequest, "invalid_request", "bad form body")
+		return
+	}
+
+	client, err := p.tokenClient(r)
+	if err != nil {
+		oauthError(w, http.StatusUnauthorized, "invalid_client", "client authentication failed")
+		return
+	}
+
+	ctx := requestContext(r)
+
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		authCode, err := p.redeemCode(ctx, r.PostForm.Get("code"))
+		switch {
+		case errors.Is(err, redis.Nil):
+			oauthError(w, http.StatusBadRequest, "invalid_grant", "code is invalid or expired")
+			return
+		case err != nil:
+			oauthError(w, http.StatusInternalServerError, "server_error", "failed to get code")
+			return
+		}
+
+		switch {
+		case authCode.ClientID != client.ClientID:
+			oauthError(w, http.StatusBadRequest, "invalid_grant", "code was issued to another client")
+			return
+		case authCode.RedirectURI != r.PostForm.Get("redirect_uri"):
+			oauthError(w, http.StatusBadRequest, "invalid_grant", "redirect uri does not match")
+			return
+		case authCode.CodeChallenge != "" && !verifyCodeChallenge(authCode.CodeChallenge, r.PostForm.Get("code_verifier")):
+			oauthError(w, http.StatusBadRequest, "invalid_grant", "code verifier does not match")
+			return
+		}
+
+		sess, refreshToken, err := p.createSession(ctx, authCode.AccountID)
+		if err != nil {
+			oauthError(w, http.StatusInternalServerError, "server_error", "failed to create session")
+			return
+		}
+
+		err = p.RedisDBWrites.HSet(ctx, sessionKey(sess.ID), sessionClientID, client.ClientID, sessionScope, authCode.Scope).Err()
+		if err != nil {
+			oauthError(w, http.StatusInternalServerError, "server_error", "failed to update session")
+			return
+		}
+
+		p.issueTokens(ctx, w, authCode.AccountID, sess.ID, client.ClientID, authCode.Scope, authCode.Nonce, refreshToken)
+
+	case "refresh_token":
+		sess, err := p.getSessionByRefreshToken(ctx, r.PostForm.Get("refresh_token"))
+		if err != nil {
+			oauthError(w, http.StatusBadRequest, "invalid_grant", "refresh token is invalid or expired")
+			return
+		}
+		if sess.ClientID != client.ClientID {
+			oauthError(w, http.StatusBadRequest, "invalid_grant", "refresh token was issued to another client")
+			return
+		}
+
+		refreshToken, err := p.rotateSession(ctx, sess)
+		if err != nil {
+			oauthError(w, http.StatusBadRequest, "invalid_grant", "refresh token already used")
+			return
+		}
+
+		var accountID uint
+		_, err = fmt.Sscan(sess.AccountID, &accountID)
+		if err != nil {
+			oauthError(w, http.StatusInternalServerError, "server_error", "bad session")
+			return
+		}
+
+		p.issueTokens(ctx, w, accountID, sess.ID, client.ClientID, sess.Scope, "", refreshToken)
+
+	default:
+		oauthError(w, http.StatusBadRequest, "unsupported_grant_type", "only authorization_code and refresh_token grants are supported")
+	}
+}
+
+// access tokens of clients carry the scope granted to the client in the session
+type oidcAccessClaims struct {
+	ClientID  string `json:"client_id"`
+	Scope     string `json:"scope"`
+	SessionID string `json:"sid"`
+	jwt.StandardClaims
+}
+
+// signs an access token for the client. Access tokens are only accepted by userinfo and the
+// resource servers of the client, not by services that accept jwt issued by SignIn.
+func (p *oidcProvider) accessToken(db *Account, sessionID, clientID, scope string, expires time.Time) (string, error) {
+	jti, err := randomToken(16)
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, &oidcAccessClaims{
+		ClientID:  clientID,
+		Scope:     scope,
+		SessionID: sessionID,
+		StandardClaims: jwt.StandardClaims{
+			Id:        jti,
+			Issuer:    p.opt.Issuer,
+			Subject:   fmt.Sprint(db.AccountID),
+			Audience:  clientID,
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: expires.Unix(),
+		},
+	})
+	token.Header["typ"] = oidcAccessType
+	token.Header["kid"] = p.keyID
+
+	return token.SignedString(p.opt.SigningKey)
+}
+
+// verifies an access token issued by the token endpoint
+func (p *oidcProvider) parseAccessToken(tokenString string) (*oidcAccessClaims, error) {
+	claims := &oidcAccessClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		// ID tokens are signed by the same key
+		if t.Method != jwt.SigningMethodRS256 || t.Header["typ"] != oidcAccessType {
+			return nil, errors.New("not an access token")
+		}
+		return &p.opt.SigningKey.PublicKey, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !claims.VerifyIssuer(p.opt.Issuer, true) || !claims.VerifyAudience(claims.ClientID, true) {
+		return nil, errors.New("access token was issued by another provider")
+	}
+	return claims, nil
+}
+
+func (p *oidcProvider) issueTokens(
+	ctx context.Context, w http.ResponseWriter, accountID uint, sessionID, clientID, scope, nonce, refreshToken string,
+) {
+	db := &Account{}
+	err := p.SQLDBReads.First(db, "account_id=?", accountID).Error
+	if err != nil {
+		oauthError(w, http.StatusBadRequest, "invalid_grant", "account not found")
+		return
+	}
+	if db.AccountState == account.AccountState_BLOCKED.String() {
+		oauthError(w, http.StatusBadRequest, "invalid_grant", "account blocked")
+		return
+	}
+
+	expiresIn := tokenLifetime()
+	accessToken, err := p.accessToken(db, sessionID, clientID, scope, time.Now().Add(expiresIn))
+	if err != nil {
+		oauthError(w, http.StatusInternalServerError, "server_error", "failed to generate access token")
+		return
+	}
+
+	idToken, err := p.idToken(db, clientID, scope, nonce, accessToken)
+	if err != nil {
+		oauthError(w, http.StatusInternalServerError, "server_error", "failed to generate id token")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token":  accessToken,
+		"token_type":    "Bearer",
+		"expires_in":    int64(expiresIn.Seconds()),
+		"refresh_token": refreshToken,
+		"id_token":      idToken,
+		"scope":         scope,
+	})
+}
+
+// standard claims of the account allowed by scope
+func accountClaims(db *Account, scope string) map[string]interface{} {
+	claims := map[string]interface{}{
+		"sub": fmt.Sprint(db.AccountID),
+	}
+	if hasScope(scope, oidcScopeProfile) {
+		claims["name"] = db.Names
+		claims["updated_at"] = db.UpdatedAt.Unix()
+		if db.ProfileURL != "" {
+			claims["picture"] = db.ProfileURL
+		}
+		if db.BirthDate != "" {
+			claims["birthdate"] = db.BirthDate
+		}
+		switch db.Gender {
+		case account.Account_MALE.String(), account.Account_FEMALE.String():
+			claims["gender"] = strings.ToLower(db.Gender)
+		}
+	}
+	// Accounts without email or phone store their id in its place
+	if hasScope(scope, oidcScopeEmail) && strings.Contains(db.Email, "@") {
+		claims["email"] = db.Email
+	}
+	if hasScope(scope, oidcScopePhone) && db.Phone != fmt.Sprint(db.AccountID) {
+		claims["phone_number"] = db.Phone
+	}
+	return claims
+}
+
+func (p *oidcProvider) idToken(db *Account, clientID, scope, nonce, accessToken string) (string, error) {
+	now := time.Now()
+
+	claims := jwt.MapClaims(accountClaims(db, scope))
+	claims["iss"] = p.opt.Issuer
+	claims["aud"] = clientID
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(oidcIDTokenTTL).Unix()
+	if db.LastLogin != nil {
+		claims["auth_time"] = db.LastLogin.Unix()
+	}
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+
+	// Left half of the access token hash
+	sum := sha256.Sum256([]byte(accessToken))
+	claims["at_hash"] = base64.RawURLEncoding.EncodeToString(sum[:len(sum)/2])
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = p.keyID
+
+	return token.SignedString(p.opt.SigningKey)
+}
+
+func (p *oidcProvider) userInfo(w http.ResponseWriter, r *http.Request) {
+	accessToken := bearerToken(r)
+	if accessToken == "" {
+		oauthError(w, http.StatusUnauthorized, "invalid_token", "missing access token")
+		return
+	}
+
+	claims, err := p.parseAccessToken(accessToken)
+	if err != nil {
+		oauthError(w, http.StatusUnauthorized, "invalid_token", "access token is invalid or expired")
+		return
+	}
+
+	// Access tokens end with their session
+	sess, err := p.getSession(r.Context(), claims.SessionID)
+	switch {
+	case err != nil:
+		oauthError(w, http.StatusInternalServerError, "server_error", "failed to get session")
+		return
+	case sess == nil || sess.ClientID != claims.ClientID:
+		oauthError(w, http.StatusUnauthorized, "invalid_token", "session has ended")
+		return
+	}
+
+	db := &Account{}
+	err = p.SQLDBReads.First(db, "account_id=?", claims.Subject).Error
+	if err != nil {
+		oauthError(w, http.StatusUnauthorized, "invalid_token", "account not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, accountClaims(db, claims.Scope))
+}
@@ -0,0 +1,314 @@
+package account
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/services/internal/pkg/jwks"
+	"github.com/gidyon/services/pkg/api/account"
+	"github.com/gorilla/securecookie"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("OpenID Connect provider @oidc", func() {
+	var (
+		ctx         context.Context
+		handler     http.Handler
+		signingKey  *rsa.PrivateKey
+		client      *account.OIDCClient
+		accountID   uint
+		redirectURI = "https://app.example.com/callback"
+		verifier    = "a-code-verifier-that-is-long-enough-for-pkce-1234567890"
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	serve := func(r *http.Request) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	tokenRequest := func(form url.Values) (*httptest.ResponseRecorder, map[string]interface{}) {
+		r := httptest.NewRequest(http.MethodPost, oidcTokenPath, strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.SetBasicAuth(client.ClientId, client.ClientSecret)
+		w := serve(r)
+		res := make(map[string]interface{})
+		Expect(json.Unmarshal(w.Body.Bytes(), &res)).ShouldNot(HaveOccurred())
+		return w, res
+	}
+
+	It("should create the provider", func() {
+		var err error
+		signingKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = NewOIDCProvider(AccountAPI, &OIDCOptions{SigningKey: signingKey})
+		Expect(err).Should(HaveOccurred())
+
+		handler, err = NewOIDCProvider(AccountAPI, &OIDCOptions{
+			Issuer:     "https://accounts.example.com/",
+			SigningKey: signingKey,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	Describe("Registering clients", func() {
+		It("should fail when redirect uris are missing", func() {
+			_, err := AccountAPI.CreateOIDCClient(ctx, &account.CreateOIDCClientRequest{
+				Client: &account.OIDCClient{ProjectId: "test", Name: "app"},
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("should create a confidential client with a secret", func() {
+			var err error
+			client, err = AccountAPI.CreateOIDCClient(ctx, &account.CreateOIDCClientRequest{
+				Client: &account.OIDCClient{ProjectId: "test", Name: "app", RedirectUris: []string{redirectURI}},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(client.ClientId).ShouldNot(BeZero())
+			Expect(client.ClientSecret).ShouldNot(BeZero())
+		})
+
+		It("should list clients of the project without secrets", func() {
+			res, err := AccountAPI.ListOIDCClients(ctx, &account.ListOIDCClientsRequest{ProjectId: "test"})
+			Expect(err).ShouldNot(HaveOccurred())
+			found := false
+			for _, c := range res.Clients {
+				Expect(c.ClientSecret).Should(BeZero())
+				if c.ClientId == client.ClientId {
+					found = true
+					Expect(c.RedirectUris).Should(ConsistOf(redirectURI))
+				}
+			}
+			Expect(found).Should(BeTrue())
+		})
+	})
+
+	Describe("Discovery", func() {
+		It("should publish endpoints under the issuer", func() {
+			w := serve(httptest.NewRequest(http.MethodGet, oidcDiscoveryPath, nil))
+			Expect(w.Code).Should(Equal(http.StatusOK))
+
+			doc := make(map[string]interface{})
+			Expect(json.Unmarshal(w.Body.Bytes(), &doc)).ShouldNot(HaveOccurred())
+			Expect(doc["issuer"]).Should(Equal("https://accounts.example.com"))
+			Expect(doc["token_endpoint"]).Should(Equal("https://accounts.example.com" + oidcTokenPath))
+		})
+
+		It("should refuse to redirect to an unregistered uri", func() {
+			q := url.Values{
+				"client_id":     {client.ClientId},
+				"redirect_uri":  {"https://evil.example.com/callback"},
+				"response_type": {"code"},
+				"scope":         {"openid"},
+			}
+			w := serve(httptest.NewRequest(http.MethodGet, oidcAuthorizePath+"?"+q.Encode(), nil))
+			Expect(w.Code).Should(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("Exchanging authorization codes", func() {
+		var code, refreshToken string
+
+		It("should create an account to sign in", func() {
+			createRes, err := AccountAPI.CreateAccount(ctx, &account.CreateAccountRequest{
+				Account:        fakeAccount(),
+				PrivateAccount: fakePrivateAccount(),
+				ProjectId:      "test",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			id, err := strconv.ParseUint(createRes.AccountId, 10, 64)
+			Expect(err).ShouldNot(HaveOccurred())
+			accountID = uint(id)
+		})
+
+		It("should authorize users signed in with the session cookie", func() {
+			signer, err := auth.NewAPI(&auth.Options{SigningKey: []byte(randomdata.RandStringRunes(32)), Issuer: "Accounts API", Audience: "accounts"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			mockAPI := AccountAPIServer.AuthAPI
+			AccountAPIServer.AuthAPI = &signingAuthAPI{API: mockAPI, signer: signer}
+			AccountAPIServer.SecureCookie = securecookie.New([]byte(randomdata.RandStringRunes(32)), nil)
+			defer func() {
+				AccountAPIServer.AuthAPI = mockAPI
+				AccountAPIServer.SecureCookie = nil
+			}()
+
+			token, err := signer.GenToken(ctx, &auth.Payload{ID: fmt.Sprint(accountID), ProjectID: "test"}, time.Now().Add(time.Minute))
+			Expect(err).ShouldNot(HaveOccurred())
+			encoded, err := AccountAPIServer.SecureCookie.Encode(auth.JWTCookie(), token)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			q := url.Values{
+				"client_id":      {client.ClientId},
+				"redirect_uri":   {redirectURI},
+				"response_type":  {"code"},
+				"scope":          {"openid"},
+				"code_challenge": {"challenge"},
+			}
+			q.Set("code_challenge_method", "S256")
+			r := httptest.NewRequest(http.MethodGet, oidcAuthorizePath+"?"+q.Encode(), nil)
+			r.AddCookie(&http.Cookie{Name: auth.JWTCookie(), Value: encoded})
+			w := serve(r)
+
+			Expect(w.Code).Should(Equal(http.StatusFound))
+			location, err := url.Parse(w.Header().Get("Location"))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(location.Query().Get("code")).ShouldNot(BeEmpty())
+
+			// Cookies that were not set by the account API are ignored
+			r = httptest.NewRequest(http.MethodGet, oidcAuthorizePath+"?"+q.Encode(), nil)
+			r.AddCookie(&http.Cookie{Name: auth.JWTCookie(), Value: token})
+			Expect(serve(r).Code).Should(Equal(http.StatusUnauthorized))
+		})
+
+		It("should save a code bound to a pkce challenge", func() {
+			var err error
+			code, err = randomToken(32)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			sum := sha256.Sum256([]byte(verifier))
+			bs, err := json.Marshal(&authorizationCode{
+				ClientID:      client.ClientId,
+				AccountID:     accountID,
+				RedirectURI:   redirectURI,
+				Scope:         "openid profile",
+				Nonce:         "n-0S6_WzA2Mj",
+				CodeChallenge: base64.RawURLEncoding.EncodeToString(sum[:]),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(AccountAPIServer.RedisDBWrites.Set(ctx, authorizationCodeKey(code), bs, oidcCodeTTL).Err()).
+				ShouldNot(HaveOccurred())
+		})
+
+		It("should reject a wrong code verifier and burn the code", func() {
+			w, res := tokenRequest(url.Values{
+				"grant_type":    {"authorization_code"},
+				"code":          {code},
+				"redirect_uri":  {redirectURI},
+				"code_verifier": {"wrong"},
+			})
+			Expect(w.Code).Should(Equal(http.StatusBadRequest))
+			Expect(res["error"]).Should(Equal("invalid_grant"))
+
+			w, _ = tokenRequest(url.Values{
+				"grant_type":    {"authorization_code"},
+				"code":          {code},
+				"redirect_uri":  {redirectURI},
+				"code_verifier": {verifier},
+			})
+			Expect(w.Code).Should(Equal(http.StatusBadRequest))
+		})
+
+		It("should issue a signed id token for a valid code", func() {
+			sum := sha256.Sum256([]byte(verifier))
+			bs, err := json.Marshal(&authorizationCode{
+				ClientID:      client.ClientId,
+				AccountID:     accountID,
+				RedirectURI:   redirectURI,
+				Scope:         "openid profile",
+				Nonce:         "n-0S6_WzA2Mj",
+				CodeChallenge: base64.RawURLEncoding.EncodeToString(sum[:]),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(AccountAPIServer.RedisDBWrites.Set(ctx, authorizationCodeKey(code), bs, oidcCodeTTL).Err()).
+				ShouldNot(HaveOccurred())
+
+			w, res := tokenRequest(url.Values{
+				"grant_type":    {"authorization_code"},
+				"code":          {code},
+				"redirect_uri":  {redirectURI},
+				"code_verifier": {verifier},
+			})
+			Expect(w.Code).Should(Equal(http.StatusOK))
+			Expect(res["refresh_token"]).ShouldNot(BeZero())
+			refreshToken = res["refresh_token"].(string)
+
+			// Verify id token against the published key set
+			jw := serve(httptest.NewRequest(http.MethodGet, oidcJWKSPath, nil))
+			set := &jwks.Set{}
+			Expect(json.Unmarshal(jw.Body.Bytes(), set)).ShouldNot(HaveOccurred())
+
+			claims := jwt.MapClaims{}
+			_, err = jwt.ParseWithClaims(res["id_token"].(string), claims, func(t *jwt.Token) (interface{}, error) {
+				kid, _ := t.Header["kid"].(string)
+				return set.Key(kid)
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(claims["aud"]).Should(Equal(client.ClientId))
+			Expect(claims["nonce"]).Should(Equal("n-0S6_WzA2Mj"))
+			Expect(claims).Should(HaveKey("name"))
+			Expect(claims).ShouldNot(HaveKey("email"))
+
+			// Access tokens are restricted to the client
+			accessToken := res["access_token"].(string)
+			accessClaims := jwt.MapClaims{}
+			_, err = jwt.ParseWithClaims(accessToken, accessClaims, func(t *jwt.Token) (interface{}, error) {
+				kid, _ := t.Header["kid"].(string)
+				return set.Key(kid)
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(accessClaims["aud"]).Should(Equal(client.ClientId))
+			Expect(accessClaims["scope"]).Should(Equal("openid profile"))
+
+			r := httptest.NewRequest(http.MethodGet, oidcUserInfoPath, nil)
+			r.Header.Set("Authorization", "Bearer "+accessToken)
+			uw := serve(r)
+			Expect(uw.Code).Should(Equal(http.StatusOK))
+			userInfo := make(map[string]interface{})
+			Expect(json.Unmarshal(uw.Body.Bytes(), &userInfo)).ShouldNot(HaveOccurred())
+			Expect(userInfo["sub"]).Should(Equal(fmt.Sprint(accountID)))
+			Expect(userInfo).Should(HaveKey("name"))
+
+			// ID tokens are not access tokens
+			r = httptest.NewRequest(http.MethodGet, oidcUserInfoPath, nil)
+			r.Header.Set("Authorization", "Bearer "+res["id_token"].(string))
+			Expect(serve(r).Code).Should(Equal(http.StatusUnauthorized))
+		})
+
+		It("should rotate the refresh token", func() {
+			w, res := tokenRequest(url.Values{
+				"grant_type":    {"refresh_token"},
+				"refresh_token": {refreshToken},
+			})
+			Expect(w.Code).Should(Equal(http.StatusOK))
+			Expect(res["refresh_token"]).ShouldNot(Equal(refreshToken))
+
+			w, res = tokenRequest(url.Values{
+				"grant_type":    {"refresh_token"},
+				"refresh_token": {refreshToken},
+			})
+			Expect(w.Code).Should(Equal(http.StatusBadRequest))
+			Expect(res["error"]).Should(Equal("invalid_grant"))
+		})
+	})
+
+	It("should delete the client", func() {
+		_, err := AccountAPI.DeleteOIDCClient(ctx, &account.DeleteOIDCClientRequest{ClientId: client.ClientId})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = AccountAPI.DeleteOIDCClient(ctx, &account.DeleteOIDCClientRequest{ClientId: client.ClientId})
+		Expect(status.Code(err)).Should(Equal(codes.NotFound))
+	})
+})
@@ -4,13 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
-	"github.com/Pallinder/go-randomdata"
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
 	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/internal/pkg/otp"
 	"github.com/gidyon/services/pkg/api/account"
 	"github.com/gidyon/services/pkg/api/messaging"
+	"github.com/gidyon/services/pkg/api/messaging/sms"
 	"github.com/go-redis/redis/v8"
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
@@ -23,41 +25,94 @@ func otpKey(accountID string) string {
 	return "otplogin:" + accountID
 }
 
-func (accountAPI *accountAPIServer) RequestSignInOTP(
-	ctx context.Context, req *account.RequestSignInOTPRequest,
-) (*empty.Empty, error) {
-	var err error
-
-	// Validation
-	switch {
-	case req == nil:
-		return nil, errs.NilObject("RequestChangePrivateAccountRequest")
-	case req.Username == "":
-		return nil, errs.MissingField("username")
+// gets the account of an otp username which is either an account id or a phone number
+func (accountAPI *accountAPIServer) otpAccount(projectID, username string) (*Account, error) {
+	var (
+		db  = &Account{}
+		err error
+	)
+	if phone, phoneErr := accountAPI.PhoneRegions.Normalise(projectID, username); phoneErr == nil {
+		// Usernames that are phone numbers are looked up by their E.164 form
+		query := accountAPI.SQLDBWrites.Where("phone=?", phone)
+		if projectID != "" {
+			query = query.Where("project_id=?", projectID)
+		}
+		err = query.First(db).Error
+	} else {
+		err = accountAPI.SQLDBWrites.First(db, "account_id = ?", username).Error
 	}
-
-	// GetAccount the user from database
-	db := &Account{}
-	err = accountAPI.SQLDBWrites.
-		First(db, "account_id = ?", req.Username).Error
 	switch {
 	case err == nil:
+		return db, nil
 	case errors.Is(err, gorm.ErrRecordNotFound):
-		return nil, errs.WrapMessagef(codes.NotFound, "account with id %s does not exist", req.Username)
+		return nil, errs.WrapMessagef(codes.NotFound, "account %s does not exist", username)
 	default:
 		return nil, errs.FailedToFind("account", err)
 	}
+}
 
-	accountID := fmt.Sprint(db.AccountID)
+// returns the otp policy of a project
+func (accountAPI *accountAPIServer) otpPolicy(projectID string) *otp.Policy {
+	if policy, ok := accountAPI.OTPPolicies[projectID]; ok {
+		return policy.WithDefaults()
+	}
+	return accountAPI.OTPPolicies[otp.DefaultPolicy].WithDefaults()
+}
+
+// send method of an otp channel and whether the account can be reached through it
+func otpSendMethod(db *Account, channel string) (messaging.SendMethod, bool) {
+	switch channel {
+	case otp.ChannelSMS:
+		return messaging.SendMethod_SMSV2, db.Phone != ""
+	case otp.ChannelCall:
+		return messaging.SendMethod_CALL, db.Phone != ""
+	case otp.ChannelEmail:
+		return messaging.SendMethod_EMAIL, db.Email != ""
+	case otp.ChannelPush:
+		return messaging.SendMethod_PUSH, db.DeviceToken != ""
+	default:
+		return messaging.SendMethod_SEND_METHOD_UNSPECIFIED, false
+	}
+}
+
+func humanDuration(d time.Duration) string {
+	n, unit := int64(d/time.Second), "second"
+	if d%time.Minute == 0 {
+		n, unit = int64(d/time.Minute), "minute"
+	}
+	if n != 1 {
+		unit += "s"
+	}
+	return fmt.Sprintf("%d %s", n, unit)
+}
+
+type otpMessage struct {
+	title           string
+	purpose         string
+	smsAuth         *sms.SMSAuth
+	smsCredentialID string
+	fetchSmsAuth    bool
+}
 
-	uniqueNumber := randomdata.Number(100000, 999999)
+// generates an otp for the account and delivers it through the channels of the project policy, falling
+// back to the next channel when delivery fails
+func (accountAPI *accountAPIServer) sendOTP(ctx context.Context, db *Account, msg *otpMessage) error {
+	var (
+		accountID = fmt.Sprint(db.AccountID)
+		policy    = accountAPI.otpPolicy(db.ProjectID)
+	)
 
-	// Set token with expiration of 5 minutes
+	code, err := policy.Generate()
+	if err != nil {
+		return errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate otp")
+	}
+
+	// Only the hash is stored
 	err = accountAPI.RedisDBWrites.Set(
-		ctx, otpKey(accountID), uniqueNumber, time.Duration(5*time.Minute),
+		ctx, otpKey(accountID), otp.Hash(accountAPI.TokenSigningKey, accountID, code), policy.TTL(),
 	).Err()
 	if err != nil {
-		return nil, errs.RedisCmdFailed(err, "SET")
+		return errs.RedisCmdFailed(err, "SET")
 	}
 
 	// Generate token
@@ -70,52 +125,88 @@ func (accountAPI *accountAPIServer) RequestSignInOTP(
 		Group:        db.PrimaryGroup,
 	}, time.Now().Add(10*time.Minute))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Outgoing context
 	ctxExt := metadata.NewOutgoingContext(ctx, metadata.Pairs(auth.Header(), fmt.Sprintf("Bearer %s", jwt)))
 
-	data := fmt.Sprintf(`Login OTP is %v \n\nExpires in 10 minutes`, uniqueNumber)
-
-	if req.Project != "" {
-		data = fmt.Sprintf(`Login OTP for %s. \n\nOTP is %d \nExpires in 10 minutes`, req.Project, uniqueNumber)
-	}
-
-	// // Send message
-	// _, err = accountAPI.MessagingClient.SendMessage(ctxExt, &messaging.SendMessageRequest{
-	// 	Message: &messaging.Message{
-	// 		UserId:      accountID,
-	// 		Title:       "OTP Login",
-	// 		Data:        data,
-	// 		Save:        true,
-	// 		Type:        messaging.MessageType_INFO,
-	// 		SendMethods: []messaging.SendMethod{messaging.SendMethod_SMSV2},
-	// 	},
-	// 	SmsAuth:         req.GetSmsAuth(),
-	// 	SmsCredentialId: req.SmsCredentialId,
-	// 	FetchSmsAuth:    req.FetchSmsAuth,
-	// })
-	// if err != nil {
-	// 	return nil, errs.WrapErrorWithMsg(err, "failed to send otp to phone")
-	// }
-
-	// Send message
-	_, err = accountAPI.MessagingClient.SendMessage(ctxExt, &messaging.SendMessageRequest{
-		Message: &messaging.Message{
-			UserId:      accountID,
-			Title:       "OTP Login",
-			Data:        data,
-			Save:        true,
-			Type:        messaging.MessageType_INFO,
-			SendMethods: []messaging.SendMethod{messaging.SendMethod_SMSV2},
-		},
-		SmsAuth:         req.GetSmsAuth(),
-		SmsCredentialId: req.SmsCredentialId,
-		FetchSmsAuth:    req.FetchSmsAuth,
+	data := fmt.Sprintf("%s OTP is %s\n\nExpires in %s", msg.purpose, code, humanDuration(policy.TTL()))
+	if db.ProjectID != "" {
+		data = fmt.Sprintf(
+			"%s OTP for %s.\n\nOTP is %s\nExpires in %s", msg.purpose, db.ProjectID, code, humanDuration(policy.TTL()),
+		)
+	}
+
+	var sendErr error
+	for _, channel := range policy.Channels {
+		sendMethod, ok := otpSendMethod(db, channel)
+		if !ok {
+			continue
+		}
+
+		// Send message
+		_, sendErr = accountAPI.MessagingClient.SendMessage(ctxExt, &messaging.SendMessageRequest{
+			Message: &messaging.Message{
+				UserId:      accountID,
+				Title:       msg.title,
+				Data:        data,
+				EmailData:   strings.ReplaceAll(data, "\n", "<br>"),
+				Save:        true,
+				Type:        messaging.MessageType_INFO,
+				SendMethods: []messaging.SendMethod{sendMethod},
+			},
+			SmsAuth:         msg.smsAuth,
+			SmsCredentialId: msg.smsCredentialID,
+			FetchSmsAuth:    msg.fetchSmsAuth,
+		})
+		if sendErr == nil {
+			return nil
+		}
+
+		accountAPI.Logger.Warningf("failed to send otp to account %s by %s: %v", accountID, channel, sendErr)
+	}
+
+	if sendErr != nil {
+		return errs.WrapErrorWithMsg(sendErr, "failed to send otp")
+	}
+
+	return errs.WrapMessage(codes.FailedPrecondition, "account has no contact to send the otp to")
+}
+
+func (accountAPI *accountAPIServer) RequestSignInOTP(
+	ctx context.Context, req *account.RequestSignInOTPRequest,
+) (*empty.Empty, error) {
+	var err error
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("RequestChangePrivateAccountRequest")
+	case req.Username == "":
+		return nil, errs.MissingField("username")
+	}
+
+	// GetAccount the user from database
+	db, err := accountAPI.otpAccount(req.Project, req.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	err = accountAPI.checkOTPAllowed(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	err = accountAPI.sendOTP(ctx, db, &otpMessage{
+		title:           "OTP Login",
+		purpose:         "Login",
+		smsAuth:         req.GetSmsAuth(),
+		smsCredentialID: req.SmsCredentialId,
+		fetchSmsAuth:    req.FetchSmsAuth,
 	})
 	if err != nil {
-		return nil, errs.WrapErrorWithMsg(err, "failed to send otp to phone")
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
@@ -143,15 +234,9 @@ func (accountAPI *accountAPIServer) SignInOTP(
 	}
 
 	// Get the user from database
-	db := &Account{}
-	err = accountAPI.SQLDBWrites.
-		First(db, "account_id = ?", req.Username).Error
-	switch {
-	case err == nil:
-	case errors.Is(err, gorm.ErrRecordNotFound):
-		return nil, errs.WrapMessage(codes.NotFound, "account does not exist")
-	default:
-		return nil, errs.FailedToFind("account", err)
+	db, err := accountAPI.otpAccount(req.Project, req.Username)
+	if err != nil {
+		return nil, err
 	}
 
 	if db.AccountState == blockedState {
@@ -172,7 +257,13 @@ func (accountAPI *accountAPIServer) SignInOTP(
 	// Check if exceed trials
 	if trials > maxTrials {
 		// Block the account
-		err = accountAPI.SQLDBWrites.Model(db).Update("account_state", account.AccountState_BLOCKED.String()).Error
+		err = accountAPI.SQLDBWrites.Transaction(func(tx *gorm.DB) error {
+			err := tx.Model(db).Update("account_state", account.AccountState_BLOCKED.String()).Error
+			if err != nil {
+				return err
+			}
+			return accountAPI.addAccountEvent(tx, account.AccountEventType_ACCOUNT_STATE_CHANGED, db.AccountID, db.AccountState)
+		})
 		if err != nil {
 			accountAPI.Logger.Errorln(err)
 			return nil, errs.WrapMessage(codes.Internal, "failed to block account")
@@ -189,8 +280,8 @@ func (accountAPI *accountAPIServer) SignInOTP(
 
 	accountID := fmt.Sprint(db.AccountID)
 
-	// Get otp
-	otp, err := accountAPI.RedisDBWrites.Get(ctx, otpKey(accountID)).Result()
+	// Get otp hash
+	otpHash, err := accountAPI.RedisDBWrites.Get(ctx, otpKey(accountID)).Result()
 	switch {
 	case err == nil:
 	case errors.Is(err, redis.Nil):
@@ -200,17 +291,17 @@ func (accountAPI *accountAPIServer) SignInOTP(
 	}
 
 	// Compare otp
-	if otp != req.Otp {
+	if !otp.Verify(accountAPI.TokenSigningKey, accountID, req.Otp, otpHash) {
 		return nil, errs.WrapMessage(codes.Unauthenticated, "Login OTP do not match")
 	}
 
-	// Delete key
-	err = accountAPI.RedisDBWrites.Del(ctx, trialsKey).Err()
+	// Delete keys; the otp is used once
+	err = accountAPI.RedisDBWrites.Del(ctx, trialsKey, otpKey(accountID)).Err()
 	if err != nil {
 		return nil, errs.RedisCmdFailed(err, "DEL")
 	}
 
-	return accountAPI.updateSession(ctx, db, req.Group)
+	return accountAPI.signInSession(ctx, db, req.Group)
 }
 
 func (accountAPI *accountAPIServer) RequestActivateAccountOTP(
@@ -246,52 +337,20 @@ func (accountAPI *accountAPIServer) RequestActivateAccountOTP(
 		return nil, errs.FailedToFind("account", err)
 	}
 
-	accountID := fmt.Sprint(db.AccountID)
-
-	uniqueNumber := randomdata.Number(100000, 999999)
-
-	// Set token with expiration of 5 minutes
-	err = accountAPI.RedisDBWrites.Set(
-		ctx, otpKey(accountID), uniqueNumber, time.Duration(5*time.Minute),
-	).Err()
-	if err != nil {
-		return nil, errs.RedisCmdFailed(err, "SET")
-	}
-
-	// Generate token
-	jwt, err := accountAPI.AuthAPI.GenToken(ctx, &auth.Payload{
-		ID:           accountID,
-		ProjectID:    db.ProjectID,
-		Names:        db.Names,
-		EmailAddress: db.Email,
-		PhoneNumber:  db.Phone,
-		Group:        db.PrimaryGroup,
-	}, time.Now().Add(10*time.Minute))
+	err = accountAPI.checkOTPAllowed(ctx, db)
 	if err != nil {
 		return nil, err
 	}
 
-	// Outgoing context
-	ctxExt := metadata.NewOutgoingContext(ctx, metadata.Pairs(auth.Header(), fmt.Sprintf("Bearer %s", jwt)))
-
-	data := fmt.Sprintf(`Account verification OTP for %s. \n\nOTP is %d \nExpires in 10 minutes`, db.ProjectID, uniqueNumber)
-
-	// Send message
-	_, err = accountAPI.MessagingClient.SendMessage(ctxExt, &messaging.SendMessageRequest{
-		Message: &messaging.Message{
-			UserId:      accountID,
-			Title:       "Account Verification OTP",
-			Data:        data,
-			Save:        true,
-			Type:        messaging.MessageType_INFO,
-			SendMethods: []messaging.SendMethod{messaging.SendMethod_SMSV2},
-		},
-		SmsAuth:         req.GetSmsAuth(),
-		SmsCredentialId: req.SmsCredentialId,
-		FetchSmsAuth:    req.FetchSmsAuth,
+	err = accountAPI.sendOTP(ctx, db, &otpMessage{
+		title:           "Account Verification OTP",
+		purpose:         "Account verification",
+		smsAuth:         req.GetSmsAuth(),
+		smsCredentialID: req.SmsCredentialId,
+		fetchSmsAuth:    req.FetchSmsAuth,
 	})
 	if err != nil {
-		return nil, errs.WrapErrorWithMsg(err, "failed to send otp to phone")
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
@@ -333,8 +392,10 @@ func (accountAPI *accountAPIServer) ActivateAccountOTP(
 		return nil, errs.WrapMessage(codes.PermissionDenied, "account is blocked")
 	}
 
-	// Get otp
-	otp, err := accountAPI.RedisDBWrites.Get(ctx, otpKey(req.AccountId)).Result()
+	accountID := fmt.Sprint(db.AccountID)
+
+	// Get otp hash
+	otpHash, err := accountAPI.RedisDBWrites.Get(ctx, otpKey(accountID)).Result()
 	switch {
 	case err == nil:
 	case errors.Is(err, redis.Nil):
@@ -344,16 +405,27 @@ func (accountAPI *accountAPIServer) ActivateAccountOTP(
 	}
 
 	// Compare otp
-	if otp != req.Otp {
+	if !otp.Verify(accountAPI.TokenSigningKey, accountID, req.Otp, otpHash) {
 		return nil, errs.WrapMessage(codes.Unauthenticated, "Verification OTP do not match")
 	}
 
 	// Update the model of the user to activate their account
-	err = accountAPI.SQLDBWrites.Table(accountsTable).Where("account_id=?", db.AccountID).
-		Update("account_state", account.AccountState_ACTIVE.String()).Error
+	err = accountAPI.SQLDBWrites.Transaction(func(tx *gorm.DB) error {
+		err := tx.Table(accountsTable).Where("account_id=?", db.AccountID).
+			Update("account_state", account.AccountState_ACTIVE.String()).Error
+		if err != nil {
+			return err
+		}
+		return accountAPI.addAccountEvent(tx, account.AccountEventType_ACCOUNT_STATE_CHANGED, db.AccountID, db.AccountState)
+	})
 	if err != nil {
 		return nil, errs.FailedToUpdate("account", err)
 	}
 
+	err = accountAPI.RedisDBWrites.Del(ctx, otpKey(accountID)).Err()
+	if err != nil {
+		accountAPI.Logger.Errorf("failed to delete used otp: %v", err)
+	}
+
 	return &account.ActivateAccountResponse{}, nil
 }
@@ -0,0 +1,171 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/services/internal/pkg/otp"
+	"github.com/gidyon/services/pkg/api/account"
+	"github.com/gidyon/services/pkg/api/messaging"
+	"github.com/gidyon/services/pkg/mocks"
+	mocks_client "github.com/gidyon/services/pkg/mocks/mocks"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Sending OTPs according to project policies @otp", func() {
+	var (
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	createAccount := func(mutate func(*Account)) *Account {
+		pb := fakeAccount()
+		pb.ProjectId = projectID
+		db, err := AccountModel(pb)
+		Expect(err).ShouldNot(HaveOccurred())
+		if mutate != nil {
+			mutate(db)
+		}
+		Expect(AccountAPIServer.SQLDBWrites.Create(db).Error).ShouldNot(HaveOccurred())
+		return db
+	}
+
+	// messaging client that fails to deliver through the given send method
+	failingMessaging := func(failing messaging.SendMethod) *mocks_client.MessagingAPIClientMock {
+		client := &mocks_client.MessagingAPIClientMock{}
+		client.On("SendMessage", mock.Anything, mock.MatchedBy(func(req *messaging.SendMessageRequest) bool {
+			return req.Message.SendMethods[0] == failing
+		}), mock.Anything).Return(nil, errors.New("delivery failed"))
+		client.On("SendMessage", mock.Anything, mock.Anything, mock.Anything).
+			Return(&messaging.SendMessageResponse{MessageId: randomdata.RandStringRunes(10)}, nil)
+		return client
+	}
+
+	sentMethods := func(client *mocks_client.MessagingAPIClientMock) []messaging.SendMethod {
+		methods := make([]messaging.SendMethod, 0)
+		for _, call := range client.Calls {
+			methods = append(methods, call.Arguments.Get(1).(*messaging.SendMessageRequest).Message.SendMethods...)
+		}
+		return methods
+	}
+
+	var (
+		otpLimits   *OTPLimitOptions
+		otpPolicies map[string]*otp.Policy
+	)
+
+	BeforeEach(func() {
+		otpLimits = AccountAPIServer.otpLimits
+		otpPolicies = AccountAPIServer.OTPPolicies
+		AccountAPIServer.otpLimits = (&OTPLimitOptions{ResendCooldown: time.Millisecond}).withDefaults()
+	})
+
+	AfterEach(func() {
+		AccountAPIServer.otpLimits = otpLimits
+		AccountAPIServer.OTPPolicies = otpPolicies
+		AccountAPIServer.MessagingClient = mocks.MessagingAPI
+	})
+
+	It("should store a hash of the otp with the policy lifetime", func() {
+		AccountAPIServer.OTPPolicies = map[string]*otp.Policy{
+			projectID: {Length: 8, Alphabet: "ABCDEF", TTLSeconds: 120},
+		}
+		db := createAccount(nil)
+		accountID := fmt.Sprint(db.AccountID)
+
+		_, err := AccountAPI.RequestSignInOTP(ctx, &account.RequestSignInOTPRequest{Username: accountID})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		stored, err := AccountAPIServer.RedisDBWrites.Get(ctx, otpKey(accountID)).Result()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(stored).Should(HaveLen(64))
+
+		ttl, err := AccountAPIServer.RedisDBWrites.TTL(ctx, otpKey(accountID)).Result()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(ttl).Should(BeNumerically("~", 2*time.Minute, 5*time.Second))
+	})
+
+	It("should sign in once with the otp", func() {
+		db := createAccount(nil)
+		accountID := fmt.Sprint(db.AccountID)
+
+		err := AccountAPIServer.RedisDBWrites.Set(
+			ctx, otpKey(accountID), otp.Hash(AccountAPIServer.TokenSigningKey, accountID, "123456"), time.Minute,
+		).Err()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, err = AccountAPI.SignInOTP(ctx, &account.SignInOTPRequest{Username: accountID, Otp: "654321"})
+		Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+
+		res, err := AccountAPI.SignInOTP(ctx, &account.SignInOTPRequest{Username: accountID, Otp: "123456"})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(res.AccountId).Should(Equal(accountID))
+
+		_, err = AccountAPI.SignInOTP(ctx, &account.SignInOTPRequest{Username: accountID, Otp: "123456"})
+		Expect(status.Code(err)).Should(Equal(codes.DeadlineExceeded))
+	})
+
+	It("should require the second factor of accounts with two-factor authentication", func() {
+		db := createAccount(nil)
+		accountID := fmt.Sprint(db.AccountID)
+
+		err := AccountAPIServer.SQLDBWrites.Create(&MFA{
+			AccountID:   db.AccountID,
+			TOTPSecret:  "secret",
+			BackupCodes: []byte("[]"),
+			Enabled:     true,
+		}).Error
+		Expect(err).ShouldNot(HaveOccurred())
+
+		err = AccountAPIServer.RedisDBWrites.Set(
+			ctx, otpKey(accountID), otp.Hash(AccountAPIServer.TokenSigningKey, accountID, "123456"), time.Minute,
+		).Err()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		res, err := AccountAPI.SignInOTP(ctx, &account.SignInOTPRequest{Username: accountID, Otp: "123456"})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(res.MfaRequired).Should(BeTrue())
+		Expect(res.Token).Should(BeZero())
+
+		// Accounts signed in by an identity provider too
+		res, err = AccountAPIServer.signInExternalAccount(ctx, projectID, &account.Account{Email: db.Email})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(res.MfaRequired).Should(BeTrue())
+		Expect(res.Token).Should(BeZero())
+	})
+
+	It("should fall back to the next channel when delivery fails", func() {
+		AccountAPIServer.OTPPolicies = map[string]*otp.Policy{
+			otp.DefaultPolicy: {Channels: []string{otp.ChannelPush, otp.ChannelSMS, otp.ChannelEmail}},
+		}
+		client := failingMessaging(messaging.SendMethod_SMSV2)
+		AccountAPIServer.MessagingClient = client
+
+		// Accounts without a device token are not sent push messages
+		db := createAccount(func(db *Account) { db.DeviceToken = "" })
+
+		_, err := AccountAPI.RequestSignInOTP(ctx, &account.RequestSignInOTPRequest{Username: fmt.Sprint(db.AccountID)})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(sentMethods(client)).Should(Equal([]messaging.SendMethod{
+			messaging.SendMethod_SMSV2, messaging.SendMethod_EMAIL,
+		}))
+	})
+
+	It("should fail when the account cannot be reached through the channels", func() {
+		AccountAPIServer.OTPPolicies = map[string]*otp.Policy{
+			otp.DefaultPolicy: {Channels: []string{otp.ChannelPush}},
+		}
+		db := createAccount(func(db *Account) { db.DeviceToken = "" })
+
+		_, err := AccountAPI.RequestSignInOTP(ctx, &account.RequestSignInOTPRequest{Username: fmt.Sprint(db.AccountID)})
+		Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+	})
+})
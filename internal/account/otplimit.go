@@ -0,0 +1,230 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+	redis "github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+)
+
+// OTPLimitOptions configures limits on sending OTPs
+type OTPLimitOptions struct {
+	// Period in which OTP requests are counted
+	Window time.Duration
+	// OTP requests allowed per account in the window
+	MaxPerAccount int
+	// OTP requests allowed per destination phone in the window
+	MaxPerPhone int
+	// OTP requests allowed per client IP in the window
+	MaxPerIP int
+	// Wait before another OTP can be sent to an account
+	ResendCooldown time.Duration
+	// OTPs that can be sent per project in a day; the budget named "default" applies to projects without
+	// their own budget. Projects without a budget are not limited.
+	DailyBudgets map[string]int
+}
+
+// DefaultOTPLimitOptions are used when OTP limit options are not provided
+var DefaultOTPLimitOptions = OTPLimitOptions{
+	Window:         time.Hour,
+	MaxPerAccount:  5,
+	MaxPerPhone:    5,
+	MaxPerIP:       20,
+	ResendCooldown: time.Minute,
+}
+
+func (opt *OTPLimitOptions) withDefaults() *OTPLimitOptions {
+	out := DefaultOTPLimitOptions
+	if opt == nil {
+		return &out
+	}
+	out.DailyBudgets = opt.DailyBudgets
+	if opt.Window > 0 {
+		out.Window = opt.Window
+	}
+	if opt.MaxPerAccount > 0 {
+		out.MaxPerAccount = opt.MaxPerAccount
+	}
+	if opt.MaxPerPhone > 0 {
+		out.MaxPerPhone = opt.MaxPerPhone
+	}
+	if opt.MaxPerIP > 0 {
+		out.MaxPerIP = opt.MaxPerIP
+	}
+	if opt.ResendCooldown > 0 {
+		out.ResendCooldown = opt.ResendCooldown
+	}
+	return &out
+}
+
+func (opt *OTPLimitOptions) dailyBudget(projectID string) int {
+	if budget, ok := opt.DailyBudgets[projectID]; ok {
+		return budget
+	}
+	return opt.DailyBudgets["default"]
+}
+
+// LoadOTPBudgets reads a JSON file mapping project ids to the OTPs they can send in a day
+func LoadOTPBudgets(file string) (map[string]int, error) {
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read otp budgets: %w", err)
+	}
+
+	budgets := make(map[string]int)
+	err = json.Unmarshal(bs, &budgets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse otp budgets: %w", err)
+	}
+
+	return budgets, nil
+}
+
+// Limits on OTP requests
+const (
+	otpLimitCooldown = "cooldown"
+	otpLimitAccount  = "account"
+	otpLimitPhone    = "phone"
+	otpLimitIP       = "ip"
+	otpLimitBudget   = "budget"
+)
+
+// otpLimitBreaches counts rejected OTP requests by the limit they exceeded
+var otpLimitBreaches = expvar.NewMap("account_otp_limit_breaches")
+
+func otpCooldownKey(accountID uint) string {
+	return fmt.Sprintf("otpcooldown:%d", accountID)
+}
+
+func otpRequestsKey(limit, subject string) string {
+	return "otprequests:" + limit + ":" + subject
+}
+
+func otpBudgetKey(projectID string, day time.Time) string {
+	return "otpbudget:" + projectID + ":" + day.Format("20060102")
+}
+
+// counts a request against a limit returning the requests in the current window and when the window ends
+func (accountAPI *accountAPIServer) countOTPRequest(
+	ctx context.Context, key string, window time.Duration,
+) (int64, time.Duration, error) {
+	var (
+		incr *redis.IntCmd
+		pttl *redis.DurationCmd
+	)
+	_, err := accountAPI.RedisDBWrites.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pttl = pipe.PTTL(ctx, key)
+		return nil
+	})
+	if err != nil {
+		return 0, 0, errs.RedisCmdFailed(err, "INCR")
+	}
+
+	ttl := pttl.Val()
+	if ttl <= 0 {
+		// The window starts with the first request
+		err = accountAPI.RedisDBWrites.PExpire(ctx, key, window).Err()
+		if err != nil {
+			return 0, 0, errs.RedisCmdFailed(err, "PEXPIRE")
+		}
+		ttl = window
+	}
+
+	return incr.Val(), ttl, nil
+}
+
+func otpLimitError(limit, reason, msg string, retryAfter time.Duration) error {
+	otpLimitBreaches.Add(limit, 1)
+	return retryError(
+		codes.ResourceExhausted, reason, fmt.Sprintf("%s; try again in %s", msg, retryAfter.Round(time.Second)), retryAfter,
+	)
+}
+
+// counts a request against the limit of the subject
+func (accountAPI *accountAPIServer) checkOTPLimit(ctx context.Context, limit, subject string, max int) error {
+	if subject == "" {
+		return nil
+	}
+	requests, ttl, err := accountAPI.countOTPRequest(ctx, otpRequestsKey(limit, subject), accountAPI.otpLimits.Window)
+	if err != nil {
+		return err
+	}
+	if requests > int64(max) {
+		return otpLimitError(limit, "OTP_RATE_LIMITED", fmt.Sprintf("too many OTP requests for the %s", limit), ttl)
+	}
+	return nil
+}
+
+// checks that an OTP can be sent to the account and counts it against the limits.
+// db is nil when the request did not match an account; it then only counts against the limit of the client.
+func (accountAPI *accountAPIServer) checkOTPAllowed(ctx context.Context, db *Account) error {
+	var (
+		limits = accountAPI.otpLimits
+		ipAddr = clientIP(ctx, accountAPI.trustedProxies)
+	)
+
+	if db == nil {
+		return accountAPI.checkOTPLimit(ctx, otpLimitIP, ipAddr, limits.MaxPerIP)
+	}
+
+	// The cooldown is claimed first so that concurrent requests do not send more than one OTP
+	ok, err := accountAPI.RedisDBWrites.SetNX(ctx, otpCooldownKey(db.AccountID), 1, limits.ResendCooldown).Result()
+	if err != nil {
+		return errs.RedisCmdFailed(err, "SETNX")
+	}
+	if !ok {
+		ttl, err := accountAPI.RedisDBWrites.PTTL(ctx, otpCooldownKey(db.AccountID)).Result()
+		if err != nil {
+			return errs.RedisCmdFailed(err, "PTTL")
+		}
+		return otpLimitError(otpLimitCooldown, "OTP_RESEND_COOLDOWN", "an OTP was sent recently", ttl)
+	}
+
+	subjects := []struct {
+		limit   string
+		subject string
+		max     int
+	}{
+		{otpLimitAccount, fmt.Sprint(db.AccountID), limits.MaxPerAccount},
+		{otpLimitPhone, db.Phone, limits.MaxPerPhone},
+		{otpLimitIP, ipAddr, limits.MaxPerIP},
+	}
+
+	for _, s := range subjects {
+		err = accountAPI.checkOTPLimit(ctx, s.limit, s.subject, s.max)
+		if err != nil {
+			return err
+		}
+	}
+
+	budget := limits.dailyBudget(db.ProjectID)
+	if budget <= 0 {
+		return nil
+	}
+
+	var (
+		now        = time.Now().UTC()
+		nextDay    = time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+		retryAfter = nextDay.Sub(now)
+	)
+
+	sent, _, err := accountAPI.countOTPRequest(ctx, otpBudgetKey(db.ProjectID, now), retryAfter)
+	if err != nil {
+		return err
+	}
+	if sent > int64(budget) {
+		if sent == int64(budget)+1 {
+			accountAPI.Logger.Errorf("daily otp budget of %d for project %q is exhausted", budget, db.ProjectID)
+		}
+		return otpLimitError(otpLimitBudget, "OTP_BUDGET_EXHAUSTED", "daily OTP budget of the project is exhausted", retryAfter)
+	}
+
+	return nil
+}
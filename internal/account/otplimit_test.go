@@ -0,0 +1,132 @@
+package account
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"time"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/services/pkg/api/account"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Limiting OTP requests @otplimit", func() {
+	var (
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	createAccount := func(projectID string) string {
+		pb := fakeAccount()
+		pb.ProjectId = projectID
+		db, err := AccountModel(pb)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(AccountAPIServer.SQLDBWrites.Create(db).Error).ShouldNot(HaveOccurred())
+		return fmt.Sprint(db.AccountID)
+	}
+
+	retryDelay := func(err error) time.Duration {
+		for _, detail := range status.Convert(err).Details() {
+			if info, ok := detail.(*errdetails.RetryInfo); ok {
+				return info.RetryDelay.AsDuration()
+			}
+		}
+		return 0
+	}
+
+	breachCount := func(limit string) int64 {
+		if v, ok := otpLimitBreaches.Get(limit).(*expvar.Int); ok {
+			return v.Value()
+		}
+		return 0
+	}
+
+	Describe("Resolving options", func() {
+		It("should use defaults for missing values", func() {
+			opt := (*OTPLimitOptions)(nil).withDefaults()
+			Expect(*opt).Should(Equal(DefaultOTPLimitOptions))
+		})
+		It("should use the default budget for projects without their own budget", func() {
+			opt := (&OTPLimitOptions{DailyBudgets: map[string]int{"default": 10, "big": 100}}).withDefaults()
+			Expect(opt.dailyBudget("big")).Should(Equal(100))
+			Expect(opt.dailyBudget("other")).Should(Equal(10))
+			Expect((*OTPLimitOptions)(nil).withDefaults().dailyBudget("other")).Should(BeZero())
+		})
+	})
+
+	Describe("Requesting OTPs repeatedly", func() {
+		var otpLimits *OTPLimitOptions
+
+		BeforeEach(func() {
+			otpLimits = AccountAPIServer.otpLimits
+		})
+
+		AfterEach(func() {
+			AccountAPIServer.otpLimits = otpLimits
+		})
+
+		It("should wait for the cooldown before resending an OTP", func() {
+			accountID := createAccount(projectID)
+			breaches := breachCount(otpLimitCooldown)
+
+			_, err := AccountAPI.RequestSignInOTP(ctx, &account.RequestSignInOTPRequest{Username: accountID})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = AccountAPI.RequestSignInOTP(ctx, &account.RequestSignInOTPRequest{Username: accountID})
+			Expect(status.Code(err)).Should(Equal(codes.ResourceExhausted))
+			Expect(retryDelay(err)).Should(BeNumerically(">", 0))
+			Expect(retryDelay(err)).Should(BeNumerically("<=", otpLimits.ResendCooldown))
+
+			Expect(breachCount(otpLimitCooldown)).Should(Equal(breaches + 1))
+		})
+
+		It("should limit OTPs sent to an account in the window", func() {
+			AccountAPIServer.otpLimits = (&OTPLimitOptions{
+				MaxPerAccount:  2,
+				ResendCooldown: time.Millisecond,
+			}).withDefaults()
+
+			accountID := createAccount(projectID)
+
+			for i := 0; i < 2; i++ {
+				_, err := AccountAPI.RequestSignInOTP(ctx, &account.RequestSignInOTPRequest{Username: accountID})
+				Expect(err).ShouldNot(HaveOccurred())
+				time.Sleep(5 * time.Millisecond)
+			}
+
+			_, err := AccountAPI.RequestSignInOTP(ctx, &account.RequestSignInOTPRequest{Username: accountID})
+			Expect(status.Code(err)).Should(Equal(codes.ResourceExhausted))
+			Expect(retryDelay(err)).Should(BeNumerically(">", time.Minute))
+		})
+
+		It("should stop sending OTPs of a project when its daily budget is spent", func() {
+			budgetProject := randomdata.RandStringRunes(10)
+			AccountAPIServer.otpLimits = (&OTPLimitOptions{
+				ResendCooldown: time.Millisecond,
+				DailyBudgets:   map[string]int{budgetProject: 1},
+			}).withDefaults()
+
+			_, err := AccountAPI.RequestSignInOTP(ctx, &account.RequestSignInOTPRequest{
+				Username: createAccount(budgetProject),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = AccountAPI.RequestSignInOTP(ctx, &account.RequestSignInOTPRequest{
+				Username: createAccount(budgetProject),
+			})
+			Expect(status.Code(err)).Should(Equal(codes.ResourceExhausted))
+
+			// Other projects are not affected
+			_, err = AccountAPI.RequestSignInOTP(ctx, &account.RequestSignInOTPRequest{
+				Username: createAccount(projectID),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+})
@@ -1,20 +1,26 @@
 package account
 
-import (
-	"golang.org/x/crypto/bcrypt"
-)
-
-// generates hashed version of password
-func genHash(password string) (string, error) {
-	hashedBytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-	if err != nil {
-		return "", err
-	}
-
-	return string(hashedBytes), nil
+// generates hashed version of password using the current hashing policy
+func (accountAPI *accountAPIServer) genHash(password string) (string, error) {
+	return accountAPI.passwordHasher.Hash(password)
 }
 
 // compares hashed password with password
-func compareHash(hashedPassword, password string) error {
-	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
+func (accountAPI *accountAPIServer) compareHash(hashedPassword, password string) error {
+	return accountAPI.passwordHasher.Compare(hashedPassword, password)
+}
+
+// upgrades password hash to the current hashing policy
+func (accountAPI *accountAPIServer) rehashPassword(db *Account, password string) {
+	if !accountAPI.passwordHasher.NeedsRehash(db.Password) {
+		return
+	}
+
+	newPass, err := accountAPI.genHash(password)
+	if err == nil {
+		err = accountAPI.SQLDBWrites.Model(db).Update("password", newPass).Error
+	}
+	if err != nil {
+		accountAPI.Logger.Errorf("failed to rehash password of account %d: %v", db.AccountID, err)
+	}
 }
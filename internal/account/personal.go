@@ -0,0 +1,671 @@
+package account
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/micro/v2/utils/mdutil"
+	"github.com/gidyon/services/pkg/api/account"
+	"github.com/gidyon/services/pkg/api/longrunning"
+	"github.com/gidyon/services/pkg/api/messaging"
+	"github.com/gidyon/services/pkg/api/settings"
+	"github.com/gidyon/services/pkg/api/subscriber"
+	redis "github.com/go-redis/redis/v8"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+const (
+	personalDataTimeout  = time.Hour
+	personalDataPageSize = 50
+	dataExportTTL        = 7 * 24 * time.Hour
+	erasedNames          = "Erased Account"
+	// Archives are kept in redis so larger exports are refused
+	maxDataExportSize = 16 << 20
+)
+
+func dataExportKey(operationID string) string {
+	return "accountdataexports:" + operationID
+}
+
+// set while an export of the account is being built
+func dataExportPendingKey(accountID string) string {
+	return "accountdataexportpending:" + accountID
+}
+
+func dataExportPath(operationID string) string {
+	return "/api/accounts/data-exports/" + operationID
+}
+
+// personalDataOperation is a long running operation on the personal data of an account
+type personalDataOperation struct {
+	id  string
+	ctx context.Context
+	db  *Account
+}
+
+// starts a long running operation on the personal data of an account. The operation
+// authenticates as the account so that every service authorizes it as the owner of the data.
+func (accountAPI *accountAPIServer) startPersonalDataOperation(
+	ctx context.Context, accountID, details string,
+) (*personalDataOperation, error) {
+	// Validation
+	var (
+		ID  int
+		err error
+	)
+	switch {
+	case accountID == "":
+		err = errs.MissingField("account id")
+	case accountAPI.OperationsClient == nil:
+		err = errs.WrapMessage(codes.Unavailable, "long running operations not available")
+	default:
+		ID, err = strconv.Atoi(accountID)
+		if err != nil {
+			err = errs.IncorrectVal("account id")
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	payload, err := accountAPI.AuthAPI.AuthorizeActorOrGroup(ctx, accountID, accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	db := &Account{}
+	err = accountAPI.SQLDBWrites.Unscoped().First(db, "account_id=?", ID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("account", accountID)
+	default:
+		return nil, errs.FailedToFind("account", err)
+	}
+
+	if payload.ProjectID != "" && accountAPI.AuthAPI.IsAdmin(payload.Group) && payload.ProjectID != db.ProjectID {
+		return nil, errs.WrapMessage(codes.PermissionDenied, "account belongs to another project")
+	}
+
+	token, err := accountAPI.AuthAPI.GenToken(ctx, &auth.Payload{
+		ID:           accountID,
+		ProjectID:    db.ProjectID,
+		Names:        db.Names,
+		PhoneNumber:  db.Phone,
+		EmailAddress: db.Email,
+		Group:        db.PrimaryGroup,
+	}, time.Now().Add(personalDataTimeout))
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate token")
+	}
+	opCtx := mdutil.AddMD(context.Background(), metadata.Pairs(auth.Header(), fmt.Sprintf("%s %s", auth.Scheme(), token)))
+
+	op, err := accountAPI.OperationsClient.CreateOperation(opCtx, &longrunning.CreateOperationRequest{
+		Operation: &longrunning.Operation{
+			UserId:       accountID,
+			Details:      details,
+			Origin:       "account",
+			Status:       longrunning.OperationStatus_PENDING,
+			TimestampSec: time.Now().Unix(),
+		},
+	})
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Unavailable, err, "failed to create long running operation")
+	}
+
+	return &personalDataOperation{id: op.Id, ctx: opCtx, db: db}, nil
+}
+
+// runs fn in the background and completes the long running operation with its result
+func (accountAPI *accountAPIServer) runPersonalDataOperation(
+	op *personalDataOperation, fn func(ctx context.Context) (map[string]interface{}, error),
+) {
+	go func() {
+		ctx, cancel := context.WithTimeout(op.ctx, personalDataTimeout)
+		defer cancel()
+
+		var (
+			result map[string]interface{}
+			err    error
+		)
+
+		func() {
+			defer func() {
+				if v := recover(); v != nil {
+					err = fmt.Errorf("recovered from panic: %v", v)
+				}
+			}()
+			result, err = fn(ctx)
+		}()
+
+		opStatus := longrunning.OperationStatus_COMPLETED
+		if err != nil {
+			accountAPI.Logger.Errorf("personal data operation %s failed: %v", op.id, err)
+			opStatus = longrunning.OperationStatus_FAILED
+			result = map[string]interface{}{"error": err.Error()}
+		}
+
+		bs, _ := json.Marshal(result)
+
+		_, err = accountAPI.OperationsClient.UpdateOperation(ctx, &longrunning.UpdateOperationRequest{
+			OperationId: op.id,
+			Result:      string(bs),
+			Status:      opStatus,
+		})
+		if err != nil {
+			accountAPI.Logger.Errorf("failed to update personal data operation %s: %v", op.id, err)
+		}
+	}()
+}
+
+// notifies the owner of the account that an operation on their data has completed
+func (accountAPI *accountAPIServer) notifyPersonalData(ctx context.Context, db *Account, title, data, link string, save bool) {
+	sendMethod := messaging.SendMethod_EMAIL
+	if db.Email == "" {
+		sendMethod = messaging.SendMethod_SMSV2
+	}
+
+	_, err := accountAPI.MessagingClient.SendMessage(ctx, &messaging.SendMessageRequest{
+		Message: &messaging.Message{
+			UserId:      fmt.Sprint(db.AccountID),
+			Title:       title,
+			Data:        data,
+			EmailData:   data,
+			Link:        link,
+			Save:        save,
+			Type:        messaging.MessageType_INFO,
+			SendMethods: []messaging.SendMethod{sendMethod},
+		},
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		accountAPI.Logger.Errorf("failed to send personal data notification to account %d: %v", db.AccountID, err)
+	}
+}
+
+// dataExportArchive writes sections of a personal data export as JSON files of a zip archive
+type dataExportArchive struct {
+	zw       *zip.Writer
+	sections []string
+}
+
+func (a *dataExportArchive) add(name string, msgs ...proto.Message) error {
+	items := make([]json.RawMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		bs, err := protojson.Marshal(msg)
+		if err != nil {
+			return errs.FromProtoMarshal(err, name)
+		}
+		items = append(items, bs)
+	}
+
+	bs, err := json.MarshalIndent(items, "", "  ")
+	if err != nil {
+		return errs.FromJSONMarshal(err, name)
+	}
+
+	w, err := a.zw.Create(name + ".json")
+	if err != nil {
+		return errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to add archive file")
+	}
+	_, err = w.Write(bs)
+	if err != nil {
+		return errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to write archive file")
+	}
+
+	a.sections = append(a.sections, name)
+
+	return nil
+}
+
+// lists messages saved for the account in the messaging service
+func (accountAPI *accountAPIServer) personalMessages(ctx context.Context, accountID string) ([]proto.Message, error) {
+	msgs := make([]proto.Message, 0)
+	pageToken := ""
+	for {
+		listRes, err := accountAPI.MessagingClient.ListMessages(ctx, &messaging.ListMessagesRequest{
+			PageToken: pageToken,
+			PageSize:  personalDataPageSize,
+			Filter:    &messaging.ListMessagesFilter{UserId: accountID},
+		}, grpc.WaitForReady(true))
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to list messages")
+		}
+		for _, msg := range listRes.Messages {
+			msgs = append(msgs, msg)
+		}
+		pageToken = listRes.NextPageToken
+		if pageToken == "" {
+			return msgs, nil
+		}
+	}
+}
+
+// lists long running operations of the account
+func (accountAPI *accountAPIServer) personalOperations(ctx context.Context, accountID string) ([]*longrunning.Operation, error) {
+	ops := make([]*longrunning.Operation, 0)
+	pageToken := ""
+	for {
+		listRes, err := accountAPI.OperationsClient.ListOperations(ctx, &longrunning.ListOperationsRequest{
+			PageToken: pageToken,
+			PageSize:  personalDataPageSize,
+			Filter:    &longrunning.ListOperationsFilter{UserId: accountID},
+		}, grpc.WaitForReady(true))
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to list operations")
+		}
+		ops = append(ops, listRes.Operations...)
+		pageToken = listRes.NextPageToken
+		if pageToken == "" {
+			return ops, nil
+		}
+	}
+}
+
+// lists sessions of the account
+func (accountAPI *accountAPIServer) personalSessions(ctx context.Context, accountID string) ([]proto.Message, error) {
+	sessionIDs, err := accountAPI.RedisDBWrites.SMembers(ctx, accountSessionsKey(accountID)).Result()
+	if err != nil {
+		return nil, errs.RedisCmdFailed(err, "smembers")
+	}
+
+	sessions := make([]proto.Message, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		sess, err := accountAPI.getSession(ctx, sessionID)
+		// Session expired
+		if err != nil || sess == nil {
+			continue
+		}
+		sessions = append(sessions, sessionProto(sess))
+	}
+
+	return sessions, nil
+}
+
+// builds the archive of all personal data of an account
+func (accountAPI *accountAPIServer) buildDataExport(ctx context.Context, db *Account) ([]byte, []string, error) {
+	accountID := fmt.Sprint(db.AccountID)
+
+	buf := &bytes.Buffer{}
+	archive := &dataExportArchive{zw: zip.NewWriter(buf)}
+
+	// Profile
+	pb, err := AccountProto(db)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = archive.add("profile", pb); err != nil {
+		return nil, nil, err
+	}
+
+	// Linked identities
+	identityDBs := make([]*LinkedIdentity, 0)
+	err = accountAPI.SQLDBWrites.Find(&identityDBs, "account_id=?", db.AccountID).Error
+	if err != nil {
+		return nil, nil, errs.FailedToFind("linked identities", err)
+	}
+	identities := make([]proto.Message, 0, len(identityDBs))
+	for _, identityDB := range identityDBs {
+		identities = append(identities, linkedIdentityProto(identityDB))
+	}
+	if err = archive.add("identities", identities...); err != nil {
+		return nil, nil, err
+	}
+
+	// Sessions
+	sessions, err := accountAPI.personalSessions(ctx, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = archive.add("sessions", sessions...); err != nil {
+		return nil, nil, err
+	}
+
+	// Saved messages
+	msgs, err := accountAPI.personalMessages(ctx, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = archive.add("messages", msgs...); err != nil {
+		return nil, nil, err
+	}
+
+	// Subscriptions
+	if accountAPI.SubscriberClient != nil {
+		subscriberPB, err := accountAPI.SubscriberClient.GetSubscriber(ctx, &subscriber.GetSubscriberRequest{
+			SubscriberId: accountID,
+		}, grpc.WaitForReady(true))
+		if err != nil {
+			return nil, nil, errs.WrapErrorWithMsg(err, "failed to get subscriptions")
+		}
+		if err = archive.add("subscriptions", subscriberPB); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Settings
+	if accountAPI.SettingsClient != nil {
+		settingsRes, err := accountAPI.SettingsClient.GetSettings(ctx, &settings.GetSettingsRequest{
+			OwnerId: accountID,
+		}, grpc.WaitForReady(true))
+		switch {
+		case err == nil:
+		case status.Code(err) == codes.NotFound:
+			settingsRes = &settings.GetSettingsResponse{}
+		default:
+			return nil, nil, errs.WrapErrorWithMsg(err, "failed to get settings")
+		}
+		if err = archive.add("settings", settingsRes); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Long running operations
+	ops, err := accountAPI.personalOperations(ctx, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	opMsgs := make([]proto.Message, 0, len(ops))
+	for _, op := range ops {
+		opMsgs = append(opMsgs, op)
+	}
+	if err = archive.add("operations", opMsgs...); err != nil {
+		return nil, nil, err
+	}
+
+	err = archive.zw.Close()
+	if err != nil {
+		return nil, nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to close archive")
+	}
+
+	if buf.Len() > maxDataExportSize {
+		return nil, nil, errs.WrapMessagef(
+			codes.ResourceExhausted, "data export of %d bytes exceeds the limit of %d bytes", buf.Len(), maxDataExportSize,
+		)
+	}
+
+	return buf.Bytes(), archive.sections, nil
+}
+
+func (accountAPI *accountAPIServer) RequestDataExport(
+	ctx context.Context, req *account.RequestDataExportRequest,
+) (*account.PersonalDataOperation, error) {
+	// Validation
+	if req == nil {
+		return nil, errs.NilObject("request data export request")
+	}
+
+	if err := denyImpersonation(ctx); err != nil {
+		return nil, err
+	}
+
+	// One export of an account is built at a time
+	ok, err := accountAPI.RedisDBWrites.SetNX(ctx, dataExportPendingKey(req.AccountId), 1, personalDataTimeout).Result()
+	if err != nil {
+		return nil, errs.RedisCmdFailed(err, "SETNX")
+	}
+	if !ok {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "a data export of the account is already in progress")
+	}
+
+	op, err := accountAPI.startPersonalDataOperation(ctx, req.AccountId, "Exporting personal data of account "+req.AccountId)
+	if err != nil {
+		accountAPI.RedisDBWrites.Del(ctx, dataExportPendingKey(req.AccountId))
+		return nil, err
+	}
+
+	accountAPI.runPersonalDataOperation(op, func(ctx context.Context) (map[string]interface{}, error) {
+		defer accountAPI.RedisDBWrites.Del(op.ctx, dataExportPendingKey(req.AccountId))
+
+		archive, sections, err := accountAPI.buildDataExport(ctx, op.db)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = accountAPI.RedisDBWrites.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.HSet(ctx, dataExportKey(op.id), "account_id", req.AccountId, "archive", archive)
+			pipe.Expire(ctx, dataExportKey(op.id), dataExportTTL)
+			return nil
+		})
+		if err != nil {
+			return nil, errs.RedisCmdFailed(err, "hset")
+		}
+
+		accountAPI.notifyPersonalData(
+			ctx, op.db, "Your data export is ready",
+			fmt.Sprintf("The archive of your personal data is ready for download for the next %d days.", dataExportTTL/(24*time.Hour)),
+			dataExportPath(op.id), true,
+		)
+
+		return map[string]interface{}{
+			"archive":  dataExportPath(op.id),
+			"sections": sections,
+			"size":     len(archive),
+		}, nil
+	})
+
+	return &account.PersonalDataOperation{
+		OperationId: op.id,
+		AccountId:   req.AccountId,
+	}, nil
+}
+
+func (accountAPI *accountAPIServer) GetDataExport(
+	ctx context.Context, req *account.GetDataExportRequest,
+) (*httpbody.HttpBody, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("get data export request")
+	case req.OperationId == "":
+		return nil, errs.MissingField("operation id")
+	}
+
+	vals, err := accountAPI.RedisDBWrites.HGetAll(ctx, dataExportKey(req.OperationId)).Result()
+	switch {
+	case err != nil:
+		return nil, errs.RedisCmdFailed(err, "hgetall")
+	case len(vals) == 0:
+		return nil, errs.DoesNotExist("data export", req.OperationId)
+	}
+
+	// Authorization
+	_, err = accountAPI.AuthAPI.AuthorizeActorOrGroup(ctx, vals["account_id"], accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = grpc.SetHeader(ctx, metadata.Pairs(
+		"content-disposition", fmt.Sprintf("attachment; filename=account-%s-data.zip", vals["account_id"]),
+	))
+	if err != nil {
+		accountAPI.Logger.Warningf("failed to set data export header: %v", err)
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: "application/zip",
+		Data:        []byte(vals["archive"]),
+	}, nil
+}
+
+// anonymises the account and removes its credentials so that it no longer identifies a person
+func eraseAccount(tx *gorm.DB, accountID uint) error {
+	err := tx.Unscoped().Model(&Account{}).Where("account_id=?", accountID).Updates(map[string]interface{}{
+		"email":             fmt.Sprint(accountID),
+		"phone":             fmt.Sprint(accountID),
+		"device_token":      "",
+		"names":             erasedNames,
+		"birth_date":        "",
+		"gender":            account.Account_GENDER_UNSPECIFIED.String(),
+		"id_number":         "",
+		"profession":        "",
+		"residence":         "",
+		"nationality":       "",
+		"profile_url":       "",
+		"linked_accounts":   "",
+		"security_question": "",
+		"security_answer":   "",
+		"password":          "",
+		"attributes":        nil,
+		"account_state":     account.AccountState_BLOCKED.String(),
+	}).Error
+	if err != nil {
+		return errs.FailedToUpdate("account", err)
+	}
+
+	return deleteAccountCredentials(tx, accountID)
+}
+
+// deletes linked identities, second factors and password history of an account
+func deleteAccountCredentials(tx *gorm.DB, accountID uint) error {
+	for _, model := range []interface{}{&LinkedIdentity{}, &MFA{}, &PasswordHistory{}} {
+		err := tx.Unscoped().Delete(model, "account_id=?", accountID).Error
+		if err != nil {
+			return errs.FailedToDelete("account credentials", err)
+		}
+	}
+	return nil
+}
+
+// anonymises personal data of an account held by the other services
+func (accountAPI *accountAPIServer) erasePersonalData(ctx context.Context, accountID, operationID string) ([]string, error) {
+	erased := make([]string, 0, 4)
+
+	// Saved messages
+	_, err := accountAPI.MessagingClient.EraseMessages(ctx, &messaging.MessageRequest{
+		UserId: accountID,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, errs.WrapErrorWithMsg(err, "failed to erase messages")
+	}
+	erased = append(erased, "messages")
+
+	// Subscriptions
+	if accountAPI.SubscriberClient != nil {
+		subscriberPB, err := accountAPI.SubscriberClient.GetSubscriber(ctx, &subscriber.GetSubscriberRequest{
+			SubscriberId: accountID,
+		}, grpc.WaitForReady(true))
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to get subscriptions")
+		}
+		if len(subscriberPB.Channels) > 0 {
+			_, err = accountAPI.SubscriberClient.Unsubscribe(ctx, &subscriber.SubscriberRequest{
+				SubscriberId: accountID,
+				Channels:     subscriberPB.Channels,
+			}, grpc.WaitForReady(true))
+			if err != nil {
+				return nil, errs.WrapErrorWithMsg(err, "failed to remove subscriptions")
+			}
+		}
+		erased = append(erased, "subscriptions")
+	}
+
+	// Settings
+	if accountAPI.SettingsClient != nil {
+		_, err = accountAPI.SettingsClient.EraseSettings(ctx, &settings.EraseSettingsRequest{
+			OwnerId: accountID,
+		}, grpc.WaitForReady(true))
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to erase settings")
+		}
+		erased = append(erased, "settings")
+	}
+
+	// Long running operations and the data exports they built, except the erasure itself
+	ops, err := accountAPI.personalOperations(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range ops {
+		if op.Id == operationID {
+			continue
+		}
+		_, err = accountAPI.OperationsClient.DeleteOperation(ctx, &longrunning.DeleteOperationRequest{
+			UserId:      accountID,
+			OperationId: op.Id,
+		}, grpc.WaitForReady(true))
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to delete operation")
+		}
+		err = accountAPI.RedisDBWrites.Del(ctx, dataExportKey(op.Id)).Err()
+		if err != nil {
+			return nil, errs.RedisCmdFailed(err, "del")
+		}
+	}
+	erased = append(erased, "operations")
+
+	return erased, nil
+}
+
+func (accountAPI *accountAPIServer) RequestErasure(
+	ctx context.Context, req *account.RequestErasureRequest,
+) (*account.PersonalDataOperation, error) {
+	// Validation
+	if req == nil {
+		return nil, errs.NilObject("request erasure request")
+	}
+
+	if err := denyImpersonation(ctx); err != nil {
+		return nil, err
+	}
+
+	op, err := accountAPI.startPersonalDataOperation(ctx, req.AccountId, "Erasing personal data of account "+req.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	accountAPI.runPersonalDataOperation(op, func(ctx context.Context) (map[string]interface{}, error) {
+		erased, err := accountAPI.erasePersonalData(ctx, req.AccountId, op.id)
+		if err != nil {
+			return nil, err
+		}
+
+		err = accountAPI.revokeAllSessions(ctx, req.AccountId, "")
+		if err != nil {
+			return nil, err
+		}
+
+		// Messaging resolves contacts through the account so the notification goes out before it is anonymised
+		accountAPI.notifyPersonalData(
+			ctx, op.db, "Your personal data has been erased",
+			"Your personal data has been erased and your account can no longer be used to sign in.", "", false,
+		)
+
+		err = accountAPI.SQLDBWrites.Transaction(func(tx *gorm.DB) error {
+			err := eraseAccount(tx, op.db.AccountID)
+			if err != nil {
+				return err
+			}
+			return accountAPI.addAccountEvent(tx, account.AccountEventType_ACCOUNT_UPDATED, op.db.AccountID, "")
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		accountAPI.indexAccount(op.db.AccountID)
+
+		return map[string]interface{}{
+			"erased": append([]string{"profile", "identities", "sessions"}, erased...),
+		}, nil
+	})
+
+	return &account.PersonalDataOperation{
+		OperationId: op.id,
+		AccountId:   req.AccountId,
+	}, nil
+}
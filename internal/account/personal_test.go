@@ -0,0 +1,197 @@
+package account
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io/ioutil"
+	"time"
+
+	"github.com/gidyon/services/pkg/api/account"
+	"github.com/gidyon/services/pkg/api/longrunning"
+	"github.com/gidyon/services/pkg/mocks"
+	lrmocks "github.com/gidyon/services/pkg/mocks/mocks"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Exporting and erasing personal data @personal", func() {
+	var (
+		ctx        context.Context
+		accountID  string
+		exportID   = uuid.New().String()
+		erasureID  = uuid.New().String()
+		email      string
+		operations *lrmocks.LongrunningClientMock
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("should create an account", func() {
+		pb := fakeAccount()
+		createRes, err := AccountAPI.CreateAccount(ctx, &account.CreateAccountRequest{
+			Account:   pb,
+			ProjectId: projectID,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		accountID = createRes.AccountId
+		email = pb.Email
+	})
+
+	It("should fail when long running operations are not available", func() {
+		_, err := AccountAPI.RequestDataExport(ctx, &account.RequestDataExportRequest{AccountId: accountID})
+		Expect(status.Code(err)).Should(Equal(codes.Unavailable))
+	})
+
+	It("should use long running operations, subscriber and settings clients", func() {
+		operations = &lrmocks.LongrunningClientMock{}
+		operations.On("CreateOperation", mock.Anything, mock.Anything, mock.Anything).
+			Return(&longrunning.Operation{Id: exportID}, nil).Once()
+		operations.On("CreateOperation", mock.Anything, mock.Anything, mock.Anything).
+			Return(&longrunning.Operation{Id: erasureID}, nil)
+		operations.On("UpdateOperation", mock.Anything, mock.Anything, mock.Anything).
+			Return(&longrunning.Operation{}, nil)
+		operations.On("ListOperations", mock.Anything, mock.Anything, mock.Anything).
+			Return(&longrunning.ListOperationsResponse{
+				Operations: []*longrunning.Operation{{Id: exportID}, {Id: erasureID}},
+			}, nil)
+		operations.On("DeleteOperation", mock.Anything, mock.Anything, mock.Anything).
+			Return(&empty.Empty{}, nil)
+		AccountAPIServer.OperationsClient = operations
+		AccountAPIServer.SubscriberClient = mocks.SubscriberAPI
+		AccountAPIServer.SettingsClient = mocks.SettingsAPI
+	})
+
+	It("should fail when account id is missing", func() {
+		_, err := AccountAPI.RequestDataExport(ctx, &account.RequestDataExportRequest{})
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		_, err = AccountAPI.RequestErasure(ctx, &account.RequestErasureRequest{})
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("should fail when the account does not exist", func() {
+		_, err := AccountAPI.RequestDataExport(ctx, &account.RequestDataExportRequest{AccountId: "0"})
+		Expect(status.Code(err)).Should(Equal(codes.NotFound))
+	})
+
+	It("should leave expired sessions out of the personal data", func() {
+		expiredID := uuid.New().String()
+		err := AccountAPIServer.RedisDBWrites.SAdd(ctx, accountSessionsKey(accountID), expiredID).Err()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		sessions, err := AccountAPIServer.personalSessions(ctx, accountID)
+		Expect(err).ShouldNot(HaveOccurred())
+		for _, sess := range sessions {
+			Expect(sess.(*account.Session).SessionId).ShouldNot(Equal(expiredID))
+		}
+	})
+
+	It("should refuse an export while another export of the account is pending", func() {
+		err := AccountAPIServer.RedisDBWrites.Set(ctx, dataExportPendingKey(accountID), 1, time.Minute).Err()
+		Expect(err).ShouldNot(HaveOccurred())
+		defer AccountAPIServer.RedisDBWrites.Del(ctx, dataExportPendingKey(accountID))
+
+		_, err = AccountAPI.RequestDataExport(ctx, &account.RequestDataExportRequest{AccountId: accountID})
+		Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+	})
+
+	It("should export personal data to an archive", func() {
+		exportRes, err := AccountAPI.RequestDataExport(ctx, &account.RequestDataExportRequest{AccountId: accountID})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(exportRes.OperationId).Should(Equal(exportID))
+
+		var archive []byte
+		Eventually(func() error {
+			body, err := AccountAPI.GetDataExport(ctx, &account.GetDataExportRequest{OperationId: exportID})
+			if err == nil {
+				archive = body.Data
+			}
+			return err
+		}, "5s").ShouldNot(HaveOccurred())
+
+		// Another export can be requested once it is built
+		Eventually(func() int64 {
+			return AccountAPIServer.RedisDBWrites.Exists(ctx, dataExportPendingKey(accountID)).Val()
+		}, "5s").Should(BeZero())
+
+		zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		files := map[string]string{}
+		for _, f := range zr.File {
+			rc, err := f.Open()
+			Expect(err).ShouldNot(HaveOccurred())
+			bs, err := ioutil.ReadAll(rc)
+			Expect(err).ShouldNot(HaveOccurred())
+			files[f.Name] = string(bs)
+		}
+		Expect(files).Should(HaveKey("identities.json"))
+		Expect(files).Should(HaveKey("sessions.json"))
+		Expect(files).Should(HaveKey("messages.json"))
+		Expect(files).Should(HaveKey("subscriptions.json"))
+		Expect(files).Should(HaveKey("settings.json"))
+		Expect(files).Should(HaveKey("operations.json"))
+		Expect(files["profile.json"]).Should(ContainSubstring(email))
+		Expect(files["settings.json"]).Should(ContainSubstring("language"))
+
+		mocks.MessagingAPI.AssertCalled(GinkgoT(), "SendMessage", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	It("should fail to get an export that does not exist", func() {
+		_, err := AccountAPI.GetDataExport(ctx, &account.GetDataExportRequest{OperationId: uuid.New().String()})
+		Expect(status.Code(err)).Should(Equal(codes.NotFound))
+	})
+
+	It("should erase personal data in every service", func() {
+		err := AccountAPIServer.SQLDBWrites.Model(&Account{}).Where("account_id=?", accountID).
+			Update("attributes", []byte(`{"employee_number":"E-1024"}`)).Error
+		Expect(err).ShouldNot(HaveOccurred())
+
+		erasureRes, err := AccountAPI.RequestErasure(ctx, &account.RequestErasureRequest{AccountId: accountID})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(erasureRes.OperationId).Should(Equal(erasureID))
+
+		Eventually(func() string {
+			db := &Account{}
+			err := AccountAPIServer.SQLDBWrites.Unscoped().First(db, "account_id=?", accountID).Error
+			Expect(err).ShouldNot(HaveOccurred())
+			return db.Names
+		}, "5s").Should(Equal(erasedNames))
+
+		db := &Account{}
+		Expect(AccountAPIServer.SQLDBWrites.Unscoped().First(db, "account_id=?", accountID).Error).ShouldNot(HaveOccurred())
+		Expect(db.Email).Should(BeEmpty())
+		Expect(db.Phone).Should(BeEmpty())
+		Expect(db.Password).Should(BeEmpty())
+		Expect(db.Attributes).Should(BeEmpty())
+		Expect(db.DeletedAt.Valid).Should(BeFalse())
+		Expect(db.AccountState).Should(Equal(account.AccountState_BLOCKED.String()))
+
+		mocks.MessagingAPI.AssertCalled(GinkgoT(), "EraseMessages", mock.Anything, mock.Anything, mock.Anything)
+		mocks.SettingsAPI.AssertCalled(GinkgoT(), "EraseSettings", mock.Anything, mock.Anything, mock.Anything)
+		operations.AssertCalled(GinkgoT(), "DeleteOperation", mock.Anything, &longrunning.DeleteOperationRequest{
+			UserId:      accountID,
+			OperationId: exportID,
+		}, mock.Anything)
+		operations.AssertNotCalled(GinkgoT(), "DeleteOperation", mock.Anything, &longrunning.DeleteOperationRequest{
+			UserId:      accountID,
+			OperationId: erasureID,
+		}, mock.Anything)
+	})
+
+	It("should remove the export archive of the erased account", func() {
+		_, err := AccountAPI.GetDataExport(ctx, &account.GetDataExportRequest{OperationId: exportID})
+		Expect(status.Code(err)).Should(Equal(codes.NotFound))
+	})
+
+	It("should remove the clients", func() {
+		AccountAPIServer.OperationsClient = nil
+		AccountAPIServer.SubscriberClient = nil
+		AccountAPIServer.SettingsClient = nil
+	})
+})
@@ -0,0 +1,158 @@
+package account
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/internal/pkg/e164"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+const phoneMigrationBatchSize = 1000
+
+// normalises phone to E.164 using the default region of the project
+func (accountAPI *accountAPIServer) normalisePhone(projectID, phone string) (string, error) {
+	normalised, err := accountAPI.PhoneRegions.Normalise(projectID, phone)
+	if err != nil {
+		return "", errs.WrapMessagef(codes.InvalidArgument, "%q is not a valid phone number", phone)
+	}
+	return normalised, nil
+}
+
+// returns the E.164 form of a username that is a phone number, otherwise the username as is
+func (accountAPI *accountAPIServer) usernamePhone(projectID, username string) string {
+	phone, err := accountAPI.PhoneRegions.Normalise(projectID, username)
+	if err != nil {
+		return username
+	}
+	return phone
+}
+
+// normalises phones of list filters using the region of the filtered project
+func (accountAPI *accountAPIServer) filterPhones(projectIDs, phones []string) []string {
+	projectID := e164.DefaultProject
+	if len(projectIDs) == 1 {
+		projectID = projectIDs[0]
+	}
+	out := make([]string, 0, len(phones))
+	for _, phone := range phones {
+		out = append(out, accountAPI.usernamePhone(projectID, phone))
+	}
+	return out
+}
+
+// PhoneCollision is a set of accounts of a project whose phones normalise to the same number
+type PhoneCollision struct {
+	ProjectID  string
+	Phone      string
+	AccountIDs []uint
+	Phones     []string
+}
+
+// InvalidPhone is an account whose phone could not be normalised
+type InvalidPhone struct {
+	AccountID uint
+	ProjectID string
+	Phone     string
+}
+
+// PhoneMigrationReport describes the result of normalising stored phones
+type PhoneMigrationReport struct {
+	Scanned    int
+	Normalised int
+	Unchanged  int
+	Invalid    []*InvalidPhone
+	Collisions []*PhoneCollision
+}
+
+type phoneRow struct {
+	AccountID uint
+	ProjectID string
+	Phone     string
+}
+
+// NormalisePhones rewrites stored phones to E.164. Accounts whose phones collide after normalisation
+// and phones that are not valid are reported and left unchanged. Nothing is written when dryRun is set.
+func NormalisePhones(ctx context.Context, db *gorm.DB, regions e164.Regions, dryRun bool) (*PhoneMigrationReport, error) {
+	report := &PhoneMigrationReport{
+		Invalid:    make([]*InvalidPhone, 0),
+		Collisions: make([]*PhoneCollision, 0),
+	}
+
+	// Accounts grouped by project and normalised phone
+	type phoneKey struct{ projectID, phone string }
+	groups := make(map[phoneKey][]*phoneRow)
+	keys := make([]phoneKey, 0)
+
+	var lastID uint
+	for {
+		rows := make([]*phoneRow, 0, phoneMigrationBatchSize)
+		err := db.WithContext(ctx).Unscoped().Model(&Account{}).Select("account_id,project_id,phone").
+			Where("account_id>?", lastID).Order("account_id").Limit(phoneMigrationBatchSize).
+			Find(&rows).Error
+		if err != nil {
+			return nil, errs.FailedToFind("accounts", err)
+		}
+
+		for _, row := range rows {
+			lastID = row.AccountID
+			// Accounts without a phone store their id instead
+			if row.Phone == "" || row.Phone == fmt.Sprint(row.AccountID) {
+				continue
+			}
+			report.Scanned++
+
+			phone, err := regions.Normalise(row.ProjectID, row.Phone)
+			if err != nil {
+				report.Invalid = append(report.Invalid, &InvalidPhone{
+					AccountID: row.AccountID,
+					ProjectID: row.ProjectID,
+					Phone:     row.Phone,
+				})
+				continue
+			}
+
+			key := phoneKey{row.ProjectID, phone}
+			if _, ok := groups[key]; !ok {
+				keys = append(keys, key)
+			}
+			groups[key] = append(groups[key], row)
+		}
+
+		if len(rows) < phoneMigrationBatchSize {
+			break
+		}
+	}
+
+	for _, key := range keys {
+		rows := groups[key]
+		if len(rows) > 1 {
+			collision := &PhoneCollision{ProjectID: key.projectID, Phone: key.phone}
+			for _, row := range rows {
+				collision.AccountIDs = append(collision.AccountIDs, row.AccountID)
+				collision.Phones = append(collision.Phones, row.Phone)
+			}
+			report.Collisions = append(report.Collisions, collision)
+			continue
+		}
+
+		row := rows[0]
+		if row.Phone == key.phone {
+			report.Unchanged++
+			continue
+		}
+
+		if !dryRun {
+			err := db.WithContext(ctx).Unscoped().Model(&Account{}).
+				Where("account_id=?", row.AccountID).Update("phone", key.phone).Error
+			if err != nil {
+				return nil, errs.FailedToUpdate("account phone", err)
+			}
+		}
+		report.Normalised++
+	}
+
+	return report, nil
+}
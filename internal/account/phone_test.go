@@ -0,0 +1,156 @@
+package account
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/services/internal/pkg/e164"
+	"github.com/gidyon/services/pkg/api/account"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Normalising phone numbers @phone", func() {
+	var (
+		ctx       context.Context
+		accountID string
+		national  string
+		e164Phone string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("should fail to create an account with an invalid phone", func() {
+		pb := fakeAccount()
+		pb.Phone = "07123"
+		_, err := AccountAPI.CreateAccount(ctx, &account.CreateAccountRequest{
+			Account:   pb,
+			ProjectId: projectID,
+		})
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("should store the phone of a new account in E.164", func() {
+		digits := randomdata.Number(10000000, 99999999)
+		national = fmt.Sprintf("07%08d", digits)
+		e164Phone = fmt.Sprintf("+2547%08d", digits)
+
+		pb := fakeAccount()
+		pb.Phone = national
+		createRes, err := AccountAPI.CreateAccount(ctx, &account.CreateAccountRequest{
+			Account:   pb,
+			ProjectId: projectID,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		accountID = createRes.AccountId
+
+		db := &Account{}
+		Expect(AccountAPIServer.SQLDBWrites.First(db, "account_id=?", accountID).Error).ShouldNot(HaveOccurred())
+		Expect(db.Phone).Should(Equal(e164Phone))
+	})
+
+	It("should find the account with any format of the phone", func() {
+		for _, phone := range []string{national, e164Phone, e164Phone[1:], national[1:]} {
+			existRes, err := AccountAPI.ExistAccount(ctx, &account.ExistAccountRequest{
+				Phone:     phone,
+				ProjectId: projectID,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(existRes.Exists).Should(BeTrue())
+			Expect(existRes.AccountId).Should(Equal(accountID))
+			Expect(existRes.ExistingFields).Should(ContainElement("phone"))
+		}
+	})
+
+	It("should fail to create another account with the same phone in another format", func() {
+		pb := fakeAccount()
+		pb.Phone = e164Phone[1:]
+		_, err := AccountAPI.CreateAccount(ctx, &account.CreateAccountRequest{
+			Account:   pb,
+			ProjectId: projectID,
+		})
+		Expect(status.Code(err)).Should(Equal(codes.AlreadyExists))
+	})
+
+	It("should filter accounts by phone in any format", func() {
+		listRes, err := AccountAPI.ListAccounts(ctx, &account.ListAccountsRequest{
+			ListCriteria: &account.Criteria{
+				Filter:     true,
+				ProjectIds: []string{projectID},
+				Phones:     []string{national},
+			},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(listRes.Accounts).Should(HaveLen(1))
+		Expect(listRes.Accounts[0].AccountId).Should(Equal(accountID))
+	})
+
+	Describe("Migrating stored phones", func() {
+		var (
+			migrateProject = randomdata.RandStringRunes(10)
+			collidingIDs   []uint
+			rewrittenID    uint
+			invalidID      uint
+			createRaw      = func(phone string) uint {
+				pb := fakeAccount()
+				pb.ProjectId = migrateProject
+				db, err := AccountModel(pb)
+				Expect(err).ShouldNot(HaveOccurred())
+				db.Phone = phone
+				Expect(AccountAPIServer.SQLDBWrites.Create(db).Error).ShouldNot(HaveOccurred())
+				return db.AccountID
+			}
+		)
+
+		It("should create accounts with raw phones", func() {
+			digits := randomdata.Number(10000000, 99999999)
+			collidingIDs = []uint{
+				createRaw(fmt.Sprintf("07%08d", digits)),
+				createRaw(fmt.Sprintf("2547%08d", digits)),
+			}
+			rewrittenID = createRaw(fmt.Sprintf("07%08d", randomdata.Number(10000000, 99999999)))
+			invalidID = createRaw("12345")
+		})
+
+		It("should report changes without writing them in a dry run", func() {
+			report, err := NormalisePhones(ctx, AccountAPIServer.SQLDBWrites, e164.Regions{}, true)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(report.Normalised).Should(BeNumerically(">=", 1))
+
+			db := &Account{}
+			Expect(AccountAPIServer.SQLDBWrites.First(db, "account_id=?", rewrittenID).Error).ShouldNot(HaveOccurred())
+			Expect(db.Phone).Should(HavePrefix("07"))
+		})
+
+		It("should rewrite phones and report collisions and invalid phones", func() {
+			report, err := NormalisePhones(ctx, AccountAPIServer.SQLDBWrites, e164.Regions{}, false)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			var collision *PhoneCollision
+			for _, c := range report.Collisions {
+				if c.ProjectID == migrateProject {
+					collision = c
+				}
+			}
+			Expect(collision).ShouldNot(BeNil())
+			Expect(collision.AccountIDs).Should(ConsistOf(collidingIDs))
+
+			invalidIDs := make([]uint, 0)
+			for _, invalid := range report.Invalid {
+				invalidIDs = append(invalidIDs, invalid.AccountID)
+			}
+			Expect(invalidIDs).Should(ContainElement(invalidID))
+
+			db := &Account{}
+			Expect(AccountAPIServer.SQLDBWrites.First(db, "account_id=?", rewrittenID).Error).ShouldNot(HaveOccurred())
+			Expect(db.Phone).Should(HavePrefix("+2547"))
+
+			// Colliding accounts are left for manual resolution
+			Expect(AccountAPIServer.SQLDBWrites.First(db, "account_id=?", collidingIDs[0]).Error).ShouldNot(HaveOccurred())
+			Expect(db.Phone).Should(HavePrefix("07"))
+		})
+	})
+})
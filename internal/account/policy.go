@@ -0,0 +1,233 @@
+package account
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/internal/pkg/password"
+	"github.com/gidyon/services/pkg/api/account"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const passwordHistoryTable = "account_password_history"
+
+// PasswordHistory contains previous password hashes of an account
+type PasswordHistory struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	AccountID uint      `gorm:"index;not null"`
+	Password  string    `gorm:"type:text;not null"`
+	CreatedAt time.Time `gorm:"precision:6;not null"`
+}
+
+// TableName is the name of the table
+func (*PasswordHistory) TableName() string {
+	return passwordHistoryTable
+}
+
+// returns the password policy of a project
+func (accountAPI *accountAPIServer) passwordPolicy(projectID string) *password.Policy {
+	if policy, ok := accountAPI.PasswordPolicies[projectID]; ok {
+		return policy
+	}
+	return accountAPI.PasswordPolicies[password.DefaultPolicy]
+}
+
+func passwordPolicyError(violations []password.Violation) error {
+	failure := &errdetails.PreconditionFailure{}
+	msg := "password does not meet policy"
+	for i, v := range violations {
+		failure.Violations = append(failure.Violations, &errdetails.PreconditionFailure_Violation{
+			Type:        v.Rule,
+			Subject:     "password",
+			Description: v.Description,
+		})
+		if i == 0 {
+			msg += ": " + v.Description
+		} else {
+			msg += "; " + v.Description
+		}
+	}
+
+	st, err := status.New(codes.InvalidArgument, msg).WithDetails(failure)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	return st.Err()
+}
+
+// checks a new password against the project policy and, for existing accounts, the password history
+func (accountAPI *accountAPIServer) checkPasswordPolicy(projectID string, accountID uint, newPassword string) error {
+	policy := accountAPI.passwordPolicy(projectID)
+	if policy == nil {
+		return nil
+	}
+
+	violations := policy.Check(newPassword)
+
+	if accountID != 0 && policy.HistorySize > 0 {
+		history := make([]*PasswordHistory, 0, policy.HistorySize)
+		err := accountAPI.SQLDBWrites.Where("account_id=?", accountID).
+			Order("id DESC").Limit(policy.HistorySize).Find(&history).Error
+		if err != nil {
+			return errs.FailedToFind("password history", err)
+		}
+
+		for _, previous := range history {
+			if accountAPI.compareHash(previous.Password, newPassword) == nil {
+				violations = append(violations, password.Violation{
+					Rule:        password.RuleHistory,
+					Description: fmt.Sprintf("password must not be one of the last %d passwords", policy.HistorySize),
+				})
+				break
+			}
+		}
+	}
+
+	if len(violations) != 0 {
+		return passwordPolicyError(violations)
+	}
+
+	return nil
+}
+
+// records a password change, keeping only as many previous passwords as the policy requires
+func (accountAPI *accountAPIServer) passwordChanged(tx *gorm.DB, projectID string, accountID uint, hash string) error {
+	err := tx.Model(&Account{}).Where("account_id=?", accountID).Update("password_changed_at", time.Now()).Error
+	if err != nil {
+		return errs.FailedToUpdate("account", err)
+	}
+
+	policy := accountAPI.passwordPolicy(projectID)
+	if policy == nil || policy.HistorySize <= 0 {
+		return nil
+	}
+
+	err = tx.Create(&PasswordHistory{AccountID: accountID, Password: hash}).Error
+	if err != nil {
+		return errs.FailedToSave("password history", err)
+	}
+
+	keep := make([]uint, 0, policy.HistorySize)
+	err = tx.Model(&PasswordHistory{}).Where("account_id=?", accountID).
+		Order("id DESC").Limit(policy.HistorySize).Pluck("id", &keep).Error
+	if err != nil {
+		return errs.FailedToFind("password history", err)
+	}
+
+	err = tx.Where("account_id=? AND id NOT IN (?)", accountID, keep).Delete(&PasswordHistory{}).Error
+	if err != nil {
+		return errs.FailedToDelete("password history", err)
+	}
+
+	return nil
+}
+
+// reports whether the account password is older than its project allows
+func (accountAPI *accountAPIServer) passwordExpired(db *Account) bool {
+	changedAt := db.CreatedAt
+	if db.PasswordChangedAt != nil {
+		changedAt = *db.PasswordChangedAt
+	}
+	return accountAPI.passwordPolicy(db.ProjectID).Expired(changedAt)
+}
+
+// issues credentials that can only be used to change an expired password
+func (accountAPI *accountAPIServer) passwordExpiredResponse(
+	ctx context.Context, db *Account,
+) (*account.SignInResponse, error) {
+	accountID := fmt.Sprint(db.AccountID)
+	changeToken := uuid.New().String()
+
+	err := accountAPI.RedisDBWrites.Set(ctx, updateToken(accountID), changeToken, 10*time.Minute).Err()
+	if err != nil {
+		return nil, errs.RedisCmdFailed(err, "SET")
+	}
+
+	jwt, err := accountAPI.genPasswordChangeToken(db, time.Now().Add(10*time.Minute))
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate token")
+	}
+
+	return &account.SignInResponse{
+		AccountId:       accountID,
+		State:           account.AccountState(account.AccountState_value[db.AccountState]),
+		PasswordExpired: true,
+		ChangeToken:     changeToken,
+		ChangeJwt:       jwt,
+	}, nil
+}
+
+const passwordChangeScope = "password_change"
+
+// passwordChangeClaims are the claims of tokens that only allow changing the password of an account
+type passwordChangeClaims struct {
+	Scope        string `json:"scope"`
+	EmailAddress string `json:"email,omitempty"`
+	PhoneNumber  string `json:"phone,omitempty"`
+	ProjectID    string `json:"project_id,omitempty"`
+	jwt.StandardClaims
+}
+
+// key for signing password change tokens. It is derived from the token signing key so that the auth
+// interceptor, authorize and other services never accept the tokens as access tokens.
+func (accountAPI *accountAPIServer) passwordChangeKey() []byte {
+	mac := hmac.New(sha256.New, accountAPI.TokenSigningKey)
+	mac.Write([]byte(passwordChangeScope))
+	return mac.Sum(nil)
+}
+
+func (accountAPI *accountAPIServer) genPasswordChangeToken(db *Account, expires time.Time) (string, error) {
+	if len(accountAPI.TokenSigningKey) == 0 {
+		return "", errors.New("token signing key is not set")
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &passwordChangeClaims{
+		Scope:        passwordChangeScope,
+		EmailAddress: db.Email,
+		PhoneNumber:  db.Phone,
+		ProjectID:    db.ProjectID,
+		StandardClaims: jwt.StandardClaims{
+			Subject:   fmt.Sprint(db.AccountID),
+			ExpiresAt: expires.Unix(),
+			IssuedAt:  time.Now().Unix(),
+		},
+	})
+
+	return token.SignedString(accountAPI.passwordChangeKey())
+}
+
+// returns the payload of a password change token, or false when the token is not one
+func (accountAPI *accountAPIServer) parsePasswordChangeToken(tokenString string) (*auth.Payload, bool) {
+	if len(accountAPI.TokenSigningKey) == 0 {
+		return nil, false
+	}
+
+	claims := &passwordChangeClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if token.Method != jwt.SigningMethodHS256 {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		return accountAPI.passwordChangeKey(), nil
+	})
+	if err != nil || claims.Scope != passwordChangeScope {
+		return nil, false
+	}
+
+	return &auth.Payload{
+		ID:           claims.Subject,
+		EmailAddress: claims.EmailAddress,
+		PhoneNumber:  claims.PhoneNumber,
+		ProjectID:    claims.ProjectID,
+	}, true
+}
@@ -0,0 +1,156 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	micro_mocks "github.com/gidyon/micro/v2/pkg/mocks/mocks"
+	"github.com/gidyon/services/internal/pkg/password"
+	"github.com/gidyon/services/pkg/api/account"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const policyProject = "policy"
+
+var _ = Describe("Enforcing password policy @policy", func() {
+	var (
+		ctx     context.Context
+		adminID string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		if AccountAPIServer.PasswordPolicies == nil {
+			AccountAPIServer.PasswordPolicies = make(map[string]*password.Policy)
+		}
+		AccountAPIServer.PasswordPolicies[policyProject] = &password.Policy{
+			MinLength:    10,
+			RequireUpper: true,
+			RequireDigit: true,
+			HistorySize:  2,
+			MaxAgeDays:   30,
+		}
+	})
+
+	It("should create an active admin", func() {
+		var err error
+		adminID, err = createAdmin(account.AccountState_ACTIVE)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("should reject a weak password with a violation for every rule", func() {
+		createReq := &account.CreateAccountRequest{
+			Account:        fakeAccount(),
+			PrivateAccount: &account.PrivateAccount{Password: "weak"},
+			ProjectId:      policyProject,
+		}
+		createRes, err := AccountAPI.CreateAccount(ctx, createReq)
+		Expect(err).Should(HaveOccurred())
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		Expect(createRes).Should(BeNil())
+
+		rules := make([]string, 0)
+		for _, detail := range status.Convert(err).Details() {
+			failure, ok := detail.(*errdetails.PreconditionFailure)
+			Expect(ok).Should(BeTrue())
+			for _, v := range failure.Violations {
+				rules = append(rules, v.Type)
+			}
+		}
+		Expect(rules).Should(ConsistOf(password.RuleMinLength, password.RuleUppercase, password.RuleDigit))
+	})
+
+	Describe("Changing password of an account", func() {
+		var accountID, email string
+		const first, second, third = "Str0ngPassword", "An0therPassword", "Th1rdPassword"
+
+		It("should create account with a strong password", func() {
+			createReq := &account.CreateAccountRequest{
+				Account: fakeAccount(),
+				PrivateAccount: &account.PrivateAccount{
+					Password:        first,
+					ConfirmPassword: first,
+				},
+				ProjectId: policyProject,
+			}
+			createRes, err := AccountAPI.CreateAccount(ctx, createReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			accountID = createRes.AccountId
+			email = createReq.Account.Email
+		})
+
+		It("should reject reusing a recent password on reset", func() {
+			_, err := AccountAPI.AdminUpdateAccount(ctx, &account.AdminUpdateAccountRequest{
+				AccountId:       accountID,
+				AdminId:         adminID,
+				UpdateOperation: account.UpdateOperation_PASSWORD_RESET,
+				Payload:         []string{first},
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(err.Error()).Should(ContainSubstring("last 2 passwords"))
+		})
+
+		It("should accept a new password on reset", func() {
+			_, err := AccountAPI.AdminUpdateAccount(ctx, &account.AdminUpdateAccountRequest{
+				AccountId:       accountID,
+				AdminId:         adminID,
+				UpdateOperation: account.UpdateOperation_PASSWORD_RESET,
+				Payload:         []string{second},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should require changing an expired password on sign in", func() {
+			err := AccountAPIServer.SQLDBWrites.Model(&Account{}).Where("account_id=?", accountID).
+				Update("password_changed_at", time.Now().Add(-31*24*time.Hour)).Error
+			Expect(err).ShouldNot(HaveOccurred())
+
+			signInRes, err := AccountAPI.SignIn(ctx, &account.SignInRequest{
+				Username:  email,
+				Password:  second,
+				Group:     auth.DefaultUserGroup(),
+				ProjectId: policyProject,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(signInRes.PasswordExpired).Should(BeTrue())
+			Expect(signInRes.ChangeToken).ShouldNot(BeZero())
+			Expect(signInRes.ChangeJwt).ShouldNot(BeZero())
+			Expect(signInRes.Token).Should(BeZero())
+			Expect(signInRes.RefreshToken).Should(BeZero())
+
+			// The change token is not an access token
+			_, err = jwt.Parse(signInRes.ChangeJwt, func(*jwt.Token) (interface{}, error) {
+				return AccountAPIServer.TokenSigningKey, nil
+			})
+			Expect(err).Should(HaveOccurred())
+
+			_, err = AccountAPI.UpdatePrivateAccountExternal(ctx, &account.UpdatePrivateAccountExternalRequest{
+				Jwt:         signInRes.ChangeJwt,
+				Username:    email,
+				ProjectId:   policyProject,
+				ChangeToken: signInRes.ChangeToken,
+				PrivateAccount: &account.PrivateAccount{
+					Password:        third,
+					ConfirmPassword: third,
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should not require an access token to change an expired password", func() {
+			authAPI := &micro_mocks.AuthAPIMock{}
+			authAPI.On("AuthorizeFunc", mock.Anything).Return(nil, status.Error(codes.Unauthenticated, "no token"))
+			accountAPI := &accountAPIServer{Options: &Options{AuthAPI: authAPI}}
+
+			_, err := accountAPI.AuthFuncOverride(ctx, "/gidyon.apis.AccountAPI/UpdatePrivateAccountExternal")
+			Expect(err).ShouldNot(HaveOccurred())
+			authAPI.AssertNotCalled(GinkgoT(), "AuthorizeFunc", mock.Anything)
+		})
+	})
+})
@@ -82,7 +82,7 @@ var _ = Describe("Refreshing JWT @refresh", func() {
 				group = createReq.Account.Group
 			})
 			Context("SignIng In into the account", func() {
-				var signRes *account.SignInResponse
+				var signRes, rotated *account.SignInResponse
 				It("should signIn into the account returning JWT and some data", func() {
 					signInReq := &account.SignInRequest{
 						Username:  email,
@@ -109,6 +109,25 @@ var _ = Describe("Refreshing JWT @refresh", func() {
 						Expect(err).ShouldNot(HaveOccurred())
 						Expect(status.Code(err)).Should(Equal(codes.OK))
 						Expect(refreshRes).ShouldNot(BeNil())
+						Expect(refreshRes.RefreshToken).ShouldNot(Equal(signRes.RefreshToken))
+						Expect(refreshRes.SessionId).Should(Equal(signRes.SessionId))
+						rotated = refreshRes
+					})
+					It("should revoke the session when a rotated token is reused", func() {
+						refreshReq.AccountGroup = group
+						refreshReq.AccountId = accountID
+						refreshReq.RefreshToken = signRes.RefreshToken
+						refreshRes, err := AccountAPI.RefreshSession(ctx, refreshReq)
+						Expect(err).Should(HaveOccurred())
+						Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+						Expect(refreshRes).Should(BeNil())
+
+						// The latest token of the family is no longer valid
+						refreshReq.RefreshToken = rotated.RefreshToken
+						refreshRes, err = AccountAPI.RefreshSession(ctx, refreshReq)
+						Expect(err).Should(HaveOccurred())
+						Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+						Expect(refreshRes).Should(BeNil())
 					})
 				})
 			})
@@ -0,0 +1,318 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/micro/v2/utils/mdutil"
+	"github.com/gidyon/services/internal/pkg/retention"
+	"github.com/gidyon/services/pkg/api/account"
+	"github.com/gidyon/services/pkg/api/messaging"
+	"github.com/gidyon/services/pkg/api/subscriber"
+	redis "github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"gorm.io/gorm"
+)
+
+const (
+	purgeRunsTable   = "account_purge_runs"
+	retentionLockKey = "accounts:retention:lock"
+	// A run is cancelled before its lock expires so that two replicas never purge at the same time
+	retentionLockTTL = 30 * time.Minute
+	retentionRunTTL  = retentionLockTTL - time.Minute
+)
+
+// RetentionOptions configures purging of soft deleted accounts
+type RetentionOptions struct {
+	// How often the purge job runs; zero disables the job
+	Interval time.Duration
+	// Policies of projects; the default policy applies to other projects
+	Policies retention.Policies
+	// Maximum number of accounts purged in one run
+	BatchSize int
+}
+
+// DefaultRetentionOptions are used for options that are not provided
+var DefaultRetentionOptions = RetentionOptions{
+	BatchSize: 500,
+}
+
+func (opt *RetentionOptions) withDefaults() *RetentionOptions {
+	out := DefaultRetentionOptions
+	if opt == nil {
+		return &out
+	}
+	out.Interval = opt.Interval
+	out.Policies = opt.Policies
+	if opt.BatchSize > 0 {
+		out.BatchSize = opt.BatchSize
+	}
+	return &out
+}
+
+// PurgeRun records one run of the retention job
+type PurgeRun struct {
+	ID         uint       `gorm:"primaryKey;autoIncrement"`
+	Replica    string     `gorm:"type:varchar(100);not null"`
+	StartedAt  time.Time  `gorm:"index;precision:6;not null"`
+	FinishedAt *time.Time `gorm:"precision:6"`
+	Expired    int        `gorm:"not null;default:0"`
+	Deleted    int        `gorm:"not null;default:0"`
+	Anonymised int        `gorm:"not null;default:0"`
+	Failed     int        `gorm:"not null;default:0"`
+	Error      string     `gorm:"type:text"`
+}
+
+// TableName is the name of the table
+func (*PurgeRun) TableName() string {
+	return purgeRunsTable
+}
+
+// Deletes the lock only if it is still held by the token that acquired it
+var releaseLockScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0
+`)
+
+func (accountAPI *accountAPIServer) acquireRetentionLock(ctx context.Context) (string, bool, error) {
+	token := uuid.New().String()
+	ok, err := accountAPI.RedisDBWrites.SetNX(ctx, retentionLockKey, token, retentionLockTTL).Result()
+	if err != nil {
+		return "", false, errs.RedisCmdFailed(err, "setnx")
+	}
+	return token, ok, nil
+}
+
+func (accountAPI *accountAPIServer) releaseRetentionLock(ctx context.Context, token string) {
+	err := releaseLockScript.Run(ctx, accountAPI.RedisDBWrites, []string{retentionLockKey}, token).Err()
+	if err != nil {
+		accountAPI.Logger.Errorf("failed to release retention lock: %v", err)
+	}
+}
+
+// runs the retention job on every interval until ctx is done
+func (accountAPI *accountAPIServer) startRetentionWorker(ctx context.Context) {
+	ticker := time.NewTicker(accountAPI.retention.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			run, err := accountAPI.purgeExpiredAccounts(ctx)
+			switch {
+			case err != nil:
+				accountAPI.Logger.Errorf("failed to purge expired accounts: %v", err)
+			case run != nil:
+				accountAPI.Logger.Infof(
+					"purged expired accounts: expired=%d deleted=%d anonymised=%d failed=%d",
+					run.Expired, run.Deleted, run.Anonymised, run.Failed,
+				)
+			}
+		}
+	}
+}
+
+// returns soft deleted accounts whose grace period has expired
+func (accountAPI *accountAPIServer) expiredAccounts(now time.Time) ([]*Account, error) {
+	policies := accountAPI.retention.Policies
+	projects := policies.Projects()
+	limit := accountAPI.retention.BatchSize
+
+	expired := func(projectClause string, projectArg interface{}, policy *retention.Policy) ([]*Account, error) {
+		db := accountAPI.SQLDBWrites.Unscoped().
+			Where("deleted_at IS NOT NULL AND deleted_at<? AND purged_at IS NULL", policy.Cutoff(now)).
+			Order("deleted_at").Limit(limit)
+		if projectClause != "" {
+			db = db.Where(projectClause, projectArg)
+		}
+		dbs := make([]*Account, 0)
+		err := db.Find(&dbs).Error
+		if err != nil {
+			return nil, errs.FailedToFind("expired accounts", err)
+		}
+		return dbs, nil
+	}
+
+	dbs := make([]*Account, 0)
+	for _, project := range projects {
+		projectDBs, err := expired("project_id=?", project, policies.Policy(project))
+		if err != nil {
+			return nil, err
+		}
+		dbs = append(dbs, projectDBs...)
+		limit -= len(projectDBs)
+		if limit <= 0 {
+			return dbs, nil
+		}
+	}
+
+	var (
+		defaultDBs []*Account
+		err        error
+	)
+	if len(projects) == 0 {
+		defaultDBs, err = expired("", nil, policies.Policy(retention.DefaultPolicy))
+	} else {
+		defaultDBs, err = expired("project_id NOT IN (?)", projects, policies.Policy(retention.DefaultPolicy))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return append(dbs, defaultDBs...), nil
+}
+
+// purges an account and the data other services hold about it
+func (accountAPI *accountAPIServer) purgeAccount(ctx context.Context, db *Account, action string) error {
+	accountID := fmt.Sprint(db.AccountID)
+
+	// Saved messages
+	var err error
+	if action == retention.ActionAnonymise {
+		_, err = accountAPI.MessagingClient.EraseMessages(ctx, &messaging.MessageRequest{UserId: accountID}, grpc.WaitForReady(true))
+	} else {
+		_, err = accountAPI.MessagingClient.DeleteMessages(ctx, &messaging.MessageRequest{UserId: accountID}, grpc.WaitForReady(true))
+	}
+	if err != nil {
+		return errs.WrapErrorWithMsg(err, "failed to purge messages")
+	}
+
+	// Subscriber rows
+	if accountAPI.SubscriberClient != nil {
+		_, err = accountAPI.SubscriberClient.DeleteSubscriber(ctx, &subscriber.DeleteSubscriberRequest{
+			SubscriberId: accountID,
+		}, grpc.WaitForReady(true))
+		if err != nil {
+			return errs.WrapErrorWithMsg(err, "failed to purge subscriber")
+		}
+	}
+
+	err = accountAPI.revokeAllSessions(ctx, accountID, "")
+	if err != nil {
+		return err
+	}
+
+	err = accountAPI.SQLDBWrites.Transaction(func(tx *gorm.DB) error {
+		if action == retention.ActionAnonymise {
+			err := eraseAccount(tx, db.AccountID)
+			if err != nil {
+				return err
+			}
+			err = tx.Unscoped().Model(&Account{}).Where("account_id=?", db.AccountID).Update("purged_at", time.Now()).Error
+			if err != nil {
+				return errs.FailedToUpdate("account", err)
+			}
+			return accountAPI.addAccountEvent(tx, account.AccountEventType_ACCOUNT_UPDATED, db.AccountID, "")
+		}
+
+		// The event is added while the account can still be read
+		err := accountAPI.addAccountEvent(tx, account.AccountEventType_ACCOUNT_DELETED, db.AccountID, "")
+		if err != nil {
+			return err
+		}
+		err = deleteAccountCredentials(tx, db.AccountID)
+		if err != nil {
+			return err
+		}
+		err = tx.Delete(&SignInEvent{}, "account_id=?", db.AccountID).Error
+		if err != nil {
+			return errs.FailedToDelete("sign in events", err)
+		}
+		err = tx.Unscoped().Delete(&Account{}, "account_id=?", db.AccountID).Error
+		if err != nil {
+			return errs.FailedToDelete("account", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if action == retention.ActionAnonymise {
+		accountAPI.indexAccount(db.AccountID)
+	} else {
+		accountAPI.unindexAccount(db.AccountID)
+	}
+
+	return nil
+}
+
+// purges expired accounts once if no other replica is purging. The run is nil when another replica holds the lock.
+func (accountAPI *accountAPIServer) purgeExpiredAccounts(ctx context.Context) (*PurgeRun, error) {
+	ctx, cancel := context.WithTimeout(ctx, retentionRunTTL)
+	defer cancel()
+
+	token, ok, err := accountAPI.acquireRetentionLock(ctx)
+	if err != nil || !ok {
+		return nil, err
+	}
+	defer accountAPI.releaseRetentionLock(context.Background(), token)
+
+	replica, _ := os.Hostname()
+
+	run := &PurgeRun{
+		Replica:   firstVal(replica, "unknown"),
+		StartedAt: time.Now(),
+	}
+	err = accountAPI.SQLDBWrites.Create(run).Error
+	if err != nil {
+		return nil, errs.FailedToSave("purge run", err)
+	}
+
+	// Other services authorize the job as an administrator
+	jwt, err := accountAPI.AuthAPI.GenToken(ctx, &auth.Payload{
+		ID:    "account-retention",
+		Names: "Account retention",
+		Group: auth.DefaultSuperAdminGroup(),
+	}, time.Now().Add(retentionRunTTL))
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate token")
+	}
+	ctx = mdutil.AddMD(ctx, metadata.Pairs(auth.Header(), fmt.Sprintf("%s %s", auth.Scheme(), jwt)))
+
+	failures := make([]string, 0)
+
+	dbs, err := accountAPI.expiredAccounts(run.StartedAt)
+	if err != nil {
+		failures = append(failures, err.Error())
+	}
+	run.Expired = len(dbs)
+
+	for _, db := range dbs {
+		action := accountAPI.retention.Policies.Policy(db.ProjectID).Action
+
+		err = accountAPI.purgeAccount(ctx, db, action)
+		switch {
+		case err != nil:
+			run.Failed++
+			failures = append(failures, fmt.Sprintf("account %d: %v", db.AccountID, err))
+		case action == retention.ActionAnonymise:
+			run.Anonymised++
+		default:
+			run.Deleted++
+		}
+	}
+
+	finishedAt := time.Now()
+	run.FinishedAt = &finishedAt
+	run.Error = strings.Join(failures, "\n")
+
+	err = accountAPI.SQLDBWrites.Save(run).Error
+	if err != nil {
+		return nil, errs.FailedToUpdate("purge run", err)
+	}
+
+	return run, nil
+}
@@ -0,0 +1,123 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gidyon/services/internal/pkg/retention"
+	"github.com/gidyon/services/pkg/api/account"
+	"github.com/gidyon/services/pkg/mocks"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Purging soft deleted accounts @retention", func() {
+	var (
+		ctx           context.Context
+		adminID       string
+		anonymiseID   uint
+		recentID      uint
+		deleteID      uint
+		retentionOpt  *RetentionOptions
+		createDeleted = func(projectID string, deletedAt time.Time) uint {
+			pb := fakeAccount()
+			pb.ProjectId = projectID
+			db, err := AccountModel(pb)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(AccountAPIServer.SQLDBWrites.Create(db).Error).ShouldNot(HaveOccurred())
+			err = AccountAPIServer.SQLDBWrites.Model(db).Update("deleted_at", deletedAt).Error
+			Expect(err).ShouldNot(HaveOccurred())
+			return db.AccountID
+		}
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("should set retention policies", func() {
+		retentionOpt = AccountAPIServer.retention
+		AccountAPIServer.retention = (&RetentionOptions{
+			Policies: retention.Policies{
+				"retention-test":        {GracePeriodDays: 1, Action: retention.ActionAnonymise},
+				retention.DefaultPolicy: {GracePeriodDays: 7, Action: retention.ActionDelete},
+			},
+		}).withDefaults()
+		AccountAPIServer.SubscriberClient = mocks.SubscriberAPI
+
+		var err error
+		adminID, err = createAdmin(account.AccountState_ACTIVE)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("should create deleted accounts", func() {
+		anonymiseID = createDeleted("retention-test", time.Now().Add(-48*time.Hour))
+		recentID = createDeleted("retention-test", time.Now().Add(-time.Hour))
+		deleteID = createDeleted("retention-other", time.Now().Add(-8*24*time.Hour))
+	})
+
+	It("should not purge while another replica holds the lock", func() {
+		Expect(AccountAPIServer.RedisDBWrites.Set(ctx, retentionLockKey, "other", time.Minute).Err()).ShouldNot(HaveOccurred())
+		run, err := AccountAPIServer.purgeExpiredAccounts(ctx)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(run).Should(BeNil())
+		Expect(AccountAPIServer.RedisDBWrites.Del(ctx, retentionLockKey).Err()).ShouldNot(HaveOccurred())
+	})
+
+	It("should purge accounts whose grace period has expired", func() {
+		run, err := AccountAPIServer.purgeExpiredAccounts(ctx)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(run).ShouldNot(BeNil())
+		Expect(run.Failed).Should(BeZero())
+		Expect(run.Anonymised).Should(BeNumerically(">=", 1))
+		Expect(run.Deleted).Should(BeNumerically(">=", 1))
+		Expect(run.FinishedAt).ShouldNot(BeNil())
+
+		saved := &PurgeRun{}
+		Expect(AccountAPIServer.SQLDBWrites.First(saved, "id=?", run.ID).Error).ShouldNot(HaveOccurred())
+		Expect(saved.Expired).Should(Equal(run.Expired))
+
+		// The lock is released after the run
+		exists, err := AccountAPIServer.RedisDBWrites.Exists(ctx, retentionLockKey).Result()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(exists).Should(BeZero())
+	})
+
+	It("should anonymise accounts of projects with an anonymise policy", func() {
+		db := &Account{}
+		err := AccountAPIServer.SQLDBWrites.Unscoped().First(db, "account_id=?", anonymiseID).Error
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(db.PurgedAt).ShouldNot(BeNil())
+		Expect(db.Names).Should(Equal(erasedNames))
+		Expect(db.Email).Should(Equal(fmt.Sprint(anonymiseID)))
+	})
+
+	It("should keep accounts within the grace period", func() {
+		db := &Account{}
+		err := AccountAPIServer.SQLDBWrites.Unscoped().First(db, "account_id=?", recentID).Error
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(db.PurgedAt).Should(BeNil())
+		Expect(db.Names).ShouldNot(Equal(erasedNames))
+	})
+
+	It("should hard delete accounts of the default policy", func() {
+		err := AccountAPIServer.SQLDBWrites.Unscoped().First(&Account{}, "account_id=?", deleteID).Error
+		Expect(err).Should(MatchError(gorm.ErrRecordNotFound))
+	})
+
+	It("should fail to restore a purged account", func() {
+		_, err := AccountAPI.AdminUpdateAccount(ctx, &account.AdminUpdateAccountRequest{
+			AccountId:       fmt.Sprint(anonymiseID),
+			AdminId:         adminID,
+			UpdateOperation: account.UpdateOperation_UNDELETE,
+		})
+		Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+	})
+
+	It("should restore retention options", func() {
+		AccountAPIServer.retention = retentionOpt
+		AccountAPIServer.SubscriberClient = nil
+	})
+})
@@ -0,0 +1,228 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/internal/pkg/search"
+	"github.com/gidyon/services/pkg/api/account"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+const reindexBatchSize = 1000
+
+// returns the searchable content of an account
+func accountDocument(db *Account) *search.Document {
+	return &search.Document{
+		ID:    fmt.Sprint(db.AccountID),
+		Scope: db.ProjectID,
+		Fields: map[string]string{
+			"names":           db.Names,
+			"email":           db.Email,
+			"phone":           db.Phone,
+			"linked_accounts": db.LinkedAccounts,
+		},
+		Fragments: []string{db.Phone, db.Email},
+	}
+}
+
+// updates the search index with the stored account. Failures are logged since the index can be rebuilt.
+func (accountAPI *accountAPIServer) indexAccount(accountID uint) {
+	if accountAPI.SearchIndex == nil || accountID == 0 {
+		return
+	}
+
+	db := &Account{}
+	err := accountAPI.SQLDBWrites.Unscoped().First(db, "account_id=?", accountID).Error
+	if err == nil {
+		err = accountAPI.SearchIndex.Index(accountDocument(db))
+	}
+	if err != nil {
+		accountAPI.Logger.Errorf("failed to index account %d: %v", accountID, err)
+	}
+}
+
+// removes the account from the search index
+func (accountAPI *accountAPIServer) unindexAccount(accountID uint) {
+	if accountAPI.SearchIndex == nil {
+		return
+	}
+
+	err := accountAPI.SearchIndex.Delete(fmt.Sprint(accountID))
+	if err != nil {
+		accountAPI.Logger.Errorf("failed to remove account %d from search index: %v", accountID, err)
+	}
+}
+
+// hits of the index fetched at once when filling a page or counting matches
+const searchBatchSize = 500
+
+// returns account ids of a page of index hits, most relevant first, and the number of hits
+func (accountAPI *accountAPIServer) searchHitIDs(text, projectID string, offset, limit int) ([]uint, uint64, error) {
+	res, err := accountAPI.SearchIndex.Search(&search.Query{
+		Text:   text,
+		Scope:  projectID,
+		Offset: offset,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, 0, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to search accounts")
+	}
+
+	ids := make([]uint, 0, len(res.Hits))
+	for _, hit := range res.Hits {
+		id, err := strconv.ParseUint(hit.ID, 10, 64)
+		if err != nil {
+			// Keeps positions of ids aligned with the ranking
+			id = 0
+		}
+		ids = append(ids, uint(id))
+	}
+
+	return ids, res.Total, nil
+}
+
+// counts index hits that are accounts of db
+func (accountAPI *accountAPIServer) countSearchMatches(db *gorm.DB, text, projectID string) (int64, error) {
+	var count int64
+	for offset := 0; ; offset += searchBatchSize {
+		ids, total, err := accountAPI.searchHitIDs(text, projectID, offset, searchBatchSize)
+		if err != nil {
+			return 0, err
+		}
+		if len(ids) == 0 {
+			return count, nil
+		}
+
+		var matches int64
+		err = db.Session(&gorm.Session{}).Limit(-1).Where("account_id IN (?)", ids).Count(&matches).Error
+		if err != nil {
+			return 0, errs.FailedToFind("accounts", err)
+		}
+		count += matches
+
+		if uint64(offset+len(ids)) >= total {
+			return count, nil
+		}
+	}
+}
+
+// searches the index and returns the accounts of db that match, most relevant first. Criteria and
+// visibility are applied by the database so hits are read until the page is full.
+func (accountAPI *accountAPIServer) searchIndex(
+	db *gorm.DB, req *account.SearchAccountsRequest, projectID string, pageSize int32,
+) (*account.Accounts, error) {
+	// Results are paged by their position in the ranking
+	var position int
+	if req.GetPageToken() != "" {
+		vals, err := accountAPI.PaginationHasher.DecodeInt64WithError(req.GetPageToken())
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+		}
+		position = int(vals[0])
+	}
+
+	var (
+		accountsPB = make([]*account.Account, 0, pageSize)
+		total      uint64
+	)
+
+	for len(accountsPB) < int(pageSize) {
+		ids, hits, err := accountAPI.searchHitIDs(req.Query, projectID, position, searchBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		total = hits
+		if len(ids) == 0 {
+			break
+		}
+
+		accountsDB := make([]*Account, 0, len(ids))
+		err = db.Session(&gorm.Session{}).Limit(-1).Find(&accountsDB, "account_id IN (?)", ids).Error
+		if err != nil {
+			return nil, errs.FailedToFind("accounts", err)
+		}
+
+		byID := make(map[uint]*Account, len(accountsDB))
+		for _, accountDB := range accountsDB {
+			byID[accountDB.AccountID] = accountDB
+		}
+
+		for _, id := range ids {
+			if len(accountsPB) == int(pageSize) {
+				break
+			}
+			position++
+
+			accountDB, ok := byID[id]
+			if !ok {
+				continue
+			}
+			pb, err := AccountProto(accountDB)
+			if err != nil {
+				return nil, err
+			}
+			accountsPB = append(accountsPB, AccountProtoView(pb, req.GetView()))
+		}
+
+		if uint64(position) >= total {
+			break
+		}
+	}
+
+	var token string
+	if uint64(position) < total {
+		var err error
+		token, err = accountAPI.PaginationHasher.EncodeInt64([]int64{int64(position)})
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to generate page token")
+		}
+	}
+
+	var collectionCount int64
+	if req.GetPageToken() == "" {
+		var err error
+		collectionCount, err = accountAPI.countSearchMatches(db, req.Query, projectID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &account.Accounts{
+		NextPageToken:   token,
+		Accounts:        accountsPB,
+		CollectionCount: collectionCount,
+	}, nil
+}
+
+// ReindexAccounts adds every account in the database to the search index and returns the number indexed
+func ReindexAccounts(ctx context.Context, db *gorm.DB, index search.Index) (int, error) {
+	var (
+		lastID  uint
+		indexed int
+	)
+	for {
+		dbs := make([]*Account, 0, reindexBatchSize)
+		err := db.WithContext(ctx).Unscoped().Where("account_id>?", lastID).
+			Order("account_id").Limit(reindexBatchSize).Find(&dbs).Error
+		if err != nil {
+			return indexed, errs.FailedToFind("accounts", err)
+		}
+
+		for _, accountDB := range dbs {
+			lastID = accountDB.AccountID
+			err = index.Index(accountDocument(accountDB))
+			if err != nil {
+				return indexed, errs.WrapErrorWithMsg(err, fmt.Sprintf("failed to index account %d", accountDB.AccountID))
+			}
+			indexed++
+		}
+
+		if len(dbs) < reindexBatchSize {
+			return indexed, nil
+		}
+	}
+}
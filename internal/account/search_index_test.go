@@ -0,0 +1,138 @@
+package account
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/services/internal/pkg/search"
+	"github.com/gidyon/services/pkg/api/account"
+)
+
+var _ = Describe("Searching accounts with a search index @searchindex", func() {
+	var (
+		ctx        context.Context
+		index      search.Index
+		surname    string
+		phone      string
+		accountIDs []string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	searchIDs := func(query string) []string {
+		searchRes, err := AccountAPI.SearchAccounts(ctx, &account.SearchAccountsRequest{Query: query})
+		Expect(err).ShouldNot(HaveOccurred())
+		ids := make([]string, 0, len(searchRes.Accounts))
+		for _, pb := range searchRes.Accounts {
+			ids = append(ids, pb.AccountId)
+		}
+		return ids
+	}
+
+	It("should set the search index", func() {
+		var err error
+		index, err = search.Open(search.BackendBleve, GinkgoT().TempDir(), "accounts")
+		Expect(err).ShouldNot(HaveOccurred())
+		AccountAPIServer.SearchIndex = index
+	})
+
+	It("should index created accounts", func() {
+		surname = "Zq" + strings.ToLower(randomdata.RandStringRunes(8))
+		for _, names := range []string{"Jane " + surname, surname + " " + surname} {
+			pb := fakeAccount()
+			pb.Names = names
+			createRes, err := AccountAPI.CreateAccount(ctx, &account.CreateAccountRequest{
+				Account:   pb,
+				ProjectId: projectID,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			accountIDs = append(accountIDs, createRes.AccountId)
+			phone = pb.Phone
+		}
+	})
+
+	It("should rank the closest match first", func() {
+		ids := searchIDs(surname)
+		Expect(ids).Should(Equal([]string{accountIDs[1], accountIDs[0]}))
+	})
+
+	It("should match prefixes and close spellings", func() {
+		Expect(searchIDs(surname[:6])).Should(ConsistOf(accountIDs))
+		Expect(searchIDs("jane " + surname[:len(surname)-1] + "x")).Should(Equal([]string{accountIDs[0]}))
+	})
+
+	It("should match part of a phone number", func() {
+		Expect(searchIDs(phone[len(phone)-7:])).Should(Equal([]string{accountIDs[1]}))
+	})
+
+	It("should reindex updated accounts", func() {
+		_, err := AccountAPI.UpdateAccount(ctx, &account.UpdateAccountRequest{
+			Account: &account.Account{
+				AccountId: accountIDs[0],
+				Names:     "Mary " + surname,
+			},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(searchIDs("mary " + surname)).Should(Equal([]string{accountIDs[0]}))
+	})
+
+	It("should rebuild the index", func() {
+		indexed, err := ReindexAccounts(ctx, AccountAPIServer.SQLDBWrites, index)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(indexed).Should(BeNumerically(">=", len(accountIDs)))
+		Expect(searchIDs(surname)).Should(HaveLen(2))
+	})
+
+	It("should fill pages and count only accounts that match the criteria", func() {
+		other := "Zq" + strings.ToLower(randomdata.RandStringRunes(8))
+		females := make([]string, 0, 2)
+		for _, gender := range []account.Account_Gender{
+			account.Account_MALE, account.Account_FEMALE, account.Account_MALE, account.Account_FEMALE,
+		} {
+			pb := fakeAccount()
+			pb.Names = "Jane " + other
+			pb.Gender = gender
+			createRes, err := AccountAPI.CreateAccount(ctx, &account.CreateAccountRequest{
+				Account:   pb,
+				ProjectId: projectID,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			if gender == account.Account_FEMALE {
+				females = append(females, createRes.AccountId)
+			}
+		}
+
+		searchReq := &account.SearchAccountsRequest{
+			Query:          other,
+			PageSize:       1,
+			SearchCriteria: &account.Criteria{ShowFemales: true},
+		}
+		ids := make([]string, 0, len(females))
+		for page := 0; page < len(females); page++ {
+			searchRes, err := AccountAPI.SearchAccounts(ctx, searchReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(searchRes.Accounts).Should(HaveLen(1))
+			if page == 0 {
+				Expect(searchRes.CollectionCount).Should(BeEquivalentTo(len(females)))
+			}
+			ids = append(ids, searchRes.Accounts[0].AccountId)
+			searchReq.PageToken = searchRes.NextPageToken
+		}
+		Expect(ids).Should(ConsistOf(females))
+
+		if searchReq.PageToken != "" {
+			searchRes, err := AccountAPI.SearchAccounts(ctx, searchReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(searchRes.Accounts).Should(BeEmpty())
+		}
+	})
+
+	It("should restore the full text search", func() {
+		AccountAPIServer.SearchIndex = nil
+		Expect(index.Close()).ShouldNot(HaveOccurred())
+	})
+})
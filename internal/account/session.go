@@ -0,0 +1,518 @@
+package account
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/pkg/api/account"
+	redis "github.com/go-redis/redis/v8"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	defaultRefreshIdleMinutes     = 7 * 24 * 60
+	defaultRefreshAbsoluteMinutes = 30 * 24 * 60
+)
+
+func envMinutes(key string, def int) time.Duration {
+	mins, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || mins <= 0 {
+		mins = def
+	}
+	return time.Duration(mins) * time.Minute
+}
+
+// period a refresh token stays valid without being used
+func refreshTokenIdleLifetime() time.Duration {
+	return envMinutes("REFRESH_TOKEN_IDLE_MINUTES", defaultRefreshIdleMinutes)
+}
+
+// period after sign in when a session ends regardless of use
+func refreshTokenAbsoluteLifetime() time.Duration {
+	return envMinutes("REFRESH_TOKEN_ABSOLUTE_MINUTES", defaultRefreshAbsoluteMinutes)
+}
+
+func absoluteRemaining(createdAt int64) time.Duration {
+	return time.Until(time.Unix(createdAt, 0).Add(refreshTokenAbsoluteLifetime()))
+}
+
+// lifetime of session keys from now
+func sessionTTL(createdAt int64) time.Duration {
+	ttl := refreshTokenIdleLifetime()
+	if remaining := absoluteRemaining(createdAt); remaining < ttl {
+		ttl = remaining
+	}
+	return ttl
+}
+
+const (
+	sessionAccountID    = "account_id"
+	sessionRefreshToken = "refresh_token"
+	sessionDevice       = "device"
+	sessionUserAgent    = "user_agent"
+	sessionIPAddress    = "ip_address"
+	sessionCreatedAt    = "created_at"
+	sessionLastUsedAt   = "last_used_at"
+	sessionClientID     = "client_id"
+	sessionScope        = "scope"
+)
+
+func sessionKey(sessionID string) string {
+	return "session:" + sessionID
+}
+
+func accountSessionsKey(accountID string) string {
+	return "sessions:" + accountID
+}
+
+func refreshTokenKey(refreshToken string) string {
+	return refreshTokenHashKey(hashToken(refreshToken))
+}
+
+func refreshTokenHashKey(refreshTokenHash string) string {
+	return "refreshtoken:" + refreshTokenHash
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+type session struct {
+	ID           string
+	AccountID    string
+	RefreshToken string
+	Device       string
+	UserAgent    string
+	IPAddress    string
+	CreatedAt    int64
+	LastUsedAt   int64
+	// Set for sessions started by OpenID Connect clients
+	ClientID string
+	Scope    string
+	// Set for sessions of an admin impersonating the account
+	Impersonator string
+}
+
+func sessionFromHash(sessionID string, vals map[string]string) *session {
+	createdAt, _ := strconv.ParseInt(vals[sessionCreatedAt], 10, 64)
+	lastUsedAt, _ := strconv.ParseInt(vals[sessionLastUsedAt], 10, 64)
+	return &session{
+		ID:           sessionID,
+		AccountID:    vals[sessionAccountID],
+		RefreshToken: vals[sessionRefreshToken],
+		Device:       vals[sessionDevice],
+		UserAgent:    vals[sessionUserAgent],
+		IPAddress:    vals[sessionIPAddress],
+		CreatedAt:    createdAt,
+		LastUsedAt:   lastUsedAt,
+		ClientID:     vals[sessionClientID],
+		Scope:        vals[sessionScope],
+		Impersonator: vals[sessionImpersonator],
+	}
+}
+
+func sessionProto(sess *session) *account.Session {
+	pb := &account.Session{
+		SessionId:      sess.ID,
+		AccountId:      sess.AccountID,
+		Device:         sess.Device,
+		UserAgent:      sess.UserAgent,
+		IpAddress:      sess.IPAddress,
+		ImpersonatorId: sess.Impersonator,
+	}
+	if sess.CreatedAt != 0 {
+		pb.CreatedAt = time.Unix(sess.CreatedAt, 0).Format(time.RFC3339)
+	}
+	if sess.LastUsedAt != 0 {
+		pb.LastUsedAt = time.Unix(sess.LastUsedAt, 0).Format(time.RFC3339)
+	}
+	return pb
+}
+
+func firstMD(md metadata.MD, keys ...string) string {
+	for _, key := range keys {
+		if vals := md.Get(key); len(vals) != 0 && vals[0] != "" {
+			return vals[0]
+		}
+	}
+	return ""
+}
+
+// reads client device, user agent and ip address from the request
+func (accountAPI *accountAPIServer) clientInfo(ctx context.Context) (device, userAgent, ipAddress string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	device = firstMD(md, "device")
+	userAgent = firstMD(md, "grpcgateway-user-agent", "user-agent")
+
+	return device, userAgent, clientIP(ctx, accountAPI.trustedProxies)
+}
+
+// returns the address of the client that sent the request. Hops left of the ones added by trusted proxies
+// are set by the client and are not used.
+func clientIP(ctx context.Context, trustedProxies int) string {
+	if trustedProxies > 0 {
+		md, _ := metadata.FromIncomingContext(ctx)
+		hops := make([]string, 0)
+		for _, val := range md.Get("x-forwarded-for") {
+			for _, hop := range strings.Split(val, ",") {
+				if hop = strings.TrimSpace(hop); hop != "" {
+					hops = append(hops, hop)
+				}
+			}
+		}
+		if len(hops) >= trustedProxies {
+			return hops[len(hops)-trustedProxies]
+		}
+	}
+	return peerAddress(ctx)
+}
+
+// returns the address of the connection the request came through
+func peerAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	addr := p.Addr.String()
+	if i := strings.LastIndex(addr, ":"); i > 0 {
+		addr = addr[:i]
+	}
+	return addr
+}
+
+// creates a session for the account returning the session and its refresh token
+func (accountAPI *accountAPIServer) createSession(ctx context.Context, accountID uint) (*session, string, error) {
+	var (
+		refreshToken                 = uuid.New().String()
+		device, userAgent, ipAddress = accountAPI.clientInfo(ctx)
+		now                          = time.Now().Unix()
+		ttl                          = sessionTTL(now)
+	)
+
+	sess := &session{
+		ID:           uuid.New().String(),
+		AccountID:    fmt.Sprint(accountID),
+		RefreshToken: hashToken(refreshToken),
+		Device:       device,
+		UserAgent:    userAgent,
+		IPAddress:    ipAddress,
+		CreatedAt:    now,
+		LastUsedAt:   now,
+	}
+
+	_, err := accountAPI.RedisDBWrites.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, sessionKey(sess.ID), map[string]interface{}{
+			sessionAccountID:    sess.AccountID,
+			sessionRefreshToken: sess.RefreshToken,
+			sessionDevice:       sess.Device,
+			sessionUserAgent:    sess.UserAgent,
+			sessionIPAddress:    sess.IPAddress,
+			sessionCreatedAt:    sess.CreatedAt,
+			sessionLastUsedAt:   sess.LastUsedAt,
+		})
+		pipe.Expire(ctx, sessionKey(sess.ID), ttl)
+		pipe.Set(ctx, refreshTokenKey(refreshToken), sess.ID, ttl)
+		pipe.SAdd(ctx, accountSessionsKey(sess.AccountID), sess.ID)
+		pipe.Expire(ctx, accountSessionsKey(sess.AccountID), refreshTokenAbsoluteLifetime())
+		return nil
+	})
+	if err != nil {
+		return nil, "", errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to create session")
+	}
+
+	return sess, refreshToken, nil
+}
+
+func (accountAPI *accountAPIServer) getSession(ctx context.Context, sessionID string) (*session, error) {
+	vals, err := accountAPI.RedisDBWrites.HGetAll(ctx, sessionKey(sessionID)).Result()
+	if err != nil {
+		return nil, errs.RedisCmdFailed(err, "HGETALL")
+	}
+	if len(vals) == 0 {
+		return nil, nil
+	}
+	return sessionFromHash(sessionID, vals), nil
+}
+
+func (accountAPI *accountAPIServer) getSessionByRefreshToken(ctx context.Context, refreshToken string) (*session, error) {
+	sessionID, err := accountAPI.RedisDBWrites.Get(ctx, refreshTokenKey(refreshToken)).Result()
+	switch {
+	case err == nil:
+	case errors.Is(err, redis.Nil):
+		// Token may have been rotated already
+		sessionID, err = accountAPI.RedisDBWrites.Get(ctx, rotatedTokenKey(hashToken(refreshToken))).Result()
+		switch {
+		case err == nil:
+			return nil, accountAPI.refreshTokenReused(ctx, sessionID)
+		case errors.Is(err, redis.Nil):
+			return nil, errs.WrapMessage(codes.Unauthenticated, "not signed in")
+		default:
+			return nil, errs.RedisCmdFailed(err, "GET")
+		}
+	default:
+		return nil, errs.RedisCmdFailed(err, "GET")
+	}
+
+	sess, err := accountAPI.getSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if sess == nil || sess.RefreshToken != hashToken(refreshToken) {
+		return nil, errs.WrapMessage(codes.Unauthenticated, "not signed in")
+	}
+
+	// Sessions cannot be extended past their absolute lifetime
+	if absoluteRemaining(sess.CreatedAt) <= 0 {
+		err = accountAPI.revokeSession(ctx, sess)
+		if err != nil {
+			return nil, err
+		}
+		return nil, errs.WrapMessage(codes.Unauthenticated, "session expired; sign in again")
+	}
+
+	return sess, nil
+}
+
+func rotatedTokenKey(refreshTokenHash string) string {
+	return "rotatedtoken:" + refreshTokenHash
+}
+
+// rotates the session refresh token returning the new token; presenting an
+// already rotated token revokes the whole session
+func (accountAPI *accountAPIServer) rotateSession(ctx context.Context, sess *session) (string, error) {
+	var (
+		refreshToken                 = uuid.New().String()
+		device, userAgent, ipAddress = accountAPI.clientInfo(ctx)
+		ttl                          = sessionTTL(sess.CreatedAt)
+	)
+
+	// Only one caller can rotate a given token
+	ok, err := accountAPI.RedisDBWrites.SetNX(
+		ctx, rotatedTokenKey(sess.RefreshToken), sess.ID, absoluteRemaining(sess.CreatedAt),
+	).Result()
+	if err != nil {
+		return "", errs.RedisCmdFailed(err, "SETNX")
+	}
+	if !ok {
+		return "", accountAPI.refreshTokenReused(ctx, sess.ID)
+	}
+
+	vals := map[string]interface{}{
+		sessionRefreshToken: hashToken(refreshToken),
+		sessionLastUsedAt:   time.Now().Unix(),
+	}
+	if ipAddress != "" {
+		vals[sessionIPAddress] = ipAddress
+	}
+	if userAgent != "" {
+		vals[sessionUserAgent] = userAgent
+	}
+	if device != "" {
+		vals[sessionDevice] = device
+	}
+
+	_, err = accountAPI.RedisDBWrites.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, refreshTokenHashKey(sess.RefreshToken))
+		pipe.Set(ctx, refreshTokenKey(refreshToken), sess.ID, ttl)
+		pipe.HSet(ctx, sessionKey(sess.ID), vals)
+		pipe.Expire(ctx, sessionKey(sess.ID), ttl)
+		pipe.SAdd(ctx, accountSessionsKey(sess.AccountID), sess.ID)
+		pipe.Expire(ctx, accountSessionsKey(sess.AccountID), refreshTokenAbsoluteLifetime())
+		return nil
+	})
+	if err != nil {
+		return "", errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to update session")
+	}
+
+	return refreshToken, nil
+}
+
+// handles presentation of a refresh token that was already rotated
+func (accountAPI *accountAPIServer) refreshTokenReused(ctx context.Context, sessionID string) error {
+	sess, err := accountAPI.getSession(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	if sess != nil {
+		_, _, ipAddress := accountAPI.clientInfo(ctx)
+		accountAPI.Logger.Warningf(
+			"SECURITY refresh token reuse detected for account %s session %s from %s; revoking session",
+			sess.AccountID, sess.ID, ipAddress,
+		)
+
+		err = accountAPI.revokeSession(ctx, sess)
+		if err != nil {
+			return err
+		}
+	}
+
+	return errs.WrapMessage(codes.Unauthenticated, "refresh token already used; sign in again")
+}
+
+func (accountAPI *accountAPIServer) revokeSession(ctx context.Context, sess *session) error {
+	_, err := accountAPI.RedisDBWrites.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, sessionKey(sess.ID), refreshTokenHashKey(sess.RefreshToken))
+		pipe.SRem(ctx, accountSessionsKey(sess.AccountID), sess.ID)
+		return nil
+	})
+	if err != nil {
+		return errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to revoke session")
+	}
+	return nil
+}
+
+// revokes every session of the account apart from the one given
+func (accountAPI *accountAPIServer) revokeAllSessions(ctx context.Context, accountID, exceptSessionID string) error {
+	sessionIDs, err := accountAPI.RedisDBWrites.SMembers(ctx, accountSessionsKey(accountID)).Result()
+	if err != nil {
+		return errs.RedisCmdFailed(err, "SMEMBERS")
+	}
+
+	for _, sessionID := range sessionIDs {
+		if sessionID == exceptSessionID {
+			continue
+		}
+		sess, err := accountAPI.getSession(ctx, sessionID)
+		if err != nil {
+			return err
+		}
+		if sess == nil {
+			sess = &session{ID: sessionID, AccountID: accountID}
+		}
+		err = accountAPI.revokeSession(ctx, sess)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (accountAPI *accountAPIServer) ListSessions(
+	ctx context.Context, req *account.ListSessionsRequest,
+) (*account.ListSessionsResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("list sessions request")
+	case req.AccountId == "":
+		return nil, errs.MissingField("account id")
+	}
+
+	if err := denyImpersonation(ctx); err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	_, err := accountAPI.AuthAPI.AuthorizeActorOrGroup(ctx, req.AccountId, accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionIDs, err := accountAPI.RedisDBWrites.SMembers(ctx, accountSessionsKey(req.AccountId)).Result()
+	if err != nil {
+		return nil, errs.RedisCmdFailed(err, "SMEMBERS")
+	}
+
+	pbs := make([]*account.Session, 0, len(sessionIDs))
+
+	for _, sessionID := range sessionIDs {
+		sess, err := accountAPI.getSession(ctx, sessionID)
+		if err != nil {
+			return nil, err
+		}
+		// Session expired
+		if sess == nil {
+			accountAPI.RedisDBWrites.SRem(ctx, accountSessionsKey(req.AccountId), sessionID)
+			continue
+		}
+		pbs = append(pbs, sessionProto(sess))
+	}
+
+	return &account.ListSessionsResponse{
+		Sessions: pbs,
+	}, nil
+}
+
+func (accountAPI *accountAPIServer) RevokeSession(
+	ctx context.Context, req *account.RevokeSessionRequest,
+) (*empty.Empty, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("revoke session request")
+	case req.AccountId == "":
+		return nil, errs.MissingField("account id")
+	case req.SessionId == "":
+		return nil, errs.MissingField("session id")
+	}
+
+	if err := denyImpersonation(ctx); err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	_, err := accountAPI.AuthAPI.AuthorizeActorOrGroup(ctx, req.AccountId, accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	sess, err := accountAPI.getSession(ctx, req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	if sess == nil || sess.AccountID != req.AccountId {
+		return nil, errs.DoesNotExist("session", req.SessionId)
+	}
+
+	err = accountAPI.revokeSession(ctx, sess)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (accountAPI *accountAPIServer) RevokeAllSessions(
+	ctx context.Context, req *account.RevokeAllSessionsRequest,
+) (*empty.Empty, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.NilObject("revoke all sessions request")
+	case req.AccountId == "":
+		return nil, errs.MissingField("account id")
+	}
+
+	if err := denyImpersonation(ctx); err != nil {
+		return nil, err
+	}
+
+	// Authorization
+	_, err := accountAPI.AuthAPI.AuthorizeActorOrGroup(ctx, req.AccountId, accountAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = accountAPI.revokeAllSessions(ctx, req.AccountId, req.ExceptSessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
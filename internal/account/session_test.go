@@ -0,0 +1,171 @@
+package account
+
+import (
+	"context"
+	"net"
+
+	"github.com/gidyon/services/pkg/api/account"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Managing sessions @sessions", func() {
+	var (
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	Describe("Finding the client address", func() {
+		forwarded := func(hops string) context.Context {
+			ctx := peer.NewContext(context.Background(), &peer.Peer{
+				Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 9090},
+			})
+			if hops == "" {
+				return ctx
+			}
+			return metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", hops))
+		}
+
+		It("should ignore addresses added by the client", func() {
+			Expect(clientIP(forwarded("1.1.1.1, 203.0.113.7"), 1)).Should(Equal("203.0.113.7"))
+			Expect(clientIP(forwarded("1.1.1.1, 203.0.113.7, 10.0.0.2"), 2)).Should(Equal("203.0.113.7"))
+		})
+
+		It("should use the peer address when no proxy is trusted or hops are missing", func() {
+			Expect(clientIP(forwarded("1.1.1.1"), -1)).Should(Equal("127.0.0.1"))
+			Expect(clientIP(forwarded("1.1.1.1"), 2)).Should(Equal("127.0.0.1"))
+			Expect(clientIP(forwarded(""), 2)).Should(Equal("127.0.0.1"))
+		})
+
+		It("should record the client address on sessions", func() {
+			_, _, ipAddress := AccountAPIServer.clientInfo(forwarded("1.1.1.1, 203.0.113.7"))
+			Expect(ipAddress).Should(Equal("203.0.113.7"))
+		})
+	})
+
+	Describe("Calling session APIs with malformed request", func() {
+		It("should fail to list sessions when account id is missing", func() {
+			listRes, err := AccountAPI.ListSessions(ctx, &account.ListSessionsRequest{})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(listRes).Should(BeNil())
+		})
+		It("should fail to revoke session when session id is missing", func() {
+			_, err := AccountAPI.RevokeSession(ctx, &account.RevokeSessionRequest{AccountId: "1"})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail to revoke session that does not exist", func() {
+			_, err := AccountAPI.RevokeSession(ctx, &account.RevokeSessionRequest{AccountId: "1", SessionId: "none"})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.NotFound))
+		})
+		It("should fail to revoke all sessions when account id is missing", func() {
+			_, err := AccountAPI.RevokeAllSessions(ctx, &account.RevokeAllSessionsRequest{})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("Signing in from multiple devices", func() {
+		var accountID, email, password, group string
+		var signIns []*account.SignInResponse
+
+		It("should create an account without error", func() {
+			createReq := &account.CreateAccountRequest{
+				Account:        fakeAccount(),
+				PrivateAccount: fakePrivateAccount(),
+				ProjectId:      projectID,
+			}
+			createRes, err := AccountAPI.CreateAccount(ctx, createReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			accountID = createRes.AccountId
+			email = createReq.Account.Email
+			password = createReq.PrivateAccount.Password
+			group = createReq.Account.Group
+		})
+
+		It("should create a session for every sign in", func() {
+			for _, device := range []string{"phone", "laptop", "tablet"} {
+				signInRes, err := AccountAPI.SignIn(
+					metadata.NewIncomingContext(ctx, metadata.Pairs("device", device, "user-agent", "test")),
+					&account.SignInRequest{
+						Username:  email,
+						Password:  password,
+						Group:     group,
+						ProjectId: projectID,
+					},
+				)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(signInRes.SessionId).ShouldNot(BeZero())
+				signIns = append(signIns, signInRes)
+			}
+
+			listRes, err := AccountAPI.ListSessions(ctx, &account.ListSessionsRequest{AccountId: accountID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.Sessions).Should(HaveLen(3))
+			for _, sess := range listRes.Sessions {
+				Expect(sess.AccountId).Should(Equal(accountID))
+				Expect(sess.UserAgent).Should(Equal("test"))
+				Expect(sess.Device).ShouldNot(BeZero())
+				Expect(sess.CreatedAt).ShouldNot(BeZero())
+			}
+		})
+
+		It("should not refresh a session of another account", func() {
+			refreshRes, err := AccountAPI.RefreshSession(ctx, &account.RefreshSessionRequest{
+				RefreshToken: signIns[0].RefreshToken,
+				AccountId:    "0",
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+			Expect(refreshRes).Should(BeNil())
+		})
+
+		It("should revoke a single session", func() {
+			_, err := AccountAPI.RevokeSession(ctx, &account.RevokeSessionRequest{
+				AccountId: accountID,
+				SessionId: signIns[0].SessionId,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			refreshRes, err := AccountAPI.RefreshSession(ctx, &account.RefreshSessionRequest{
+				RefreshToken: signIns[0].RefreshToken,
+				AccountId:    accountID,
+			})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+			Expect(refreshRes).Should(BeNil())
+
+			listRes, err := AccountAPI.ListSessions(ctx, &account.ListSessionsRequest{AccountId: accountID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.Sessions).Should(HaveLen(2))
+		})
+
+		It("should revoke all sessions except the current one", func() {
+			_, err := AccountAPI.RevokeAllSessions(ctx, &account.RevokeAllSessionsRequest{
+				AccountId:       accountID,
+				ExceptSessionId: signIns[2].SessionId,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			listRes, err := AccountAPI.ListSessions(ctx, &account.ListSessionsRequest{AccountId: accountID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.Sessions).Should(HaveLen(1))
+			Expect(listRes.Sessions[0].SessionId).Should(Equal(signIns[2].SessionId))
+
+			refreshRes, err := AccountAPI.RefreshSession(ctx, &account.RefreshSessionRequest{
+				RefreshToken: signIns[2].RefreshToken,
+				AccountId:    accountID,
+				AccountGroup: group,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(refreshRes.SessionId).Should(Equal(signIns[2].SessionId))
+		})
+	})
+})
@@ -4,19 +4,45 @@ import (
 	"context"
 	"fmt"
 
+	fbauth "firebase.google.com/go/auth"
 	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/services/internal/pkg/fauth"
 	"github.com/gidyon/services/pkg/api/account"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// verifies every firebase ID token as the returned token
+type firebaseTokenFunc func() *fbauth.Token
+
+func (f firebaseTokenFunc) VerifyIDToken(context.Context, string) (*fbauth.Token, error) {
+	return f(), nil
+}
+
+// firebase token with verified contacts of the account
+func firebaseToken(uid string, pb *account.Account) *fbauth.Token {
+	claims := map[string]interface{}{}
+	if pb.Email != "" {
+		claims["email"], claims["email_verified"] = pb.Email, true
+	}
+	if pb.Phone != "" {
+		claims["phone_number"] = pb.Phone
+	}
+	return &fbauth.Token{UID: uid, Claims: claims}
+}
+
 var _ = Describe("Sign in a user @signin", func() {
 	var (
 		signInReq *account.SignInExternalRequest
 		ctx       context.Context
+		fbAuth    fauth.FirebaseAuthClient
 	)
 
 	BeforeEach(func() {
+		fbAuth = AccountAPIServer.FirebaseAuth
+		AccountAPIServer.FirebaseAuth = firebaseTokenFunc(func() *fbauth.Token {
+			return firebaseToken("", signInReq.Account)
+		})
 		signInReq = &account.SignInExternalRequest{
 			Account: fakeAccount(),
 			AuthToken: fmt.Sprintf(
@@ -28,6 +54,10 @@ var _ = Describe("Sign in a user @signin", func() {
 		ctx = context.Background()
 	})
 
+	AfterEach(func() {
+		AccountAPIServer.FirebaseAuth = fbAuth
+	})
+
 	Describe("Signing a user with malformed request", func() {
 		It("should fail when the request is nil", func() {
 			signInReq = nil
@@ -126,6 +156,35 @@ var _ = Describe("Sign in a user @signin", func() {
 					Expect(signInRes).ShouldNot(BeNil())
 				})
 			})
+
+			Describe("Signing in with the contacts of the user that the token did not verify", func() {
+				It("should fail when the token has no verified contacts", func() {
+					signInReq.Account.Email = userPB.Email
+					AccountAPIServer.FirebaseAuth = firebaseTokenFunc(func() *fbauth.Token {
+						return &fbauth.Token{UID: randomdata.RandStringRunes(16), Claims: map[string]interface{}{
+							"email": userPB.Email,
+						}}
+					})
+					signInRes, err := AccountAPI.SignInExternal(ctx, signInReq)
+					Expect(err).Should(HaveOccurred())
+					Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+					Expect(signInRes).Should(BeNil())
+				})
+				It("should not sign in or link the user", func() {
+					uid := randomdata.RandStringRunes(16)
+					signInReq.Account.Email = userPB.Email
+					AccountAPIServer.FirebaseAuth = firebaseTokenFunc(func() *fbauth.Token {
+						return firebaseToken(uid, &account.Account{Email: randomdata.Email()})
+					})
+					signInRes, err := AccountAPI.SignInExternal(ctx, signInReq)
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(signInRes.AccountId).ShouldNot(Equal(userID))
+
+					listRes, err := AccountAPI.ListIdentities(ctx, &account.ListIdentitiesRequest{AccountId: userID})
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(listRes.Identities).Should(BeEmpty())
+				})
+			})
 		})
 	})
 })
@@ -38,7 +38,7 @@ import (
 const templateName = "base"
 
 type accountAPIServer struct {
-	account.UnsafeAccountAPIServer
+	account.UnimplementedAccountAPIServer
 	activationURL string
 	tpl           *template.Template
 	*Options
@@ -15,8 +15,9 @@ import (
 	"strings"
 
 	"github.com/gidyon/micro/v2/pkg/middleware/grpc/auth"
-	"github.com/gidyon/micro/v2/utils/dbutil"
 	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/internal/pkg/search"
+	"github.com/gidyon/services/internal/pkg/sqldb"
 	"github.com/gidyon/services/pkg/api/channel"
 	"github.com/golang/protobuf/ptypes/empty"
 )
@@ -34,6 +35,7 @@ type Options struct {
 	Logger           grpclog.LoggerV2
 	PaginationHasher *hashids.HashID
 	AuthAPI          auth.API
+	SearchIndex      search.Index
 }
 
 // NewChannelAPIServer is factory for creating channel  APIs
@@ -76,12 +78,9 @@ func NewChannelAPIServer(
 	}
 
 	// Full text search index
-	if !channelAPI.SQLDBWrites.Migrator().HasIndex(&Channel{}, dbutil.FullTextIndex) {
-		// Create a full text search index
-		err = dbutil.CreateFullTextIndex(channelAPI.SQLDBWrites, channelsTable, "title", "label", "description")
-		if err != nil {
-			return nil, errs.WrapErrorWithMsg(err, "failed to create full text index")
-		}
+	err = sqldb.CreateFullTextIndex(channelAPI.SQLDBWrites, channelsTable, "title", "label", "description")
+	if err != nil {
+		return nil, errs.WrapErrorWithMsg(err, "failed to create full text index")
 	}
 
 	return channelAPI, nil
@@ -126,6 +125,8 @@ func (channelAPI *channelAPIServer) CreateChannel(
 		return nil, errs.SQLQueryFailed(err, "CreateChannel")
 	}
 
+	channelAPI.indexChannel(channelDB.ID)
+
 	return &channel.CreateChannelResponse{
 		Id: fmt.Sprint(channelDB.ID),
 	}, nil
@@ -170,6 +171,8 @@ func (channelAPI *channelAPIServer) UpdateChannel(
 		return nil, errs.SQLQueryFailed(err, "UpdateChannel")
 	}
 
+	channelAPI.indexChannel(uint(ID))
+
 	return &empty.Empty{}, nil
 }
 
@@ -202,6 +205,8 @@ func (channelAPI *channelAPIServer) DeleteChannel(
 		return nil, errs.SQLQueryFailed(err, "DeleteChannel")
 	}
 
+	channelAPI.unindexChannel(uint(ID))
+
 	return &empty.Empty{}, nil
 }
 
@@ -320,6 +325,12 @@ func (channelAPI *channelAPIServer) SearchChannels(
 		pageSize = defaultPageSize
 	}
 
+	// Search index ranks results by relevance
+	if channelAPI.SearchIndex != nil {
+		db := generateWhereCondition(channelAPI.SQLDBReads.Model(&Channel{}), searchReq.GetFilter())
+		return channelAPI.searchIndex(db, searchReq, pageSize)
+	}
+
 	var ID uint
 
 	// Get last id from page token
@@ -340,8 +351,6 @@ func (channelAPI *channelAPIServer) SearchChannels(
 	// Apply filter criterias
 	db = generateWhereCondition(db, searchReq.GetFilter())
 
-	parsedQuery := dbutil.ParseQuery(searchReq.Query)
-
 	// ID filter
 	if ID > 0 {
 		db = db.Where("id<?", ID)
@@ -357,8 +366,7 @@ func (channelAPI *channelAPIServer) SearchChannels(
 		}
 	}
 
-	err = db.Find(&channelsDB, "MATCH(title, label, description) AGAINST(? IN BOOLEAN MODE)", parsedQuery).
-		Error
+	err = sqldb.MatchFullText(db, searchReq.Query, "title", "label", "description").Find(&channelsDB).Error
 	switch {
 	case err == nil:
 	default:
@@ -6,11 +6,10 @@ import (
 
 	"github.com/Pallinder/go-randomdata"
 	"github.com/gidyon/micro/v2"
-	"github.com/gidyon/micro/v2/pkg/conn"
 	micro_mock "github.com/gidyon/micro/v2/pkg/mocks"
 	"github.com/gidyon/micro/v2/utils/encryption"
+	"github.com/gidyon/services/internal/pkg/sqldb/sqldbtest"
 	"github.com/gidyon/services/pkg/api/channel"
-	_ "github.com/go-sql-driver/mysql"
 	"gorm.io/gorm"
 
 	"github.com/onsi/ginkgo"
@@ -28,18 +27,11 @@ var (
 )
 
 const (
-	dbAddress = "localhost:3306"
-	schema    = "services"
+	schema = "services"
 )
 
 func startDB() (*gorm.DB, error) {
-	return conn.OpenGormConn(&conn.DBOptions{
-		Dialect:  "mysql",
-		Address:  dbAddress,
-		User:     "root",
-		Password: "hakty11",
-		Schema:   schema,
-	})
+	return sqldbtest.Open(schema)
 }
 
 var _ = BeforeSuite(func() {
@@ -14,9 +14,9 @@ const channelsTable = "channels"
 type Channel struct {
 	Title       string `gorm:"index;type:varchar(50);unique;not null"`
 	Label       string `gorm:"index;type:varchar(50)"`
-	Description string `gorm:"type:text(500);not null"`
+	Description string `gorm:"type:text;not null"`
 	OwnerID     string `gorm:"type:varchar(50);not null"`
-	Subscribers int32  `gorm:"type:int(10);not null"`
+	Subscribers int32  `gorm:"not null"`
 	gorm.Model
 }
 
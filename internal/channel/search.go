@@ -0,0 +1,161 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/gidyon/micro/v2/utils/errs"
+	"github.com/gidyon/services/internal/pkg/search"
+	"github.com/gidyon/services/pkg/api/channel"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+const reindexBatchSize = 1000
+
+// returns the searchable content of a channel
+func channelDocument(channelDB *Channel) *search.Document {
+	return &search.Document{
+		ID: fmt.Sprint(channelDB.ID),
+		Fields: map[string]string{
+			"title":       channelDB.Title,
+			"label":       channelDB.Label,
+			"description": channelDB.Description,
+		},
+	}
+}
+
+// updates the search index with the stored channel. Failures are logged since the index can be rebuilt.
+func (channelAPI *channelAPIServer) indexChannel(channelID uint) {
+	if channelAPI.SearchIndex == nil || channelID == 0 {
+		return
+	}
+
+	channelDB := &Channel{}
+	err := channelAPI.SQLDBWrites.First(channelDB, "id=?", channelID).Error
+	if err == nil {
+		err = channelAPI.SearchIndex.Index(channelDocument(channelDB))
+	}
+	if err != nil {
+		channelAPI.logger.Errorf("failed to index channel %d: %v", channelID, err)
+	}
+}
+
+// removes the channel from the search index
+func (channelAPI *channelAPIServer) unindexChannel(channelID uint) {
+	if channelAPI.SearchIndex == nil {
+		return
+	}
+
+	err := channelAPI.SearchIndex.Delete(fmt.Sprint(channelID))
+	if err != nil {
+		channelAPI.logger.Errorf("failed to remove channel %d from search index: %v", channelID, err)
+	}
+}
+
+// searches the index and returns the channels of db that match, most relevant first
+func (channelAPI *channelAPIServer) searchIndex(
+	db *gorm.DB, searchReq *channel.SearchChannelsRequest, pageSize int32,
+) (*channel.ListChannelsResponse, error) {
+	// Results are paged by their position in the ranking
+	var offset int
+	if searchReq.GetPageToken() != "" {
+		vals, err := channelAPI.PaginationHasher.DecodeInt64WithError(searchReq.GetPageToken())
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+		}
+		offset = int(vals[0])
+	}
+
+	res, err := channelAPI.SearchIndex.Search(&search.Query{
+		Text:   searchReq.Query,
+		Offset: offset,
+		Limit:  int(pageSize),
+	})
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to search channels")
+	}
+
+	ids := make([]uint, 0, len(res.Hits))
+	for _, hit := range res.Hits {
+		id, err := strconv.ParseUint(hit.ID, 10, 64)
+		if err == nil {
+			ids = append(ids, uint(id))
+		}
+	}
+
+	// Filters are applied by the database
+	channelsDB := make([]*Channel, 0, len(ids))
+	if len(ids) != 0 {
+		err = db.Find(&channelsDB, "id IN (?)", ids).Error
+		if err != nil {
+			return nil, errs.FailedToFind("channels", err)
+		}
+	}
+
+	byID := make(map[uint]*Channel, len(channelsDB))
+	for _, channelDB := range channelsDB {
+		byID[channelDB.ID] = channelDB
+	}
+
+	channelsPB := make([]*channel.Channel, 0, len(channelsDB))
+	for _, id := range ids {
+		channelDB, ok := byID[id]
+		if !ok {
+			continue
+		}
+		channelPB, err := GetChannelPB(channelDB)
+		if err != nil {
+			return nil, err
+		}
+		channelsPB = append(channelsPB, channelPB)
+	}
+
+	var token string
+	if next := offset + len(res.Hits); uint64(next) < res.Total {
+		token, err = channelAPI.PaginationHasher.EncodeInt64([]int64{int64(next)})
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to generate page token")
+		}
+	}
+
+	var collectionCount int64
+	if searchReq.GetPageToken() == "" {
+		collectionCount = int64(res.Total)
+	}
+
+	return &channel.ListChannelsResponse{
+		NextPageToken:   token,
+		Channels:        channelsPB,
+		CollectionCount: collectionCount,
+	}, nil
+}
+
+// ReindexChannels adds every channel in the database to the search index and returns the number indexed
+func ReindexChannels(ctx context.Context, db *gorm.DB, index search.Index) (int, error) {
+	var (
+		lastID  uint
+		indexed int
+	)
+	for {
+		channelsDB := make([]*Channel, 0, reindexBatchSize)
+		err := db.WithContext(ctx).Where("id>?", lastID).Order("id").Limit(reindexBatchSize).Find(&channelsDB).Error
+		if err != nil {
+			return indexed, errs.FailedToFind("channels", err)
+		}
+
+		for _, channelDB := range channelsDB {
+			lastID = channelDB.ID
+			err = index.Index(channelDocument(channelDB))
+			if err != nil {
+				return indexed, errs.WrapErrorWithMsg(err, fmt.Sprintf("failed to index channel %d", channelDB.ID))
+			}
+			indexed++
+		}
+
+		if len(channelsDB) < reindexBatchSize {
+			return indexed, nil
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/services/pkg/api/messaging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Deleting messages of a user @delete", func() {
+	var (
+		delReq *messaging.MessageRequest
+		ctx    context.Context
+		userID = fmt.Sprint(randomdata.Number(10000, 99999))
+	)
+
+	BeforeEach(func() {
+		delReq = &messaging.MessageRequest{
+			UserId: userID,
+		}
+		ctx = context.Background()
+	})
+
+	Describe("Deleting messages with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			delReq = nil
+			delRes, err := MessagingAPI.DeleteMessages(ctx, delReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(delRes).Should(BeNil())
+		})
+		It("should fail when user id is missing in request", func() {
+			delReq.UserId = ""
+			delRes, err := MessagingAPI.DeleteMessages(ctx, delReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(delRes).Should(BeNil())
+		})
+	})
+
+	Describe("Deleting messages with correct request", func() {
+		It("should succeed in creating messages", func() {
+			for i := 0; i < 3; i++ {
+				messageDB, err := GetMessageDB(fakeMessage(userID))
+				Expect(err).ShouldNot(HaveOccurred())
+				err = MessagingServer.SQLDBWrites.Create(messageDB).Error
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+		})
+
+		It("should succeed in deleting the messages", func() {
+			delRes, err := MessagingAPI.DeleteMessages(ctx, delReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(delRes).ShouldNot(BeNil())
+		})
+
+		It("should have removed the messages permanently", func() {
+			var count int64
+			err := MessagingServer.SQLDBWrites.Unscoped().Model(&Message{}).Where("user_id=?", userID).Count(&count).Error
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(BeZero())
+		})
+	})
+})
@@ -0,0 +1,85 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/services/pkg/api/messaging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Erasing messages of a user @erase", func() {
+	var (
+		eraseReq *messaging.MessageRequest
+		ctx      context.Context
+		userID   = fmt.Sprint(randomdata.Number(1000, 9999))
+	)
+
+	BeforeEach(func() {
+		eraseReq = &messaging.MessageRequest{
+			UserId: userID,
+		}
+		ctx = context.Background()
+	})
+
+	Describe("Erasing messages with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			eraseReq = nil
+			eraseRes, err := MessagingAPI.EraseMessages(ctx, eraseReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(eraseRes).Should(BeNil())
+		})
+		It("should fail when user id is missing in request", func() {
+			eraseReq.UserId = ""
+			eraseRes, err := MessagingAPI.EraseMessages(ctx, eraseReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(eraseRes).Should(BeNil())
+		})
+		It("should fail when user id is incorrect", func() {
+			eraseReq.UserId = randomdata.RandStringRunes(32)
+			eraseRes, err := MessagingAPI.EraseMessages(ctx, eraseReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(eraseRes).Should(BeNil())
+		})
+	})
+
+	Describe("Erasing messages with correct request", func() {
+		It("should succeed in creating messages", func() {
+			for i := 0; i < 3; i++ {
+				messageDB, err := GetMessageDB(fakeMessage(userID))
+				Expect(err).ShouldNot(HaveOccurred())
+				err = MessagingServer.SQLDBWrites.Create(messageDB).Error
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+		})
+
+		It("should succeed in erasing the messages", func() {
+			eraseRes, err := MessagingAPI.EraseMessages(ctx, eraseReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(eraseRes).ShouldNot(BeNil())
+		})
+
+		It("should keep the messages without their content", func() {
+			listRes, err := MessagingAPI.ListMessages(ctx, &messaging.ListMessagesRequest{
+				Filter: &messaging.ListMessagesFilter{
+					UserId: userID,
+				},
+				PageSize: 100,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.Messages).Should(HaveLen(3))
+
+			for _, messagePB := range listRes.Messages {
+				Expect(messagePB.Title).Should(Equal(erasedContent))
+				Expect(messagePB.Data).Should(Equal(erasedContent))
+				Expect(messagePB.Link).Should(BeEmpty())
+				Expect(messagePB.Details).Should(BeEmpty())
+			}
+		})
+	})
+})
@@ -8,12 +8,11 @@ import (
 
 	"github.com/Pallinder/go-randomdata"
 	"github.com/gidyon/micro/v2"
-	"github.com/gidyon/micro/v2/pkg/conn"
 	micro_mock "github.com/gidyon/micro/v2/pkg/mocks"
 	"github.com/gidyon/micro/v2/utils/encryption"
+	"github.com/gidyon/services/internal/pkg/sqldb/sqldbtest"
 	"github.com/gidyon/services/pkg/api/messaging"
 	"github.com/gidyon/services/pkg/mocks"
-	_ "github.com/go-sql-driver/mysql"
 	"github.com/onsi/ginkgo"
 	"github.com/onsi/gomega"
 	"gorm.io/gorm"
@@ -30,18 +29,11 @@ var (
 )
 
 const (
-	dbAddress = "localhost:3306"
-	schema    = "services"
+	schema = "services"
 )
 
 func startDB() (*gorm.DB, error) {
-	return conn.OpenGormConn(&conn.DBOptions{
-		Dialect:  "mysql",
-		Address:  dbAddress,
-		User:     "root",
-		Password: "hakty11",
-		Schema:   schema,
-	})
+	return sqldbtest.Open(schema)
 }
 
 var _ = BeforeSuite(func() {
@@ -107,8 +107,6 @@ func validateMessage(msg *messaging.Message) error {
 	switch {
 	case msg == nil:
 		err = errs.NilObject("message")
-	case msg.UserId == "":
-		err = errs.MissingField("user id")
 	case msg.Title == "":
 		err = errs.MissingField("title")
 	case msg.Data == "":
@@ -328,6 +326,8 @@ func (api *messagingServer) SendMessage(
 		return nil, errs.NilObject("send request")
 	case req.Message == nil:
 		return nil, errs.NilObject("message")
+	case req.Message.UserId == "" && (req.Message.Save || req.Email == "" && req.Phone == ""):
+		return nil, errs.MissingField("user id")
 	default:
 		err = validateMessage(req.GetMessage())
 		if err != nil {
@@ -340,12 +340,18 @@ func (api *messagingServer) SendMessage(
 	ctxGet, cancel := context.WithTimeout(mdutil.AddFromCtx(ctx), 10*time.Second)
 	defer cancel()
 
-	// Get subscriber
-	pb, err := api.SubscriberClient.GetSubscriber(ctxGet, &subscriber.GetSubscriberRequest{
-		SubscriberId: msg.UserId,
-	}, grpc.WaitForReady(true))
-	if err != nil {
-		return nil, errs.WrapErrorWithMsg(err, "failed to get subscriber")
+	// Recipients without an account are reached through the contacts in the request
+	pb := &subscriber.Subscriber{
+		Email: req.Email,
+		Phone: req.Phone,
+	}
+	if msg.UserId != "" {
+		pb, err = api.SubscriberClient.GetSubscriber(ctxGet, &subscriber.GetSubscriberRequest{
+			SubscriberId: msg.UserId,
+		}, grpc.WaitForReady(true))
+		if err != nil {
+			return nil, errs.WrapErrorWithMsg(err, "failed to get subscriber")
+		}
 	}
 
 	// Save message
@@ -610,3 +616,74 @@ func (api *messagingServer) GetNewMessagesCount(
 		Count: int32(count),
 	}, nil
 }
+
+// Placeholder for the content of erased messages
+const erasedContent = "[erased]"
+
+func (api *messagingServer) EraseMessages(
+	ctx context.Context, eraseReq *messaging.MessageRequest,
+) (*empty.Empty, error) {
+	// Authorize request
+	_, err := api.AuthAPI.AuthorizeActorOrGroup(ctx, eraseReq.GetUserId(), api.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+	var ID int
+
+	// Validation
+	switch {
+	case eraseReq == nil:
+		return nil, errs.NilObject("MessageRequest")
+	case eraseReq.UserId == "":
+		return nil, errs.MissingField("user id")
+	default:
+		ID, err = strconv.Atoi(eraseReq.UserId)
+		if err != nil {
+			return nil, errs.IncorrectVal("user id")
+		}
+	}
+
+	// Messages are kept for counts and history but their content is replaced
+	err = api.SQLDBWrites.Unscoped().Model(Message{}).Where("user_id=?", ID).Updates(map[string]interface{}{
+		"title":   erasedContent,
+		"message": erasedContent,
+		"link":    "",
+		"details": nil,
+	}).Error
+	if err != nil {
+		return nil, errs.WrapErrorWithMsg(err, "failed to erase messages")
+	}
+
+	return emptyMsg, nil
+}
+
+func (api *messagingServer) DeleteMessages(
+	ctx context.Context, delReq *messaging.MessageRequest,
+) (*empty.Empty, error) {
+	// Authorize request
+	_, err := api.AuthAPI.AuthorizeActorOrGroup(ctx, delReq.GetUserId(), api.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+	var ID int
+
+	// Validation
+	switch {
+	case delReq == nil:
+		return nil, errs.NilObject("MessageRequest")
+	case delReq.UserId == "":
+		return nil, errs.MissingField("user id")
+	default:
+		ID, err = strconv.Atoi(delReq.UserId)
+		if err != nil {
+			return nil, errs.IncorrectVal("user id")
+		}
+	}
+
+	err = api.SQLDBWrites.Unscoped().Delete(&Message{}, "user_id=?", ID).Error
+	if err != nil {
+		return nil, errs.WrapErrorWithMsg(err, "failed to delete messages")
+	}
+
+	return emptyMsg, nil
+}
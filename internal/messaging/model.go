@@ -23,8 +23,8 @@ type Message struct {
 	Title       string `gorm:"type:varchar(256);not null"`
 	Message     string `gorm:"type:varchar(2048);not null"`
 	Link        string `gorm:"type:varchar(512);not null"`
-	Seen        bool   `gorm:"type:tinyint(1);not null;default:0"`
-	Type        int8   `gorm:"type:tinyint(1);not null;default:0"`
+	Seen        bool   `gorm:"not null;default:false"`
+	Type        int8   `gorm:"not null;default:0"`
 	SendMethods []byte `gorm:"type:json;not null"`
 	Details     []byte `gorm:"type:json;null"`
 	gorm.Model
@@ -81,6 +81,14 @@ var _ = Describe("Sending messages @sending", func() {
 			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
 			Expect(sendRes).Should(BeNil())
 		})
+		It("should fail if user id is missing for a saved message to contacts", func() {
+			sendReq.Message.UserId = ""
+			sendReq.Email = randomdata.Email()
+			sendRes, err := MessagingAPI.SendMessage(ctx, sendReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(sendRes).Should(BeNil())
+		})
 		It("should fail if message title is missing", func() {
 			sendReq.Message.Title = ""
 			sendRes, err := MessagingAPI.SendMessage(ctx, sendReq)
@@ -130,6 +138,19 @@ var _ = Describe("Sending messages @sending", func() {
 			})
 		})
 
+		Describe("Sending a message to a recipient without an account", func() {
+			It("should succeed using the contacts in the request", func() {
+				sendReq.Message.UserId = ""
+				sendReq.Message.Save = false
+				sendReq.Message.SendMethods = []messaging.SendMethod{messaging.SendMethod_EMAIL, messaging.SendMethod_SMSV2}
+				sendReq.Email = randomdata.Email()
+				sendReq.Phone = randomdata.PhoneNumber()
+				sendRes, err := MessagingAPI.SendMessage(ctx, sendReq)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(sendRes).ShouldNot(BeNil())
+			})
+		})
+
 		for _, sendMethod := range messaging.SendMethod_value {
 			sendMethod := sendMethod
 			Describe("Different send methods", func() {
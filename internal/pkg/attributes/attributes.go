@@ -0,0 +1,256 @@
+// Package attributes validates custom account attributes against schemas defined per project.
+package attributes
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// Attribute types
+const (
+	TypeString  = "string"
+	TypeNumber  = "number"
+	TypeInteger = "integer"
+	TypeBoolean = "boolean"
+)
+
+// Violation rules
+const (
+	RuleUnknown  = "unknown"
+	RuleRequired = "required"
+	RuleType     = "type"
+	RuleEnum     = "enum"
+	RulePattern  = "pattern"
+)
+
+// DefaultSchema is the name of the schema for projects without their own schema
+const DefaultSchema = "default"
+
+var namePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,63}$`)
+
+// Attribute describes the values allowed for one custom attribute
+type Attribute struct {
+	Type     string   `json:"type,omitempty"`
+	Required bool     `json:"required,omitempty"`
+	Enum     []string `json:"enum,omitempty"`
+	Pattern  string   `json:"pattern,omitempty"`
+
+	pattern *regexp.Regexp
+	enum    map[string]struct{}
+}
+
+// Violation is a value that does not satisfy its attribute
+type Violation struct {
+	Attribute   string
+	Rule        string
+	Description string
+}
+
+// Schema maps attribute names to their definition
+type Schema map[string]*Attribute
+
+// Schemas maps project ids to their schema
+type Schemas map[string]Schema
+
+// Compile checks the definition of the attribute and prepares it for validation
+func (a *Attribute) Compile() error {
+	if a.Type == "" {
+		a.Type = TypeString
+	}
+	switch a.Type {
+	case TypeString, TypeNumber, TypeInteger, TypeBoolean:
+	default:
+		return fmt.Errorf("unknown type %q", a.Type)
+	}
+
+	if a.Pattern != "" {
+		pattern, err := regexp.Compile(a.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern: %w", err)
+		}
+		a.pattern = pattern
+	}
+
+	if len(a.Enum) > 0 {
+		a.enum = make(map[string]struct{}, len(a.Enum))
+		for _, val := range a.Enum {
+			normalised, ok := a.normalise(val)
+			if !ok {
+				return fmt.Errorf("enum value %q is not a %s", val, a.Type)
+			}
+			a.enum[normalised] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// returns the canonical form of a value of the attribute type
+func (a *Attribute) normalise(val string) (string, bool) {
+	switch a.Type {
+	case TypeNumber:
+		f, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return "", false
+		}
+		return strconv.FormatFloat(f, 'f', -1, 64), true
+	case TypeInteger:
+		i, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return "", false
+		}
+		return strconv.FormatInt(i, 10), true
+	case TypeBoolean:
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return "", false
+		}
+		return strconv.FormatBool(b), true
+	}
+	return val, true
+}
+
+// Compile compiles every attribute of the schema
+func (s Schema) Compile() error {
+	for name, attr := range s {
+		if !namePattern.MatchString(name) {
+			return fmt.Errorf("attribute name %q must be a letter or underscore followed by letters, digits or underscores", name)
+		}
+		if attr == nil {
+			return fmt.Errorf("attribute %s has no definition", name)
+		}
+		if err := attr.Compile(); err != nil {
+			return fmt.Errorf("attribute %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Validate checks values against the schema and returns them in their canonical form.
+// Empty values are treated as missing.
+func (s Schema) Validate(values map[string]string) (map[string]string, []Violation) {
+	out := make(map[string]string, len(values))
+	violations := make([]Violation, 0)
+
+	for _, name := range valueNames(values) {
+		val := values[name]
+		if val == "" {
+			continue
+		}
+
+		attr, ok := s[name]
+		if !ok {
+			violations = append(violations, Violation{
+				Attribute: name, Rule: RuleUnknown, Description: fmt.Sprintf("%s is not a known attribute", name),
+			})
+			continue
+		}
+
+		normalised, ok := attr.normalise(val)
+		if !ok {
+			violations = append(violations, Violation{
+				Attribute: name, Rule: RuleType, Description: fmt.Sprintf("%s must be a %s", name, attr.Type),
+			})
+			continue
+		}
+
+		if attr.enum != nil {
+			if _, ok := attr.enum[normalised]; !ok {
+				violations = append(violations, Violation{
+					Attribute: name, Rule: RuleEnum, Description: fmt.Sprintf("%s must be one of %v", name, attr.Enum),
+				})
+				continue
+			}
+		}
+
+		if attr.pattern != nil && !attr.pattern.MatchString(val) {
+			violations = append(violations, Violation{
+				Attribute: name, Rule: RulePattern, Description: fmt.Sprintf("%s must match %s", name, attr.Pattern),
+			})
+			continue
+		}
+
+		out[name] = normalised
+	}
+
+	for _, name := range s.names() {
+		if s[name].Required && values[name] == "" {
+			violations = append(violations, Violation{
+				Attribute: name, Rule: RuleRequired, Description: fmt.Sprintf("%s is required", name),
+			})
+		}
+	}
+
+	return out, violations
+}
+
+// Normalise returns the canonical form of a value of the attribute, or the value as is when it is not valid
+func (s Schema) Normalise(name, val string) string {
+	attr, ok := s[name]
+	if !ok {
+		return val
+	}
+	if normalised, ok := attr.normalise(val); ok {
+		return normalised
+	}
+	return val
+}
+
+// Schema returns the schema of the project, falling back to the default schema
+func (s Schemas) Schema(projectID string) Schema {
+	if schema, ok := s[projectID]; ok {
+		return schema
+	}
+	return s[DefaultSchema]
+}
+
+// LoadSchemas reads a JSON file mapping project ids to schemas. The schema
+// named "default" applies to projects without their own schema.
+func LoadSchemas(file string) (Schemas, error) {
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read attribute schemas: %w", err)
+	}
+
+	schemas := make(Schemas)
+	err = json.Unmarshal(bs, &schemas)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse attribute schemas: %w", err)
+	}
+
+	for project, schema := range schemas {
+		err = schema.Compile()
+		if err != nil {
+			return nil, fmt.Errorf("attribute schema %s: %w", project, err)
+		}
+	}
+
+	return schemas, nil
+}
+
+// ValidName reports whether name can be the name of an attribute
+func ValidName(name string) bool {
+	return namePattern.MatchString(name)
+}
+
+func valueNames(values map[string]string) []string {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s Schema) names() []string {
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
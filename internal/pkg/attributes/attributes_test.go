@@ -0,0 +1,114 @@
+package attributes
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func writeSchemas(t *testing.T, content string) string {
+	file := filepath.Join(t.TempDir(), "attributes.json")
+	if err := ioutil.WriteFile(file, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func loadSchema(t *testing.T) Schema {
+	schemas, err := LoadSchemas(writeSchemas(t, `{
+		"default": {"nickname": {}},
+		"hr": {
+			"employee_no": {"type": "string", "required": true, "pattern": "^E[0-9]{4}$"},
+			"grade": {"type": "integer", "enum": ["1", "2", "3"]},
+			"salary": {"type": "number"},
+			"remote": {"type": "boolean"}
+		}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schemas.Schema("other")["nickname"] == nil {
+		t.Fatal("expected default schema for other projects")
+	}
+	return schemas.Schema("hr")
+}
+
+func TestValidate(t *testing.T) {
+	schema := loadSchema(t)
+
+	values, violations := schema.Validate(map[string]string{
+		"employee_no": "E0001",
+		"grade":       "02",
+		"salary":      "1200.50",
+		"remote":      "TRUE",
+	})
+	if len(violations) != 0 {
+		t.Fatalf("unexpected violations %+v", violations)
+	}
+
+	want := map[string]string{"employee_no": "E0001", "grade": "2", "salary": "1200.5", "remote": "true"}
+	for name, val := range want {
+		if values[name] != val {
+			t.Errorf("%s = %q, want %q", name, values[name], val)
+		}
+	}
+}
+
+func TestValidateViolations(t *testing.T) {
+	schema := loadSchema(t)
+
+	_, violations := schema.Validate(map[string]string{
+		"grade":   "5",
+		"salary":  "a lot",
+		"unknown": "x",
+	})
+
+	rules := make(map[string]string)
+	for _, v := range violations {
+		rules[v.Attribute] = v.Rule
+	}
+	want := map[string]string{
+		"employee_no": RuleRequired,
+		"grade":       RuleEnum,
+		"salary":      RuleType,
+		"unknown":     RuleUnknown,
+	}
+	for name, rule := range want {
+		if rules[name] != rule {
+			t.Errorf("violation of %s = %q, want %q", name, rules[name], rule)
+		}
+	}
+
+	_, violations = schema.Validate(map[string]string{"employee_no": "X1"})
+	if len(violations) != 1 || violations[0].Rule != RulePattern {
+		t.Errorf("unexpected violations %+v", violations)
+	}
+}
+
+func TestValidateWithoutSchema(t *testing.T) {
+	var schemas Schemas
+	values, violations := schemas.Schema("any").Validate(map[string]string{"empty": ""})
+	if len(values) != 0 || len(violations) != 0 {
+		t.Errorf("unexpected result %v %+v", values, violations)
+	}
+
+	_, violations = schemas.Schema("any").Validate(map[string]string{"nickname": "x"})
+	if len(violations) != 1 || violations[0].Rule != RuleUnknown {
+		t.Errorf("unexpected violations %+v", violations)
+	}
+}
+
+func TestLoadInvalidSchemas(t *testing.T) {
+	for _, content := range []string{
+		`{"default": {"a": {"type": "date"}}}`,
+		`{"default": {"a": {"pattern": "("}}}`,
+		`{"default": {"a": {"type": "integer", "enum": ["x"]}}}`,
+		`{"default": {"bad-name": {}}}`,
+		`{"default": {"a": null}}`,
+		`[]`,
+	} {
+		if _, err := LoadSchemas(writeSchemas(t, content)); err == nil {
+			t.Errorf("expected error for %s", content)
+		}
+	}
+}
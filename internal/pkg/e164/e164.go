@@ -0,0 +1,91 @@
+// Package e164 normalises phone numbers to the E.164 format using a default region per project.
+package e164
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// DefaultRegion is the region of numbers without a country code when no region is configured
+const DefaultRegion = "KE"
+
+// DefaultProject is the name of the region entry for projects without their own region
+const DefaultProject = "default"
+
+// ErrInvalid is returned for numbers that are not valid in any region
+var ErrInvalid = errors.New("invalid phone number")
+
+// Normalise parses phone and formats it as E.164. Numbers without a country code are read as numbers of region.
+func Normalise(phone, region string) (string, error) {
+	phone = strings.TrimSpace(phone)
+	if phone == "" {
+		return "", ErrInvalid
+	}
+
+	// Numbers such as 2547.. carry the country code without the leading plus
+	if !strings.HasPrefix(phone, "+") && !strings.HasPrefix(phone, "0") {
+		if num, err := phonenumbers.Parse("+"+phone, region); err == nil && phonenumbers.IsValidNumber(num) {
+			return phonenumbers.Format(num, phonenumbers.E164), nil
+		}
+	}
+
+	num, err := phonenumbers.Parse(phone, region)
+	if err != nil || !phonenumbers.IsValidNumber(num) {
+		return "", ErrInvalid
+	}
+
+	return phonenumbers.Format(num, phonenumbers.E164), nil
+}
+
+// ValidRegion reports whether region is a supported ISO 3166-1 alpha-2 region code
+func ValidRegion(region string) bool {
+	return phonenumbers.GetSupportedRegions()[region]
+}
+
+// Regions maps project ids to the region of their phone numbers
+type Regions map[string]string
+
+// Region returns the region of the project, falling back to the default entry and then to DefaultRegion
+func (r Regions) Region(projectID string) string {
+	if region, ok := r[projectID]; ok {
+		return region
+	}
+	if region, ok := r[DefaultProject]; ok {
+		return region
+	}
+	return DefaultRegion
+}
+
+// Normalise normalises phone using the region of the project
+func (r Regions) Normalise(projectID, phone string) (string, error) {
+	return Normalise(phone, r.Region(projectID))
+}
+
+// LoadRegions reads regions from a JSON file that maps project ids to region codes
+func LoadRegions(file string) (Regions, error) {
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read phone regions: %w", err)
+	}
+
+	regions := Regions{}
+	err = json.Unmarshal(bs, &regions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode phone regions: %w", err)
+	}
+
+	for projectID, region := range regions {
+		region = strings.ToUpper(region)
+		if !ValidRegion(region) {
+			return nil, fmt.Errorf("phone region %q of project %q is not supported", region, projectID)
+		}
+		regions[projectID] = region
+	}
+
+	return regions, nil
+}
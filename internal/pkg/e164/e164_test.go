@@ -0,0 +1,79 @@
+package e164
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestNormalise(t *testing.T) {
+	for _, tc := range []struct {
+		phone, region, want string
+	}{
+		{"0712345678", "KE", "+254712345678"},
+		{"712345678", "KE", "+254712345678"},
+		{"254712345678", "KE", "+254712345678"},
+		{"+254712345678", "KE", "+254712345678"},
+		{"+254 712 345-678", "KE", "+254712345678"},
+		{"(0712) 345 678", "KE", "+254712345678"},
+		{"0772123456", "UG", "+256772123456"},
+		{"+254712345678", "UG", "+254712345678"},
+		{"2025550123", "US", "+12025550123"},
+	} {
+		got, err := Normalise(tc.phone, tc.region)
+		if err != nil {
+			t.Errorf("Normalise(%q, %q) failed: %v", tc.phone, tc.region, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("Normalise(%q, %q) = %q, want %q", tc.phone, tc.region, got, tc.want)
+		}
+	}
+}
+
+func TestNormaliseInvalid(t *testing.T) {
+	for _, phone := range []string{"", "  ", "12", "07123", "not a number", "user@example.com"} {
+		if got, err := Normalise(phone, "KE"); err != ErrInvalid {
+			t.Errorf("Normalise(%q) = %q, %v; want ErrInvalid", phone, got, err)
+		}
+	}
+}
+
+func TestRegions(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "regions.json")
+	if err := ioutil.WriteFile(file, []byte(`{"default": "ug", "kenya": "KE"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	regions, err := LoadRegions(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := regions.Region("kenya"); got != "KE" {
+		t.Errorf("unexpected project region %q", got)
+	}
+	if got := regions.Region("other"); got != "UG" {
+		t.Errorf("unexpected default region %q", got)
+	}
+	if got := Regions(nil).Region("other"); got != DefaultRegion {
+		t.Errorf("unexpected region without regions %q", got)
+	}
+
+	got, err := regions.Normalise("kenya", "0712345678")
+	if err != nil || got != "+254712345678" {
+		t.Errorf("unexpected normalised phone %q, %v", got, err)
+	}
+}
+
+func TestLoadInvalidRegions(t *testing.T) {
+	for _, content := range []string{`{"default": "XX"}`, `["KE"]`} {
+		file := filepath.Join(t.TempDir(), "regions.json")
+		if err := ioutil.WriteFile(file, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadRegions(file); err == nil {
+			t.Errorf("expected error for %s", content)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Package eventbus publishes events to topics and delivers them to groups of consumers at least once.
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	redis "github.com/go-redis/redis/v8"
+)
+
+// Backends of the bus
+const (
+	// BackendRedis keeps topics in Redis streams
+	BackendRedis = "redis"
+	// BackendMemory keeps topics in the memory of the process
+	BackendMemory = "memory"
+)
+
+// ErrClosed is returned when using a closed bus
+var ErrClosed = errors.New("event bus is closed")
+
+// Message is an event published to a topic
+type Message struct {
+	// ID is unique for every event so that consumers can drop redelivered events
+	ID string
+	// Key of the entity the event is about. Events of a key are delivered in the order they were published.
+	Key  string
+	Type string
+	// Payload is the encoded event
+	Payload []byte
+	Time    time.Time
+}
+
+// Handler processes a delivered message. Messages are redelivered until the handler succeeds.
+type Handler func(ctx context.Context, msg *Message) error
+
+// Bus publishes messages to topics and delivers them to consumer groups
+type Bus interface {
+	// Publish appends the messages to the topic in order
+	Publish(ctx context.Context, topic string, msgs ...*Message) error
+	// Subscribe delivers messages of the topic published after the group was created to the handler until ctx is done.
+	// Every message is delivered to one consumer of the group. A failed message is retried before later messages
+	// so a group with one consumer handles the events of a key in order.
+	Subscribe(ctx context.Context, topic, group, consumer string, handler Handler) error
+	// Close releases the bus
+	Close() error
+}
+
+// Open opens the bus of backend. The Redis backend requires a client.
+func Open(backend string, client *redis.Client) (Bus, error) {
+	switch backend {
+	case "", BackendMemory:
+		return NewMemory(), nil
+	case BackendRedis:
+		if client == nil {
+			return nil, errors.New("missing redis client")
+		}
+		return NewRedis(client, nil), nil
+	}
+	return nil, fmt.Errorf("unknown event bus backend %q", backend)
+}
+
+const (
+	minRetryDelay = 100 * time.Millisecond
+	maxRetryDelay = 30 * time.Second
+)
+
+// handle calls the handler until it succeeds or ctx is done
+func handle(ctx context.Context, handler Handler, msg *Message) error {
+	delay := minRetryDelay
+	for {
+		if handler(ctx, msg) == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		if delay *= 2; delay > maxRetryDelay {
+			delay = maxRetryDelay
+		}
+	}
+}
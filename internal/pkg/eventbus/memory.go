@@ -0,0 +1,147 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+)
+
+type memoryTopic struct {
+	msgs []*Message
+	// offsets of the next message of each group
+	offsets map[string]int
+	// groups of the topic are serialised so that their messages are handled in order
+	groupLocks map[string]*sync.Mutex
+	// closed when messages are published
+	published chan struct{}
+}
+
+type memoryBus struct {
+	mu     sync.Mutex
+	topics map[string]*memoryTopic
+	closed chan struct{}
+	once   sync.Once
+}
+
+// NewMemory creates a bus that keeps topics in memory. It is meant for tests and single process deployments.
+func NewMemory() Bus {
+	return &memoryBus{
+		topics: make(map[string]*memoryTopic),
+		closed: make(chan struct{}),
+	}
+}
+
+// returns the topic; must be called with the lock held
+func (bus *memoryBus) topic(name string) *memoryTopic {
+	topic, ok := bus.topics[name]
+	if !ok {
+		topic = &memoryTopic{
+			offsets:    make(map[string]int),
+			groupLocks: make(map[string]*sync.Mutex),
+			published:  make(chan struct{}),
+		}
+		bus.topics[name] = topic
+	}
+	return topic
+}
+
+func (bus *memoryBus) isClosed() bool {
+	select {
+	case <-bus.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (bus *memoryBus) Publish(ctx context.Context, topicName string, msgs ...*Message) error {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+
+	if bus.isClosed() {
+		return ErrClosed
+	}
+
+	topic := bus.topic(topicName)
+	for _, msg := range msgs {
+		cp := *msg
+		topic.msgs = append(topic.msgs, &cp)
+	}
+
+	// Wake up subscribers
+	close(topic.published)
+	topic.published = make(chan struct{})
+
+	return nil
+}
+
+// next returns the next message of the group or a channel closed when one is published
+func (bus *memoryBus) next(topicName, group string) (*Message, <-chan struct{}) {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+
+	topic := bus.topic(topicName)
+	offset := topic.offsets[group]
+	if offset < len(topic.msgs) {
+		cp := *topic.msgs[offset]
+		return &cp, nil
+	}
+	return nil, topic.published
+}
+
+func (bus *memoryBus) ack(topicName, group string) {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+
+	bus.topics[topicName].offsets[group]++
+}
+
+func (bus *memoryBus) Subscribe(ctx context.Context, topicName, group, _ string, handler Handler) error {
+	bus.mu.Lock()
+	if bus.isClosed() {
+		bus.mu.Unlock()
+		return ErrClosed
+	}
+	topic := bus.topic(topicName)
+	groupLock, ok := topic.groupLocks[group]
+	if !ok {
+		// New groups start after messages already published
+		topic.offsets[group] = len(topic.msgs)
+		groupLock = &sync.Mutex{}
+		topic.groupLocks[group] = groupLock
+	}
+	bus.mu.Unlock()
+
+	for {
+		groupLock.Lock()
+		msg, published := bus.next(topicName, group)
+		if msg != nil {
+			err := handle(ctx, handler, msg)
+			if err == nil {
+				bus.ack(topicName, group)
+			}
+			groupLock.Unlock()
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		groupLock.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-bus.closed:
+			return ErrClosed
+		case <-published:
+		}
+	}
+}
+
+func (bus *memoryBus) Close() error {
+	bus.once.Do(func() {
+		bus.mu.Lock()
+		close(bus.closed)
+		bus.mu.Unlock()
+	})
+	return nil
+}
@@ -0,0 +1,126 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// subscribes a group of one consumer and returns the channel of handled message ids
+func subscribe(t *testing.T, ctx context.Context, bus Bus, group string, handler Handler) <-chan string {
+	handled := make(chan string, 100)
+	subscribed := make(chan struct{})
+	go func() {
+		close(subscribed)
+		bus.Subscribe(ctx, "accounts", group, "consumer", func(ctx context.Context, msg *Message) error {
+			if handler != nil {
+				if err := handler(ctx, msg); err != nil {
+					return err
+				}
+			}
+			handled <- msg.ID
+			return nil
+		})
+	}()
+	<-subscribed
+	// Let the subscription create its group
+	time.Sleep(20 * time.Millisecond)
+	return handled
+}
+
+func receive(t *testing.T, handled <-chan string, n int) []string {
+	ids := make([]string, 0, n)
+	for len(ids) < n {
+		select {
+		case id := <-handled:
+			ids = append(ids, id)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received %v; want %d messages", ids, n)
+		}
+	}
+	return ids
+}
+
+func publish(t *testing.T, bus Bus, ids ...string) {
+	msgs := make([]*Message, 0, len(ids))
+	for _, id := range ids {
+		msgs = append(msgs, &Message{ID: id, Key: "1", Type: "created", Time: time.Now()})
+	}
+	if err := bus.Publish(context.Background(), "accounts", msgs...); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMemoryDelivery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bus := NewMemory()
+	defer bus.Close()
+
+	// Messages published before the group exists are not delivered
+	publish(t, bus, "0")
+
+	first := subscribe(t, ctx, bus, "first", nil)
+	second := subscribe(t, ctx, bus, "second", nil)
+
+	publish(t, bus, "1", "2")
+	publish(t, bus, "3")
+
+	for _, handled := range []<-chan string{first, second} {
+		if got := fmt.Sprint(receive(t, handled, 3)); got != "[1 2 3]" {
+			t.Errorf("got %s; want [1 2 3]", got)
+		}
+	}
+}
+
+func TestMemoryRetriesInOrder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bus := NewMemory()
+	defer bus.Close()
+
+	failures := 2
+	handled := subscribe(t, ctx, bus, "group", func(ctx context.Context, msg *Message) error {
+		if msg.ID == "1" && failures > 0 {
+			failures--
+			return errors.New("unavailable")
+		}
+		return nil
+	})
+
+	publish(t, bus, "1", "2")
+
+	if got := fmt.Sprint(receive(t, handled, 2)); got != "[1 2]" {
+		t.Errorf("got %s; want [1 2]", got)
+	}
+}
+
+func TestMemoryClose(t *testing.T) {
+	bus := NewMemory()
+	if err := bus.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := bus.Publish(context.Background(), "accounts", &Message{ID: "1"}); !errors.Is(err, ErrClosed) {
+		t.Errorf("Publish() = %v; want %v", err, ErrClosed)
+	}
+	err := bus.Subscribe(context.Background(), "accounts", "group", "consumer", func(context.Context, *Message) error { return nil })
+	if !errors.Is(err, ErrClosed) {
+		t.Errorf("Subscribe() = %v; want %v", err, ErrClosed)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	if _, err := Open(BackendRedis, nil); err == nil {
+		t.Error("expected error without redis client")
+	}
+	if _, err := Open("kafka", nil); err == nil {
+		t.Error("expected error for unknown backend")
+	}
+	if bus, err := Open("", nil); err != nil || bus == nil {
+		t.Errorf("Open() = %v, %v; want memory bus", bus, err)
+	}
+}
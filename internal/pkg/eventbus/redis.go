@@ -0,0 +1,221 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	redis "github.com/go-redis/redis/v8"
+)
+
+// Fields of stream entries
+const (
+	fieldID      = "id"
+	fieldKey     = "key"
+	fieldType    = "type"
+	fieldPayload = "payload"
+	fieldTime    = "time"
+)
+
+// RedisOptions contains parameters of a bus of Redis streams
+type RedisOptions struct {
+	// Prefix of the stream keys of topics
+	Prefix string
+	// Approximate number of entries kept in each stream
+	MaxLen int64
+	// Number of entries read at once
+	BatchSize int64
+	// Period a read waits for new entries
+	Block time.Duration
+	// Pending entries of other consumers idle for longer are claimed so that entries of consumers that
+	// went away are delivered
+	ClaimIdle time.Duration
+}
+
+func (opt *RedisOptions) withDefaults() *RedisOptions {
+	res := &RedisOptions{}
+	if opt != nil {
+		*res = *opt
+	}
+	if res.Prefix == "" {
+		res.Prefix = "events:"
+	}
+	if res.MaxLen <= 0 {
+		res.MaxLen = 100000
+	}
+	if res.BatchSize <= 0 {
+		res.BatchSize = 100
+	}
+	if res.Block <= 0 {
+		res.Block = 5 * time.Second
+	}
+	if res.ClaimIdle <= 0 {
+		res.ClaimIdle = time.Minute
+	}
+	return res
+}
+
+type redisBus struct {
+	client *redis.Client
+	opt    *RedisOptions
+}
+
+// NewRedis creates a bus that keeps every topic in a Redis stream
+func NewRedis(client *redis.Client, opt *RedisOptions) Bus {
+	return &redisBus{client: client, opt: opt.withDefaults()}
+}
+
+func (bus *redisBus) stream(topic string) string {
+	return bus.opt.Prefix + topic
+}
+
+func (bus *redisBus) Publish(ctx context.Context, topic string, msgs ...*Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	// Entries are appended atomically in order
+	pipe := bus.client.TxPipeline()
+	for _, msg := range msgs {
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream:       bus.stream(topic),
+			MaxLenApprox: bus.opt.MaxLen,
+			Values: map[string]interface{}{
+				fieldID:      msg.ID,
+				fieldKey:     msg.Key,
+				fieldType:    msg.Type,
+				fieldPayload: msg.Payload,
+				fieldTime:    msg.Time.UnixNano(),
+			},
+		})
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func streamMessage(entry redis.XMessage) *Message {
+	value := func(field string) string {
+		v, _ := entry.Values[field].(string)
+		return v
+	}
+	nanos, _ := strconv.ParseInt(value(fieldTime), 10, 64)
+	return &Message{
+		ID:      value(fieldID),
+		Key:     value(fieldKey),
+		Type:    value(fieldType),
+		Payload: []byte(value(fieldPayload)),
+		Time:    time.Unix(0, nanos),
+	}
+}
+
+func (bus *redisBus) Subscribe(ctx context.Context, topic, group, consumer string, handler Handler) error {
+	stream := bus.stream(topic)
+
+	err := bus.client.XGroupCreateMkStream(ctx, stream, group, "$").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return err
+	}
+
+	process := func(entries []redis.XMessage) error {
+		for _, entry := range entries {
+			err := handle(ctx, handler, streamMessage(entry))
+			if err != nil {
+				return err
+			}
+			err = bus.client.XAck(ctx, stream, group, entry.ID).Err()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// Entries delivered to the consumer before it stopped are handled first
+	start := "0"
+	lastClaim := time.Time{}
+
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if time.Since(lastClaim) > bus.opt.ClaimIdle {
+			entries, err := bus.claimStale(ctx, stream, group, consumer)
+			if err != nil {
+				return err
+			}
+			err = process(entries)
+			if err != nil {
+				return err
+			}
+			lastClaim = time.Now()
+		}
+
+		res, err := bus.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    group,
+			Consumer: consumer,
+			Streams:  []string{stream, start},
+			Count:    bus.opt.BatchSize,
+			Block:    bus.opt.Block,
+		}).Result()
+		switch {
+		case errors.Is(err, redis.Nil):
+			continue
+		case err != nil:
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+
+		for _, s := range res {
+			if start == "0" && len(s.Messages) == 0 {
+				// No pending entries left
+				start = ">"
+			}
+			err = process(s.Messages)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// claims entries of other consumers of the group that have not been acknowledged for long
+func (bus *redisBus) claimStale(ctx context.Context, stream, group, consumer string) ([]redis.XMessage, error) {
+	pending, err := bus.client.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: stream,
+		Group:  group,
+		Start:  "-",
+		End:    "+",
+		Count:  bus.opt.BatchSize,
+	}).Result()
+	if err != nil || len(pending) == 0 {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(pending))
+	for _, p := range pending {
+		if p.Consumer != consumer && p.Idle >= bus.opt.ClaimIdle {
+			ids = append(ids, p.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return bus.client.XClaim(ctx, &redis.XClaimArgs{
+		Stream:   stream,
+		Group:    group,
+		Consumer: consumer,
+		MinIdle:  bus.opt.ClaimIdle,
+		Messages: ids,
+	}).Result()
+}
+
+func (bus *redisBus) Close() error {
+	// The client is owned by the caller
+	return nil
+}
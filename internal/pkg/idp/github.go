@@ -0,0 +1,138 @@
+package idp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const gitHubAPIURL = "https://api.github.com"
+
+// GitHub verifies OAuth access tokens of a GitHub OAuth app. GitHub does not
+// issue ID tokens so identities are read from its API.
+type GitHub struct {
+	name         string
+	apiURL       string
+	clientID     string
+	clientSecret string
+	httpClient   *http.Client
+}
+
+type gitHubUser struct {
+	ID        int64  `json:"id"`
+	Login     string `json:"login"`
+	Name      string `json:"name"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+// NewGitHub creates a GitHub provider for the OAuth app with the given client id and secret
+func NewGitHub(cfg *Config, httpClient *http.Client) (*GitHub, error) {
+	switch {
+	case len(cfg.ClientIDs) != 1:
+		return nil, fmt.Errorf("idp: provider %s requires exactly one client id", cfg.Name)
+	case cfg.ClientSecret == "":
+		return nil, fmt.Errorf("idp: client secret of provider %s is required", cfg.Name)
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	apiURL := cfg.APIURL
+	if apiURL == "" {
+		apiURL = gitHubAPIURL
+	}
+	return &GitHub{
+		name:         cfg.Name,
+		apiURL:       strings.TrimSuffix(apiURL, "/"),
+		clientID:     cfg.ClientIDs[0],
+		clientSecret: cfg.ClientSecret,
+		httpClient:   httpClient,
+	}, nil
+}
+
+// Name of the provider
+func (p *GitHub) Name() string {
+	return p.name
+}
+
+func (p *GitHub) do(req *http.Request, v interface{}) (int, error) {
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return res.StatusCode, nil
+	}
+	return res.StatusCode, json.NewDecoder(res.Body).Decode(v)
+}
+
+// Verify checks that token was issued to the app and returns its user
+func (p *GitHub) Verify(ctx context.Context, token string) (*Identity, error) {
+	// Only the app can check its tokens, which also proves the token audience
+	body, err := json.Marshal(map[string]string{"access_token": token})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, fmt.Sprintf("%s/applications/%s/token", p.apiURL, p.clientID), bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(p.clientID, p.clientSecret)
+	req.Header.Set("Content-Type", "application/json")
+
+	check := struct {
+		User *gitHubUser `json:"user"`
+	}{}
+	code, err := p.do(req, &check)
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("idp: failed to check token with %s: %w", p.name, err)
+	case code == http.StatusNotFound || code == http.StatusUnprocessableEntity:
+		return nil, fmt.Errorf("%w: token not issued to the app", ErrInvalidToken)
+	case code != http.StatusOK:
+		return nil, fmt.Errorf("idp: checking token with %s returned %d", p.name, code)
+	case check.User == nil || check.User.ID == 0:
+		return nil, fmt.Errorf("%w: token has no user", ErrInvalidToken)
+	}
+
+	identity := &Identity{
+		Provider: p.name,
+		Subject:  fmt.Sprint(check.User.ID),
+		Names:    check.User.Name,
+		Picture:  check.User.AvatarURL,
+	}
+	if identity.Names == "" {
+		identity.Names = check.User.Login
+	}
+
+	// The primary email needs the user:email scope
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, p.apiURL+"/user/emails", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "token "+token)
+
+	emails := make([]struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}, 0)
+	code, err = p.do(req, &emails)
+	if err == nil && code == http.StatusOK {
+		for _, e := range emails {
+			if e.Primary {
+				identity.Email = e.Email
+				identity.EmailVerified = e.Verified
+				break
+			}
+		}
+	}
+
+	return identity, nil
+}
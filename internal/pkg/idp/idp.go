@@ -0,0 +1,168 @@
+// Package idp verifies credentials issued by external identity providers
+package idp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// Provider types
+const (
+	TypeOIDC      = "oidc"
+	TypeGoogle    = "google"
+	TypeMicrosoft = "microsoft"
+	TypeGitHub    = "github"
+)
+
+// Firebase is the name of the provider handled by firebase auth
+const Firebase = "firebase"
+
+// DefaultProviders is the name of the providers for projects without their own providers
+const DefaultProviders = "default"
+
+var (
+	// ErrUnknownProvider is returned when a project has no provider with a given name
+	ErrUnknownProvider = errors.New("idp: unknown identity provider")
+	// ErrInvalidToken is returned when a credential fails verification
+	ErrInvalidToken = errors.New("idp: invalid token")
+)
+
+// Identity is a user as asserted by an identity provider
+type Identity struct {
+	Provider      string
+	Subject       string
+	Names         string
+	Email         string
+	EmailVerified bool
+	Phone         string
+	PhoneVerified bool
+	Picture       string
+}
+
+// Provider verifies credentials of an identity provider
+type Provider interface {
+	// Name of the provider as requested by clients
+	Name() string
+	// Verify returns the identity asserted by token
+	Verify(ctx context.Context, token string) (*Identity, error)
+}
+
+// Config configures an identity provider of a project
+type Config struct {
+	// Name clients use to select the provider
+	Name string `json:"name"`
+	// One of oidc, google, microsoft or github
+	Type string `json:"type"`
+	// Issuer url of oidc providers
+	Issuer string `json:"issuer,omitempty"`
+	// Overrides the JWKS url from the issuer discovery document
+	JWKSURL string `json:"jwks_url,omitempty"`
+	// Microsoft tenant; defaults to common
+	Tenant string `json:"tenant,omitempty"`
+	// Client ids accepted as the token audience
+	ClientIDs []string `json:"client_ids,omitempty"`
+	// GitHub OAuth app secret used to check that tokens belong to the app
+	ClientSecret string `json:"client_secret,omitempty"`
+	// GitHub API url; defaults to https://api.github.com
+	APIURL string `json:"api_url,omitempty"`
+}
+
+// New creates a provider from its configuration
+func New(cfg *Config, httpClient *http.Client) (Provider, error) {
+	if cfg == nil || cfg.Name == "" {
+		return nil, errors.New("idp: provider name is required")
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	switch cfg.Type {
+	case TypeOIDC:
+		return NewOIDC(cfg, httpClient)
+	case TypeGoogle:
+		c := *cfg
+		c.Issuer = "https://accounts.google.com"
+		return NewOIDC(&c, httpClient)
+	case TypeMicrosoft:
+		c := *cfg
+		tenant := c.Tenant
+		if tenant == "" {
+			tenant = "common"
+		}
+		c.Issuer = "https://login.microsoftonline.com/" + tenant + "/v2.0"
+		return NewOIDC(&c, httpClient)
+	case TypeGitHub:
+		return NewGitHub(cfg, httpClient)
+	default:
+		return nil, fmt.Errorf("idp: unsupported type %q of provider %s", cfg.Type, cfg.Name)
+	}
+}
+
+// Registry holds the identity providers enabled for each project
+type Registry struct {
+	providers map[string]map[string]Provider
+}
+
+// NewRegistry creates providers from configurations keyed by project id. The
+// providers under "default" are used by projects without their own providers.
+func NewRegistry(configs map[string][]*Config, httpClient *http.Client) (*Registry, error) {
+	r := &Registry{providers: make(map[string]map[string]Provider, len(configs))}
+	for project, cfgs := range configs {
+		for _, cfg := range cfgs {
+			p, err := New(cfg, httpClient)
+			if err != nil {
+				return nil, fmt.Errorf("project %s: %w", project, err)
+			}
+			r.Register(project, p)
+		}
+	}
+	return r, nil
+}
+
+// LoadRegistry reads provider configurations from a JSON file mapping project ids to providers
+func LoadRegistry(file string) (*Registry, error) {
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read identity providers: %w", err)
+	}
+
+	configs := make(map[string][]*Config)
+	err = json.Unmarshal(bs, &configs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse identity providers: %w", err)
+	}
+
+	return NewRegistry(configs, nil)
+}
+
+// Register enables a provider for a project
+func (r *Registry) Register(projectID string, p Provider) {
+	if r.providers == nil {
+		r.providers = make(map[string]map[string]Provider)
+	}
+	if r.providers[projectID] == nil {
+		r.providers[projectID] = make(map[string]Provider)
+	}
+	r.providers[projectID][p.Name()] = p
+}
+
+// Provider returns the provider of a project with the given name
+func (r *Registry) Provider(projectID, name string) (Provider, error) {
+	if r == nil {
+		return nil, ErrUnknownProvider
+	}
+	providers, ok := r.providers[projectID]
+	if !ok {
+		providers = r.providers[DefaultProviders]
+	}
+	p, ok := providers[name]
+	if !ok {
+		return nil, ErrUnknownProvider
+	}
+	return p, nil
+}
@@ -0,0 +1,147 @@
+package idp_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gidyon/services/internal/pkg/idp"
+	"github.com/gidyon/services/internal/pkg/idp/idptest"
+)
+
+func TestOIDCVerify(t *testing.T) {
+	iss, err := idptest.NewIssuer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer iss.Close()
+
+	registry, err := idp.NewRegistry(map[string][]*idp.Config{
+		idp.DefaultProviders: {{Name: "stub", Type: idp.TypeOIDC, Issuer: iss.URL, ClientIDs: []string{"app"}}},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := registry.Provider("any-project", "stub")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := iss.IDToken("user-1", "app", map[string]interface{}{
+		"email":          "user@example.com",
+		"email_verified": true,
+		"given_name":     "Jane",
+		"family_name":    "Doe",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	identity, err := p.Verify(context.Background(), token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity.Subject != "user-1" || identity.Email != "user@example.com" || !identity.EmailVerified || identity.Names != "Jane Doe" {
+		t.Errorf("unexpected identity %+v", identity)
+	}
+
+	cases := map[string]map[string]interface{}{
+		"audience": {"aud": "other-app"},
+		"issuer":   {"iss": "https://evil.example.com"},
+		"expired":  {"exp": time.Now().Add(-time.Minute).Unix()},
+	}
+	for name, extra := range cases {
+		token, err := iss.IDToken("user-1", "app", extra)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := p.Verify(context.Background(), token); !errors.Is(err, idp.ErrInvalidToken) {
+			t.Errorf("%s: expected invalid token got %v", name, err)
+		}
+	}
+
+	other, err := idptest.NewIssuer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer other.Close()
+
+	forged, err := other.IDToken("user-1", "app", map[string]interface{}{"iss": iss.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Verify(context.Background(), forged); !errors.Is(err, idp.ErrInvalidToken) {
+		t.Errorf("expected token signed by another key to be invalid got %v", err)
+	}
+}
+
+func TestRegistryProjects(t *testing.T) {
+	registry, err := idp.NewRegistry(map[string][]*idp.Config{
+		"p1": {{Name: "google", Type: idp.TypeGoogle, ClientIDs: []string{"app"}}},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := registry.Provider("p1", "google"); err != nil {
+		t.Errorf("expected provider: %v", err)
+	}
+	if _, err := registry.Provider("p2", "google"); !errors.Is(err, idp.ErrUnknownProvider) {
+		t.Errorf("expected unknown provider got %v", err)
+	}
+
+	_, err = idp.NewRegistry(map[string][]*idp.Config{
+		"p1": {{Name: "bad", Type: "saml"}},
+	}, nil)
+	if err == nil {
+		t.Error("expected error for unsupported provider type")
+	}
+}
+
+func TestGitHubVerify(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/applications/app/token", func(w http.ResponseWriter, r *http.Request) {
+		id, secret, _ := r.BasicAuth()
+		body := map[string]string{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if id != "app" || secret != "secret" || body["access_token"] != "good" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"user": map[string]interface{}{"id": 42, "login": "octocat"},
+		})
+	})
+	mux.HandleFunc("/user/emails", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]map[string]interface{}{
+			{"email": "old@example.com", "primary": false, "verified": true},
+			{"email": "octocat@example.com", "primary": true, "verified": true},
+		})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	p, err := idp.New(&idp.Config{
+		Name: "github", Type: idp.TypeGitHub, ClientIDs: []string{"app"}, ClientSecret: "secret", APIURL: server.URL,
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	identity, err := p.Verify(context.Background(), "good")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity.Subject != "42" || identity.Names != "octocat" || identity.Email != "octocat@example.com" {
+		t.Errorf("unexpected identity %+v", identity)
+	}
+
+	if _, err := p.Verify(context.Background(), "bad"); !errors.Is(err, idp.ErrInvalidToken) {
+		t.Errorf("expected invalid token got %v", err)
+	}
+}
@@ -0,0 +1,225 @@
+package idp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gidyon/services/internal/pkg/jwks"
+)
+
+const (
+	keysMaxAge = time.Hour
+	// Unknown key ids refresh the key set at most this often
+	keysMinRefresh = time.Minute
+	// Microsoft multi-tenant issuers contain this placeholder for the tid claim
+	tenantPlaceholder = "{tenantid}"
+)
+
+// OIDC verifies ID tokens of an OpenID Connect provider against its JWKS
+type OIDC struct {
+	name       string
+	issuer     string
+	jwksURL    string
+	audiences  []string
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	keys      *jwks.Set
+	fetchedAt time.Time
+}
+
+// NewOIDC creates an OpenID Connect provider. The JWKS url is discovered from
+// the issuer on first use unless configured.
+func NewOIDC(cfg *Config, httpClient *http.Client) (*OIDC, error) {
+	switch {
+	case cfg.Issuer == "":
+		return nil, fmt.Errorf("idp: issuer of provider %s is required", cfg.Name)
+	case len(cfg.ClientIDs) == 0:
+		return nil, fmt.Errorf("idp: client ids of provider %s are required", cfg.Name)
+	}
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &OIDC{
+		name:       cfg.Name,
+		issuer:     strings.TrimSuffix(cfg.Issuer, "/"),
+		jwksURL:    cfg.JWKSURL,
+		audiences:  cfg.ClientIDs,
+		httpClient: httpClient,
+	}, nil
+}
+
+// Name of the provider
+func (p *OIDC) Name() string {
+	return p.name
+}
+
+func (p *OIDC) getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("idp: GET %s returned %s", url, res.Status)
+	}
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+// returns the key set, fetching it when stale or when it lacks kid
+func (p *OIDC) keySet(ctx context.Context, kid string) (*jwks.Set, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	age := time.Since(p.fetchedAt)
+	if p.keys != nil && age < keysMaxAge {
+		if _, err := p.keys.Key(kid); err == nil || age < keysMinRefresh {
+			return p.keys, nil
+		}
+	}
+
+	if p.jwksURL == "" {
+		discovery := struct {
+			Issuer  string `json:"issuer"`
+			JWKSURI string `json:"jwks_uri"`
+		}{}
+		err := p.getJSON(ctx, p.issuer+"/.well-known/openid-configuration", &discovery)
+		if err != nil {
+			return nil, fmt.Errorf("idp: discovery of %s failed: %w", p.name, err)
+		}
+		if discovery.JWKSURI == "" {
+			return nil, fmt.Errorf("idp: discovery of %s has no jwks_uri", p.name)
+		}
+		p.jwksURL = discovery.JWKSURI
+		// Multi-tenant issuers are only known from the discovery document
+		if strings.Contains(discovery.Issuer, tenantPlaceholder) {
+			p.issuer = discovery.Issuer
+		}
+	}
+
+	keys := &jwks.Set{}
+	err := p.getJSON(ctx, p.jwksURL, keys)
+	if err != nil {
+		if p.keys != nil {
+			return p.keys, nil
+		}
+		return nil, fmt.Errorf("idp: failed to fetch keys of %s: %w", p.name, err)
+	}
+
+	p.keys = keys
+	p.fetchedAt = time.Now()
+
+	return keys, nil
+}
+
+func (p *OIDC) expectedIssuer(claims jwt.MapClaims) string {
+	p.mu.Lock()
+	issuer := p.issuer
+	p.mu.Unlock()
+	if tid, ok := claims["tid"].(string); ok && tid != "" {
+		issuer = strings.Replace(issuer, tenantPlaceholder, tid, 1)
+	}
+	return issuer
+}
+
+// the aud claim is either a string or an array of strings
+func (p *OIDC) audienceAllowed(claims jwt.MapClaims) bool {
+	var auds []string
+	switch v := claims["aud"].(type) {
+	case string:
+		auds = []string{v}
+	case []interface{}:
+		for _, aud := range v {
+			if s, ok := aud.(string); ok {
+				auds = append(auds, s)
+			}
+		}
+	}
+	for _, aud := range auds {
+		for _, allowed := range p.audiences {
+			if aud == allowed {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// claims may encode booleans as strings
+func boolClaim(claims jwt.MapClaims, name string) bool {
+	switch v := claims[name].(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	default:
+		return false
+	}
+}
+
+func stringClaim(claims jwt.MapClaims, name string) string {
+	v, _ := claims[name].(string)
+	return v
+}
+
+// Verify checks the signature, issuer, audience and lifetime of an ID token
+func (p *OIDC) Verify(ctx context.Context, token string) (*Identity, error) {
+	claims := jwt.MapClaims{}
+	parser := &jwt.Parser{ValidMethods: []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}}
+
+	_, err := parser.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		keys, err := p.keySet(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		return keys.Key(kid)
+	})
+	if err != nil {
+		var verr *jwt.ValidationError
+		if errors.As(err, &verr) && verr.Errors&jwt.ValidationErrorUnverifiable != 0 &&
+			verr.Inner != nil && !errors.Is(verr.Inner, jwks.ErrKeyNotFound) {
+			// Keys of the provider could not be fetched
+			return nil, verr.Inner
+		}
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	switch {
+	case stringClaim(claims, "iss") != p.expectedIssuer(claims):
+		return nil, fmt.Errorf("%w: unexpected issuer %q", ErrInvalidToken, stringClaim(claims, "iss"))
+	case !p.audienceAllowed(claims):
+		return nil, fmt.Errorf("%w: token not issued to an allowed client", ErrInvalidToken)
+	case !claims.VerifyExpiresAt(time.Now().Unix(), true):
+		return nil, fmt.Errorf("%w: token has no expiry", ErrInvalidToken)
+	case stringClaim(claims, "sub") == "":
+		return nil, fmt.Errorf("%w: token has no subject", ErrInvalidToken)
+	}
+
+	identity := &Identity{
+		Provider:      p.name,
+		Subject:       stringClaim(claims, "sub"),
+		Names:         stringClaim(claims, "name"),
+		Email:         stringClaim(claims, "email"),
+		EmailVerified: boolClaim(claims, "email_verified"),
+		Phone:         stringClaim(claims, "phone_number"),
+		PhoneVerified: boolClaim(claims, "phone_number_verified"),
+		Picture:       stringClaim(claims, "picture"),
+	}
+	if identity.Names == "" {
+		identity.Names = strings.TrimSpace(stringClaim(claims, "given_name") + " " + stringClaim(claims, "family_name"))
+	}
+
+	return identity, nil
+}
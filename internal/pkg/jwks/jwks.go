@@ -0,0 +1,119 @@
+// Package jwks converts between public keys and JSON Web Key Sets (RFC 7517)
+package jwks
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrKeyNotFound is returned when a key set has no key with the requested id
+var ErrKeyNotFound = errors.New("jwks: key not found")
+
+// Key is a public JSON Web Key
+type Key struct {
+	Kty string `json:"kty"`
+	Use string `json:"use,omitempty"`
+	Kid string `json:"kid,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	// RSA keys
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Elliptic curve keys
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// Set is a JSON Web Key Set
+type Set struct {
+	Keys []Key `json:"keys"`
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decode(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// NewRSAKey returns the JSON Web Key of an RSA public key used for RS256 signatures
+func NewRSAKey(kid string, pub *rsa.PublicKey) Key {
+	return Key{
+		Kty: "RSA",
+		Use: "sig",
+		Kid: kid,
+		Alg: "RS256",
+		N:   encode(pub.N.Bytes()),
+		E:   encode(big.NewInt(int64(pub.E)).Bytes()),
+	}
+}
+
+// PublicKey returns the RSA or ECDSA public key of k
+func (k Key) PublicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decode(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("jwks: bad modulus of key %s: %w", k.Kid, err)
+		}
+		e, err := decode(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("jwks: bad exponent of key %s: %w", k.Kid, err)
+		}
+		if n.Sign() == 0 || !e.IsInt64() || e.Int64() < 3 {
+			return nil, fmt.Errorf("jwks: invalid rsa key %s", k.Kid)
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("jwks: unsupported curve %q of key %s", k.Crv, k.Kid)
+		}
+		x, err := decode(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("jwks: bad x coordinate of key %s: %w", k.Kid, err)
+		}
+		y, err := decode(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("jwks: bad y coordinate of key %s: %w", k.Kid, err)
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("jwks: key %s is not on curve %s", k.Kid, k.Crv)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("jwks: unsupported key type %q of key %s", k.Kty, k.Kid)
+	}
+}
+
+// Key returns the public key with the given id. The only key of a set is
+// returned for an empty id since some issuers omit the kid header.
+func (s *Set) Key(kid string) (crypto.PublicKey, error) {
+	for _, k := range s.Keys {
+		if k.Kid == kid {
+			return k.PublicKey()
+		}
+	}
+	if len(s.Keys) == 1 && kid == "" {
+		return s.Keys[0].PublicKey()
+	}
+	return nil, ErrKeyNotFound
+}
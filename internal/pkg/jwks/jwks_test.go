@@ -0,0 +1,69 @@
+package jwks
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestRSAKeyRoundTrip(t *testing.T) {
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bs, err := json.Marshal(&Set{Keys: []Key{NewRSAKey("k1", &priv.PublicKey)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	set := &Set{}
+	if err := json.Unmarshal(bs, set); err != nil {
+		t.Fatal(err)
+	}
+
+	pub, err := set.Key("k1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaPub, ok := pub.(*rsa.PublicKey)
+	if !ok || rsaPub.N.Cmp(priv.N) != 0 || rsaPub.E != priv.E {
+		t.Errorf("unexpected public key %v", pub)
+	}
+
+	if _, err := set.Key(""); err != nil {
+		t.Errorf("expected only key for empty kid: %v", err)
+	}
+	if _, err := set.Key("k2"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("expected key not found got %v", err)
+	}
+}
+
+func TestECKey(t *testing.T) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	k := Key{Kty: "EC", Kid: "ec", Crv: "P-256", X: encode(priv.X.Bytes()), Y: encode(priv.Y.Bytes())}
+	pub, err := k.PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ecPub, ok := pub.(*ecdsa.PublicKey); !ok || ecPub.X.Cmp(priv.X) != 0 {
+		t.Errorf("unexpected public key %v", pub)
+	}
+
+	k.Y = encode([]byte{1})
+	if _, err := k.PublicKey(); err == nil {
+		t.Error("expected error for point not on curve")
+	}
+
+	if _, err := (Key{Kty: "oct"}).PublicKey(); err == nil {
+		t.Error("expected error for unsupported key type")
+	}
+}
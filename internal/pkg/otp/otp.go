@@ -0,0 +1,168 @@
+// Package otp generates and verifies one time passwords according to per project policies
+package otp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"time"
+)
+
+// Channels through which OTPs are delivered
+const (
+	ChannelSMS   = "sms"
+	ChannelEmail = "email"
+	ChannelPush  = "push"
+	ChannelCall  = "call"
+)
+
+// DefaultPolicy is the name of the policy for projects without their own policy
+const DefaultPolicy = "default"
+
+// Digits is the alphabet of numeric OTPs
+const Digits = "0123456789"
+
+const (
+	minLength = 4
+	maxLength = 12
+)
+
+// Policy configures how OTPs of a project are generated and delivered
+type Policy struct {
+	// Number of characters in an OTP
+	Length int `json:"length,omitempty"`
+	// Characters OTPs are made of
+	Alphabet string `json:"alphabet,omitempty"`
+	// Seconds an OTP stays valid
+	TTLSeconds int `json:"ttl_seconds,omitempty"`
+	// Channels tried in order until an OTP is delivered
+	Channels []string `json:"channels,omitempty"`
+}
+
+// Default is used for values missing from a policy
+var Default = Policy{
+	Length:     6,
+	Alphabet:   Digits,
+	TTLSeconds: 300,
+	Channels:   []string{ChannelSMS},
+}
+
+// WithDefaults returns a copy of the policy with missing values set from Default
+func (p *Policy) WithDefaults() *Policy {
+	out := Default
+	if p == nil {
+		return &out
+	}
+	if p.Length > 0 {
+		out.Length = p.Length
+	}
+	if p.Alphabet != "" {
+		out.Alphabet = p.Alphabet
+	}
+	if p.TTLSeconds > 0 {
+		out.TTLSeconds = p.TTLSeconds
+	}
+	if len(p.Channels) > 0 {
+		out.Channels = p.Channels
+	}
+	return &out
+}
+
+// TTL is how long an OTP stays valid
+func (p *Policy) TTL() time.Duration {
+	return time.Duration(p.TTLSeconds) * time.Second
+}
+
+// Validate checks that OTPs of the policy can be generated and delivered
+func (p *Policy) Validate() error {
+	if p.Length != 0 && (p.Length < minLength || p.Length > maxLength) {
+		return fmt.Errorf("length must be between %d and %d", minLength, maxLength)
+	}
+
+	seen := make(map[rune]struct{})
+	for _, r := range p.Alphabet {
+		if _, ok := seen[r]; ok {
+			return fmt.Errorf("alphabet has repeated character %q", r)
+		}
+		seen[r] = struct{}{}
+	}
+	if p.Alphabet != "" && len(seen) < 2 {
+		return fmt.Errorf("alphabet must have at least 2 characters")
+	}
+
+	for _, channel := range p.Channels {
+		switch channel {
+		case ChannelSMS, ChannelEmail, ChannelPush, ChannelCall:
+		default:
+			return fmt.Errorf("unknown channel %q", channel)
+		}
+	}
+
+	return nil
+}
+
+// Generate returns a random OTP using a cryptographically secure source
+func (p *Policy) Generate() (string, error) {
+	var (
+		alphabet = []rune(p.Alphabet)
+		max      = big.NewInt(int64(len(alphabet)))
+		otp      = make([]rune, p.Length)
+	)
+	if len(alphabet) < 2 {
+		return "", fmt.Errorf("alphabet must have at least 2 characters")
+	}
+	for i := range otp {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		otp[i] = alphabet[n.Int64()]
+	}
+	return string(otp), nil
+}
+
+// Hash returns the keyed hash of an OTP issued to subject. Only hashes are stored so that OTPs cannot be
+// read from storage; the key keeps short OTPs from being recovered by hashing every candidate.
+func Hash(key []byte, subject, otp string) string {
+	mac := hmac.New(sha256.New, key)
+	fmt.Fprintf(mac, "%s|%s", subject, otp)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether otp matches the hash issued to subject
+func Verify(key []byte, subject, otp, hash string) bool {
+	return hmac.Equal([]byte(Hash(key, subject, otp)), []byte(hash))
+}
+
+// LoadPolicies reads a JSON file mapping project ids to policies. The policy
+// named "default" applies to projects without their own policy.
+func LoadPolicies(file string) (map[string]*Policy, error) {
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read otp policies: %w", err)
+	}
+
+	policies := make(map[string]*Policy)
+	err = json.Unmarshal(bs, &policies)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse otp policies: %w", err)
+	}
+
+	for project, policy := range policies {
+		if policy == nil {
+			delete(policies, project)
+			continue
+		}
+		err = policy.Validate()
+		if err != nil {
+			return nil, fmt.Errorf("otp policy %s: %w", project, err)
+		}
+	}
+
+	return policies, nil
+}
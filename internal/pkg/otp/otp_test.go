@@ -0,0 +1,116 @@
+package otp
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithDefaults(t *testing.T) {
+	p := (*Policy)(nil).WithDefaults()
+	if p.Length != 6 || p.Alphabet != Digits || p.TTL() != 5*time.Minute || p.Channels[0] != ChannelSMS {
+		t.Fatalf("unexpected defaults %+v", p)
+	}
+
+	p = (&Policy{Length: 8, Channels: []string{ChannelEmail, ChannelSMS}}).WithDefaults()
+	if p.Length != 8 || p.Alphabet != Digits || len(p.Channels) != 2 {
+		t.Fatalf("unexpected policy %+v", p)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	p := (&Policy{Length: 8, Alphabet: "ABC"}).WithDefaults()
+
+	seen := make(map[string]struct{})
+	for i := 0; i < 50; i++ {
+		code, err := p.Generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(code) != 8 {
+			t.Fatalf("expected 8 characters, got %q", code)
+		}
+		if strings.Trim(code, "ABC") != "" {
+			t.Fatalf("code %q has characters outside the alphabet", code)
+		}
+		seen[code] = struct{}{}
+	}
+	if len(seen) < 40 {
+		t.Fatalf("expected mostly unique codes, got %d of 50", len(seen))
+	}
+
+	_, err := (&Policy{Length: 6, Alphabet: "A"}).Generate()
+	if err == nil {
+		t.Fatal("expected error for single character alphabet")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, p := range []*Policy{
+		{Length: 2},
+		{Length: 20},
+		{Alphabet: "AA"},
+		{Alphabet: "A"},
+		{Channels: []string{"pigeon"}},
+	} {
+		if p.Validate() == nil {
+			t.Errorf("expected policy %+v to be invalid", p)
+		}
+	}
+
+	p := &Policy{Length: 8, Alphabet: "0123456789ABCDEF", Channels: []string{ChannelPush, ChannelCall}}
+	if err := p.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHashAndVerify(t *testing.T) {
+	key := []byte("key")
+
+	hash := Hash(key, "1", "123456")
+	if strings.Contains(hash, "123456") {
+		t.Fatal("hash contains the otp")
+	}
+	if !Verify(key, "1", "123456", hash) {
+		t.Fatal("expected otp to match its hash")
+	}
+	if Verify(key, "2", "123456", hash) {
+		t.Fatal("expected otp of another subject not to match")
+	}
+	if Verify(key, "1", "654321", hash) {
+		t.Fatal("expected another otp not to match")
+	}
+	if Verify([]byte("other"), "1", "123456", hash) {
+		t.Fatal("expected otp hashed with another key not to match")
+	}
+}
+
+func TestLoadPolicies(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "otp.json")
+	err := ioutil.WriteFile(file, []byte(`{
+		"default": {"length": 6},
+		"test": {"length": 8, "alphabet": "0123456789ABCDEF", "ttl_seconds": 600, "channels": ["email", "sms"]}
+	}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policies, err := LoadPolicies(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if policies[DefaultPolicy].Length != 6 || policies["test"].TTLSeconds != 600 || policies["test"].Channels[0] != ChannelEmail {
+		t.Fatalf("unexpected policies %+v", policies)
+	}
+
+	err = ioutil.WriteFile(file, []byte(`{"test": {"channels": ["fax"]}}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = LoadPolicies(file); err == nil {
+		t.Fatal("expected error for unknown channel")
+	}
+}
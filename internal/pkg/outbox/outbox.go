@@ -0,0 +1,198 @@
+// Package outbox saves events in the transaction of the change they describe and relays them to an event bus.
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gidyon/services/internal/pkg/eventbus"
+	"github.com/gidyon/services/internal/pkg/sqldb"
+	"google.golang.org/grpc/grpclog"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const outboxTable = "outbox_messages"
+
+// Message is an event waiting in the outbox to be published
+type Message struct {
+	ID          uint       `gorm:"primaryKey;autoIncrement"`
+	Topic       string     `gorm:"index;type:varchar(50);not null"`
+	EventKey    string     `gorm:"type:varchar(50);not null"`
+	EventType   string     `gorm:"type:varchar(50);not null"`
+	Payload     []byte     `gorm:"not null"`
+	CreatedAt   time.Time  `gorm:"precision:6;not null;autoCreateTime"`
+	PublishedAt *time.Time `gorm:"index;precision:6"`
+}
+
+// TableName is the name of the table
+func (*Message) TableName() string {
+	return outboxTable
+}
+
+// Migrate creates the outbox table when it does not exist
+func Migrate(db *gorm.DB) error {
+	if db.Migrator().HasTable(outboxTable) {
+		return nil
+	}
+	return db.AutoMigrate(&Message{})
+}
+
+// Add saves an event of the topic using tx so that it is published only when tx commits
+func Add(tx *gorm.DB, topic, key, eventType string, payload []byte) error {
+	return tx.Create(&Message{
+		Topic:     topic,
+		EventKey:  key,
+		EventType: eventType,
+		Payload:   payload,
+	}).Error
+}
+
+// RelayOptions contains parameters of a relay
+type RelayOptions struct {
+	DB     *gorm.DB
+	Bus    eventbus.Bus
+	Logger grpclog.LoggerV2
+	// Period between checks for new messages
+	Interval time.Duration
+	// Number of messages published at once
+	BatchSize int
+	// Period published messages are kept for
+	Retention time.Duration
+}
+
+func (opt *RelayOptions) withDefaults() *RelayOptions {
+	res := *opt
+	if res.Interval <= 0 {
+		res.Interval = time.Second
+	}
+	if res.BatchSize <= 0 {
+		res.BatchSize = 100
+	}
+	if res.Retention <= 0 {
+		res.Retention = 24 * time.Hour
+	}
+	return &res
+}
+
+// Relay publishes messages of the outbox to the bus in the order they were saved
+type Relay struct {
+	opt *RelayOptions
+}
+
+// NewRelay creates a relay of the outbox in the database
+func NewRelay(opt *RelayOptions) (*Relay, error) {
+	switch {
+	case opt == nil:
+		return nil, errors.New("missing relay options")
+	case opt.DB == nil:
+		return nil, errors.New("missing relay database")
+	case opt.Bus == nil:
+		return nil, errors.New("missing relay event bus")
+	case opt.Logger == nil:
+		return nil, errors.New("missing relay logger")
+	}
+	return &Relay{opt: opt.withDefaults()}, nil
+}
+
+// Run relays messages until ctx is done
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.opt.Interval)
+	defer ticker.Stop()
+
+	lastCleanup := time.Time{}
+
+	for {
+		for {
+			published, err := r.RelayBatch(ctx)
+			if err != nil {
+				r.opt.Logger.Errorf("failed to relay outbox messages: %v", err)
+				break
+			}
+			if published < r.opt.BatchSize {
+				break
+			}
+		}
+
+		if time.Since(lastCleanup) > r.opt.Retention/24 {
+			err := r.opt.DB.WithContext(ctx).
+				Where("published_at<?", time.Now().Add(-r.opt.Retention)).
+				Delete(&Message{}).Error
+			if err != nil {
+				r.opt.Logger.Errorf("failed to delete published outbox messages: %v", err)
+			}
+			lastCleanup = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayBatch publishes the oldest unpublished messages and returns how many were published.
+// The messages are locked until they are marked published so that relays of other instances do not publish them.
+// Messages published before a failure to mark them are published again.
+func (r *Relay) RelayBatch(ctx context.Context) (int, error) {
+	published := 0
+
+	err := r.opt.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		db := tx.Where("published_at IS NULL").Order("id").Limit(r.opt.BatchSize)
+		if sqldb.Dialect(tx) != sqldb.SQLite {
+			db = db.Clauses(clause.Locking{Strength: "UPDATE"})
+		}
+
+		dbs := make([]*Message, 0, r.opt.BatchSize)
+		err := db.Find(&dbs).Error
+		if err != nil {
+			return fmt.Errorf("failed to find outbox messages: %w", err)
+		}
+		if len(dbs) == 0 {
+			return nil
+		}
+
+		// Messages of a topic are published in one call to keep their order
+		var (
+			topics = make([]string, 0, 1)
+			msgs   = make(map[string][]*eventbus.Message)
+			ids    = make([]uint, 0, len(dbs))
+		)
+		for _, db := range dbs {
+			if _, ok := msgs[db.Topic]; !ok {
+				topics = append(topics, db.Topic)
+			}
+			msgs[db.Topic] = append(msgs[db.Topic], &eventbus.Message{
+				ID:      fmt.Sprint(db.ID),
+				Key:     db.EventKey,
+				Type:    db.EventType,
+				Payload: db.Payload,
+				Time:    db.CreatedAt,
+			})
+			ids = append(ids, db.ID)
+		}
+
+		for _, topic := range topics {
+			err = r.opt.Bus.Publish(ctx, topic, msgs[topic]...)
+			if err != nil {
+				return fmt.Errorf("failed to publish to %s: %w", topic, err)
+			}
+		}
+
+		err = tx.Model(&Message{}).Where("id IN (?)", ids).Update("published_at", time.Now()).Error
+		if err != nil {
+			return fmt.Errorf("failed to mark outbox messages published: %w", err)
+		}
+
+		published = len(dbs)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return published, nil
+}
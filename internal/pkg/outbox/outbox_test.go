@@ -0,0 +1,128 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gidyon/micro/v2"
+	"github.com/gidyon/services/internal/pkg/eventbus"
+	"github.com/gidyon/services/internal/pkg/sqldb"
+	"gorm.io/gorm"
+)
+
+type failingBus struct {
+	eventbus.Bus
+}
+
+func (failingBus) Publish(context.Context, string, ...*eventbus.Message) error {
+	return errors.New("unavailable")
+}
+
+func openTestDB(t *testing.T) *gorm.DB {
+	db, err := sqldb.Open(&sqldb.Options{
+		Dialect: sqldb.SQLite,
+		Schema:  filepath.Join(t.TempDir(), "outbox.db"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = Migrate(db); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func newTestRelay(t *testing.T, db *gorm.DB, bus eventbus.Bus) *Relay {
+	relay, err := NewRelay(&RelayOptions{
+		DB:        db,
+		Bus:       bus,
+		Logger:    micro.NewLogger("outbox", 0),
+		BatchSize: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return relay
+}
+
+func TestRelayBatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db := openTestDB(t)
+	bus := eventbus.NewMemory()
+
+	handled := make(chan *eventbus.Message, 10)
+	go bus.Subscribe(ctx, "accounts", "test", "consumer", func(ctx context.Context, msg *eventbus.Message) error {
+		handled <- msg
+		return nil
+	})
+	// Let the subscription create its group
+	time.Sleep(20 * time.Millisecond)
+
+	// Messages of rolled back transactions are not saved
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := Add(tx, "accounts", "1", "created", []byte("rolled back")); err != nil {
+			return err
+		}
+		return errors.New("rollback")
+	})
+	if err == nil {
+		t.Fatal("expected rollback")
+	}
+
+	for i, eventType := range []string{"created", "updated", "deleted"} {
+		if err = Add(db, "accounts", "1", eventType, []byte(fmt.Sprint(i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Nothing is marked published when the bus fails
+	if _, err = newTestRelay(t, db, failingBus{}).RelayBatch(ctx); err == nil {
+		t.Fatal("expected publish error")
+	}
+
+	relay := newTestRelay(t, db, bus)
+	for _, want := range []int{2, 1, 0} {
+		published, err := relay.RelayBatch(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if published != want {
+			t.Errorf("RelayBatch() = %d; want %d", published, want)
+		}
+	}
+
+	for _, want := range []string{"created", "updated", "deleted"} {
+		msg := <-handled
+		if msg.Type != want || msg.Key != "1" {
+			t.Errorf("got %s event of %s; want %s event of 1", msg.Type, msg.Key, want)
+		}
+	}
+
+	var unpublished int64
+	if err = db.Model(&Message{}).Where("published_at IS NULL").Count(&unpublished).Error; err != nil {
+		t.Fatal(err)
+	}
+	if unpublished != 0 {
+		t.Errorf("%d messages not marked published", unpublished)
+	}
+}
+
+func TestNewRelay(t *testing.T) {
+	db := openTestDB(t)
+	for _, opt := range []*RelayOptions{
+		nil,
+		{Bus: eventbus.NewMemory(), Logger: micro.NewLogger("outbox", 0)},
+		{DB: db, Logger: micro.NewLogger("outbox", 0)},
+		{DB: db, Bus: eventbus.NewMemory()},
+	} {
+		if _, err := NewRelay(opt); err == nil {
+			t.Errorf("NewRelay(%+v) expected error", opt)
+		}
+	}
+}
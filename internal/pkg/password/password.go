@@ -0,0 +1,276 @@
+// Package password hashes and verifies passwords using argon2id or bcrypt
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Supported algorithms
+const (
+	Argon2id = "argon2id"
+	Bcrypt   = "bcrypt"
+)
+
+// ErrMismatch is returned when a password does not match its hash
+var ErrMismatch = errors.New("password does not match")
+
+// ErrUnknownAlgorithm is returned when a hash was not produced by a supported algorithm
+var ErrUnknownAlgorithm = errors.New("unknown password hash algorithm")
+
+// Hasher hashes passwords with the configured algorithm and verifies hashes of any supported algorithm
+type Hasher interface {
+	// Hash returns the encoded hash of password, prefixed with its algorithm
+	Hash(password string) (string, error)
+	// Compare checks that password matches the encoded hash
+	Compare(hash, password string) error
+	// NeedsRehash reports whether hash was produced with a different algorithm or parameters
+	NeedsRehash(hash string) bool
+}
+
+// Argon2idParams are parameters for argon2id hashing
+type Argon2idParams struct {
+	// Memory in KiB
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// Options contain parameters for NewHasher
+type Options struct {
+	// Algorithm for new hashes, either argon2id or bcrypt
+	Algorithm  string
+	Argon2id   Argon2idParams
+	BcryptCost int
+}
+
+// DefaultArgon2idParams follow the recommendations of RFC 9106
+var DefaultArgon2idParams = Argon2idParams{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+// Limits on argon2id parameters. Hashes are decoded from storage so the limits keep a tampered hash from
+// making verification use unbounded memory or time, and keep empty salts and keys from being accepted.
+const (
+	maxArgon2idMemory      = 1024 * 1024
+	maxArgon2idIterations  = 32
+	maxArgon2idParallelism = 32
+	minArgon2idSaltLength  = 8
+	minArgon2idKeyLength   = 16
+	maxArgon2idLength      = 1024
+)
+
+// checks that argon2id parameters are within the limits
+func (p Argon2idParams) validate() error {
+	switch {
+	case p.Iterations < 1 || p.Iterations > maxArgon2idIterations:
+		return fmt.Errorf("argon2id iterations %d out of range", p.Iterations)
+	case p.Parallelism < 1 || p.Parallelism > maxArgon2idParallelism:
+		return fmt.Errorf("argon2id parallelism %d out of range", p.Parallelism)
+	case p.Memory < 8*uint32(p.Parallelism) || p.Memory > maxArgon2idMemory:
+		return fmt.Errorf("argon2id memory %d out of range", p.Memory)
+	case p.SaltLength < minArgon2idSaltLength || p.SaltLength > maxArgon2idLength:
+		return fmt.Errorf("argon2id salt length %d out of range", p.SaltLength)
+	case p.KeyLength < minArgon2idKeyLength || p.KeyLength > maxArgon2idLength:
+		return fmt.Errorf("argon2id key length %d out of range", p.KeyLength)
+	}
+	return nil
+}
+
+type hasher struct {
+	algorithm  string
+	argon2id   Argon2idParams
+	bcryptCost int
+}
+
+// NewHasher creates a password hasher. Missing values are set to their defaults.
+func NewHasher(opt *Options) (Hasher, error) {
+	if opt == nil {
+		opt = &Options{}
+	}
+
+	h := &hasher{
+		algorithm:  strings.ToLower(opt.Algorithm),
+		argon2id:   opt.Argon2id,
+		bcryptCost: opt.BcryptCost,
+	}
+
+	switch h.algorithm {
+	case "":
+		h.algorithm = Argon2id
+	case Argon2id, Bcrypt:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownAlgorithm, opt.Algorithm)
+	}
+
+	if h.argon2id.Memory == 0 {
+		h.argon2id.Memory = DefaultArgon2idParams.Memory
+	}
+	if h.argon2id.Iterations == 0 {
+		h.argon2id.Iterations = DefaultArgon2idParams.Iterations
+	}
+	if h.argon2id.Parallelism == 0 {
+		h.argon2id.Parallelism = DefaultArgon2idParams.Parallelism
+	}
+	if h.argon2id.SaltLength == 0 {
+		h.argon2id.SaltLength = DefaultArgon2idParams.SaltLength
+	}
+	if h.argon2id.KeyLength == 0 {
+		h.argon2id.KeyLength = DefaultArgon2idParams.KeyLength
+	}
+	if err := h.argon2id.validate(); err != nil {
+		return nil, err
+	}
+
+	if h.bcryptCost == 0 {
+		h.bcryptCost = bcrypt.DefaultCost
+	}
+	if h.bcryptCost < bcrypt.MinCost || h.bcryptCost > bcrypt.MaxCost {
+		return nil, fmt.Errorf("bcrypt cost %d out of range", h.bcryptCost)
+	}
+
+	return h, nil
+}
+
+func (h *hasher) Hash(password string) (string, error) {
+	if h.algorithm == Bcrypt {
+		bs, err := bcrypt.GenerateFromPassword([]byte(password), h.bcryptCost)
+		if err != nil {
+			return "", err
+		}
+		return string(bs), nil
+	}
+
+	salt := make([]byte, h.argon2id.SaltLength)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return "", err
+	}
+
+	p := h.argon2id
+	key := argon2.IDKey([]byte(password), salt, p.Iterations, p.Memory, p.Parallelism, p.KeyLength)
+
+	return encodeArgon2id(p, salt, key), nil
+}
+
+func (h *hasher) Compare(hash, password string) error {
+	switch algorithm(hash) {
+	case Argon2id:
+		p, salt, key, err := decodeArgon2id(hash)
+		if err != nil {
+			return err
+		}
+		other := argon2.IDKey([]byte(password), salt, p.Iterations, p.Memory, p.Parallelism, uint32(len(key)))
+		if subtle.ConstantTimeCompare(key, other) != 1 {
+			return ErrMismatch
+		}
+		return nil
+	case Bcrypt:
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return ErrMismatch
+		}
+		return err
+	default:
+		return ErrUnknownAlgorithm
+	}
+}
+
+func (h *hasher) NeedsRehash(hash string) bool {
+	alg := algorithm(hash)
+	if alg != h.algorithm {
+		return true
+	}
+
+	switch alg {
+	case Argon2id:
+		p, salt, key, err := decodeArgon2id(hash)
+		if err != nil {
+			return true
+		}
+		return p.Memory != h.argon2id.Memory ||
+			p.Iterations != h.argon2id.Iterations ||
+			p.Parallelism != h.argon2id.Parallelism ||
+			uint32(len(salt)) != h.argon2id.SaltLength ||
+			uint32(len(key)) != h.argon2id.KeyLength
+	default:
+		cost, err := bcrypt.Cost([]byte(hash))
+		return err != nil || cost != h.bcryptCost
+	}
+}
+
+// algorithm returns the algorithm a hash was produced with
+func algorithm(hash string) string {
+	switch {
+	case strings.HasPrefix(hash, "$argon2id$"):
+		return Argon2id
+	case strings.HasPrefix(hash, "$2a$"), strings.HasPrefix(hash, "$2b$"), strings.HasPrefix(hash, "$2y$"):
+		return Bcrypt
+	default:
+		return ""
+	}
+}
+
+var b64 = base64.RawStdEncoding
+
+// encodes in the PHC string format $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>
+func encodeArgon2id(p Argon2idParams, salt, key []byte) string {
+	return fmt.Sprintf(
+		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, p.Memory, p.Iterations, p.Parallelism, b64.EncodeToString(salt), b64.EncodeToString(key),
+	)
+}
+
+func decodeArgon2id(hash string) (p Argon2idParams, salt, key []byte, err error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return p, nil, nil, errors.New("malformed argon2id hash")
+	}
+
+	var version int
+	_, err = fmt.Sscanf(parts[2], "v=%d", &version)
+	if err != nil {
+		return p, nil, nil, fmt.Errorf("malformed argon2id version: %w", err)
+	}
+	if version != argon2.Version {
+		return p, nil, nil, fmt.Errorf("unsupported argon2id version %d", version)
+	}
+
+	_, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Iterations, &p.Parallelism)
+	if err != nil {
+		return p, nil, nil, fmt.Errorf("malformed argon2id parameters: %w", err)
+	}
+
+	salt, err = b64.DecodeString(parts[4])
+	if err != nil {
+		return p, nil, nil, fmt.Errorf("malformed argon2id salt: %w", err)
+	}
+
+	key, err = b64.DecodeString(parts[5])
+	if err != nil {
+		return p, nil, nil, fmt.Errorf("malformed argon2id key: %w", err)
+	}
+
+	p.SaltLength = uint32(len(salt))
+	p.KeyLength = uint32(len(key))
+
+	err = p.validate()
+	if err != nil {
+		return p, nil, nil, fmt.Errorf("malformed argon2id hash: %w", err)
+	}
+
+	return p, salt, key, nil
+}
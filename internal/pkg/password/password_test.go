@@ -0,0 +1,147 @@
+package password
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var testArgon2id = Argon2idParams{Memory: 1024, Iterations: 1, Parallelism: 1}
+
+func TestHashAndCompare(t *testing.T) {
+	for _, alg := range []string{Argon2id, Bcrypt} {
+		h, err := NewHasher(&Options{Algorithm: alg, Argon2id: testArgon2id, BcryptCost: bcrypt.MinCost})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		hash, err := h.Hash("hakty11")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if alg == Argon2id && !strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$") {
+			t.Errorf("unexpected argon2id hash %s", hash)
+		}
+
+		if err := h.Compare(hash, "hakty11"); err != nil {
+			t.Errorf("%s: expected password to match: %v", alg, err)
+		}
+		if err := h.Compare(hash, "hakty12"); !errors.Is(err, ErrMismatch) {
+			t.Errorf("%s: expected mismatch got %v", alg, err)
+		}
+		if h.NeedsRehash(hash) {
+			t.Errorf("%s: hash with current parameters should not need rehash", alg)
+		}
+	}
+}
+
+func TestCompareAcrossAlgorithms(t *testing.T) {
+	legacy, err := bcrypt.GenerateFromPassword([]byte("hakty11"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h, err := NewHasher(&Options{Argon2id: testArgon2id})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := h.Compare(string(legacy), "hakty11"); err != nil {
+		t.Errorf("expected legacy bcrypt hash to match: %v", err)
+	}
+	if !h.NeedsRehash(string(legacy)) {
+		t.Error("expected legacy bcrypt hash to need rehash")
+	}
+	if err := h.Compare("plain", "plain"); !errors.Is(err, ErrUnknownAlgorithm) {
+		t.Errorf("expected unknown algorithm got %v", err)
+	}
+}
+
+func TestNeedsRehashOnParameterChange(t *testing.T) {
+	old, err := NewHasher(&Options{Argon2id: testArgon2id})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hash, err := old.Hash("hakty11")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params := testArgon2id
+	params.Iterations = 2
+	current, err := NewHasher(&Options{Argon2id: params})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !current.NeedsRehash(hash) {
+		t.Error("expected hash with old parameters to need rehash")
+	}
+	if err := current.Compare(hash, "hakty11"); err != nil {
+		t.Errorf("expected hash with old parameters to match: %v", err)
+	}
+}
+
+func TestNewHasherUnknownAlgorithm(t *testing.T) {
+	_, err := NewHasher(&Options{Algorithm: "md5"})
+	if !errors.Is(err, ErrUnknownAlgorithm) {
+		t.Errorf("expected unknown algorithm got %v", err)
+	}
+}
+
+func TestDecodeArgon2id(t *testing.T) {
+	var (
+		salt = b64.EncodeToString([]byte("0123456789abcdef"))
+		key  = b64.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	)
+
+	p, _, _, err := decodeArgon2id("$argon2id$v=19$m=65536,t=3,p=2$" + salt + "$" + key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Memory != 65536 || p.Iterations != 3 || p.Parallelism != 2 || p.SaltLength != 16 || p.KeyLength != 32 {
+		t.Errorf("unexpected parameters %+v", p)
+	}
+
+	for name, hash := range map[string]string{
+		"no iterations":    "$argon2id$v=19$m=65536,t=0,p=2$" + salt + "$" + key,
+		"no parallelism":   "$argon2id$v=19$m=65536,t=3,p=0$" + salt + "$" + key,
+		"huge memory":      "$argon2id$v=19$m=4294967295,t=3,p=2$" + salt + "$" + key,
+		"many iterations":  "$argon2id$v=19$m=65536,t=100000,p=2$" + salt + "$" + key,
+		"many threads":     "$argon2id$v=19$m=65536,t=3,p=255$" + salt + "$" + key,
+		"empty salt":       "$argon2id$v=19$m=65536,t=3,p=2$$" + key,
+		"empty key":        "$argon2id$v=19$m=65536,t=3,p=2$" + salt + "$",
+		"other version":    "$argon2id$v=16$m=65536,t=3,p=2$" + salt + "$" + key,
+		"missing sections": "$argon2id$v=19$m=65536,t=3,p=2$" + salt,
+	} {
+		if _, _, _, err := decodeArgon2id(hash); err == nil {
+			t.Errorf("%s: expected hash %s to be rejected", name, hash)
+		}
+	}
+
+	// Passwords are not compared against hashes that fail to decode
+	h, err := NewHasher(&Options{Argon2id: testArgon2id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := h.Compare("$argon2id$v=19$m=1024,t=1,p=1$"+salt+"$", "hakty11"); err == nil {
+		t.Error("expected hash with empty key not to match")
+	}
+}
+
+func TestNewHasherArgon2idLimits(t *testing.T) {
+	for _, params := range []Argon2idParams{
+		{Memory: maxArgon2idMemory + 1},
+		{Iterations: maxArgon2idIterations + 1},
+		{Parallelism: maxArgon2idParallelism + 1},
+		{KeyLength: 4},
+	} {
+		if _, err := NewHasher(&Options{Argon2id: params}); err == nil {
+			t.Errorf("expected parameters %+v to be rejected", params)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package password
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Policy rules
+const (
+	RuleMinLength = "min_length"
+	RuleUppercase = "uppercase"
+	RuleLowercase = "lowercase"
+	RuleDigit     = "digit"
+	RuleSymbol    = "symbol"
+	RuleBreached  = "breached"
+	RuleHistory   = "history"
+)
+
+// DefaultPolicy is the name of the policy for projects without their own policy
+const DefaultPolicy = "default"
+
+const maxBreachedLineLen = 256
+
+// Policy contains requirements that passwords must satisfy
+type Policy struct {
+	MinLength     int  `json:"min_length,omitempty"`
+	RequireUpper  bool `json:"require_upper,omitempty"`
+	RequireLower  bool `json:"require_lower,omitempty"`
+	RequireDigit  bool `json:"require_digit,omitempty"`
+	RequireSymbol bool `json:"require_symbol,omitempty"`
+	// File with one breached or common password per line
+	BreachedListFile string `json:"breached_list_file,omitempty"`
+	// Number of previous passwords that cannot be reused
+	HistorySize int `json:"history_size,omitempty"`
+	// Days after which a password must be changed; zero means passwords do not expire
+	MaxAgeDays int `json:"max_age_days,omitempty"`
+
+	breached map[string]struct{}
+}
+
+// Violation is a policy rule that a password does not satisfy
+type Violation struct {
+	Rule        string
+	Description string
+}
+
+// Load reads the breached password list of the policy
+func (p *Policy) Load() error {
+	if p.BreachedListFile == "" {
+		return nil
+	}
+
+	f, err := os.Open(p.BreachedListFile)
+	if err != nil {
+		return fmt.Errorf("failed to open breached password list: %w", err)
+	}
+	defer f.Close()
+
+	breached := make(map[string]struct{})
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || len(line) > maxBreachedLineLen {
+			continue
+		}
+		breached[strings.ToLower(line)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read breached password list: %w", err)
+	}
+
+	p.breached = breached
+
+	return nil
+}
+
+// Check returns the rules that password violates; password history is checked by the caller
+func (p *Policy) Check(password string) []Violation {
+	if p == nil {
+		return nil
+	}
+
+	violations := make([]Violation, 0)
+
+	if utf8.RuneCountInString(password) < p.MinLength {
+		violations = append(violations, Violation{
+			Rule:        RuleMinLength,
+			Description: fmt.Sprintf("password must have at least %d characters", p.MinLength),
+		})
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r), unicode.IsSymbol(r), unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+
+	if p.RequireUpper && !upper {
+		violations = append(violations, Violation{Rule: RuleUppercase, Description: "password must contain an uppercase letter"})
+	}
+	if p.RequireLower && !lower {
+		violations = append(violations, Violation{Rule: RuleLowercase, Description: "password must contain a lowercase letter"})
+	}
+	if p.RequireDigit && !digit {
+		violations = append(violations, Violation{Rule: RuleDigit, Description: "password must contain a digit"})
+	}
+	if p.RequireSymbol && !symbol {
+		violations = append(violations, Violation{Rule: RuleSymbol, Description: "password must contain a symbol"})
+	}
+
+	if _, ok := p.breached[strings.ToLower(password)]; ok {
+		violations = append(violations, Violation{
+			Rule:        RuleBreached,
+			Description: "password is too common or has appeared in a data breach",
+		})
+	}
+
+	return violations
+}
+
+// Expired reports whether a password changed at the given time must be changed
+func (p *Policy) Expired(changedAt time.Time) bool {
+	if p == nil || p.MaxAgeDays <= 0 || changedAt.IsZero() {
+		return false
+	}
+	return time.Since(changedAt) > time.Duration(p.MaxAgeDays)*24*time.Hour
+}
+
+// LoadPolicies reads a JSON file mapping project ids to policies. The policy
+// named "default" applies to projects without their own policy.
+func LoadPolicies(file string) (map[string]*Policy, error) {
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read password policies: %w", err)
+	}
+
+	policies := make(map[string]*Policy)
+	err = json.Unmarshal(bs, &policies)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse password policies: %w", err)
+	}
+
+	for project, policy := range policies {
+		if policy == nil {
+			delete(policies, project)
+			continue
+		}
+		err = policy.Load()
+		if err != nil {
+			return nil, fmt.Errorf("password policy %s: %w", project, err)
+		}
+	}
+
+	return policies, nil
+}
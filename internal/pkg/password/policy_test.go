@@ -0,0 +1,93 @@
+package password
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func rules(violations []Violation) map[string]bool {
+	out := make(map[string]bool, len(violations))
+	for _, v := range violations {
+		out[v.Rule] = true
+	}
+	return out
+}
+
+func TestPolicyCheck(t *testing.T) {
+	dir := t.TempDir()
+	list := filepath.Join(dir, "breached.txt")
+	err := ioutil.WriteFile(list, []byte("# common\nPassword1!\nqwerty\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &Policy{
+		MinLength:        8,
+		RequireUpper:     true,
+		RequireLower:     true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+		BreachedListFile: list,
+	}
+	if err := p.Load(); err != nil {
+		t.Fatal(err)
+	}
+
+	got := rules(p.Check("abc"))
+	for _, rule := range []string{RuleMinLength, RuleUppercase, RuleDigit, RuleSymbol} {
+		if !got[rule] {
+			t.Errorf("expected violation of %s", rule)
+		}
+	}
+	if got[RuleLowercase] {
+		t.Error("unexpected lowercase violation")
+	}
+
+	if got := rules(p.Check("passw0rd1!")); !got[RuleUppercase] || got[RuleBreached] {
+		t.Errorf("unexpected violations %v", got)
+	}
+
+	if got := rules(p.Check("PASSWORD1!")); !got[RuleBreached] {
+		t.Error("expected breached violation ignoring case")
+	}
+
+	if got := p.Check("Str0ng&Unique"); len(got) != 0 {
+		t.Errorf("expected no violations got %v", got)
+	}
+
+	var nilPolicy *Policy
+	if got := nilPolicy.Check(""); len(got) != 0 {
+		t.Errorf("nil policy should not have violations got %v", got)
+	}
+}
+
+func TestPolicyExpired(t *testing.T) {
+	p := &Policy{MaxAgeDays: 30}
+	if p.Expired(time.Now().Add(-24 * time.Hour)) {
+		t.Error("password changed yesterday should not be expired")
+	}
+	if !p.Expired(time.Now().Add(-31 * 24 * time.Hour)) {
+		t.Error("password changed 31 days ago should be expired")
+	}
+	if (&Policy{}).Expired(time.Now().Add(-365 * 24 * time.Hour)) {
+		t.Error("password should not expire without max age")
+	}
+}
+
+func TestLoadPolicies(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "policies.json")
+	err := ioutil.WriteFile(file, []byte(`{"default":{"min_length":8},"test":{"min_length":12,"history_size":3}}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policies, err := LoadPolicies(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if policies[DefaultPolicy].MinLength != 8 || policies["test"].HistorySize != 3 {
+		t.Errorf("unexpected policies %+v", policies)
+	}
+}
@@ -0,0 +1,105 @@
+// Package retention contains policies for purging soft deleted accounts.
+package retention
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+)
+
+// Actions applied to accounts once their grace period expires
+const (
+	ActionDelete    = "delete"
+	ActionAnonymise = "anonymise"
+)
+
+// DefaultPolicy is the name of the policy for projects without their own policy
+const DefaultPolicy = "default"
+
+// DefaultGracePeriodDays is the grace period of projects without a policy
+const DefaultGracePeriodDays = 30
+
+// Policy decides when and how soft deleted accounts of a project are purged
+type Policy struct {
+	// Days a deleted account can be restored before it is purged
+	GracePeriodDays int `json:"grace_period_days,omitempty"`
+	// Either delete or anonymise; defaults to delete
+	Action string `json:"action,omitempty"`
+}
+
+// Validate checks the policy and fills in its defaults
+func (p *Policy) Validate() error {
+	if p.GracePeriodDays < 0 {
+		return fmt.Errorf("grace period of %d days is negative", p.GracePeriodDays)
+	}
+	if p.GracePeriodDays == 0 {
+		p.GracePeriodDays = DefaultGracePeriodDays
+	}
+	switch p.Action {
+	case "":
+		p.Action = ActionDelete
+	case ActionDelete, ActionAnonymise:
+	default:
+		return fmt.Errorf("unknown action %q; must be %s or %s", p.Action, ActionDelete, ActionAnonymise)
+	}
+	return nil
+}
+
+// Cutoff returns the time before which deleted accounts are past the grace period
+func (p *Policy) Cutoff(now time.Time) time.Time {
+	return now.Add(-time.Duration(p.GracePeriodDays) * 24 * time.Hour)
+}
+
+// Policies maps project ids to retention policies
+type Policies map[string]*Policy
+
+// Policy returns the policy of a project, falling back to the default policy
+func (ps Policies) Policy(projectID string) *Policy {
+	if p, ok := ps[projectID]; ok {
+		return p
+	}
+	if p, ok := ps[DefaultPolicy]; ok {
+		return p
+	}
+	return &Policy{GracePeriodDays: DefaultGracePeriodDays, Action: ActionDelete}
+}
+
+// Projects returns ids of projects with their own policy
+func (ps Policies) Projects() []string {
+	projects := make([]string, 0, len(ps))
+	for project := range ps {
+		if project != DefaultPolicy {
+			projects = append(projects, project)
+		}
+	}
+	return projects
+}
+
+// LoadPolicies reads a JSON file mapping project ids to policies. The policy
+// named "default" applies to projects without their own policy.
+func LoadPolicies(file string) (Policies, error) {
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read retention policies: %w", err)
+	}
+
+	policies := make(Policies)
+	err = json.Unmarshal(bs, &policies)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse retention policies: %w", err)
+	}
+
+	for project, policy := range policies {
+		if policy == nil {
+			delete(policies, project)
+			continue
+		}
+		err = policy.Validate()
+		if err != nil {
+			return nil, fmt.Errorf("retention policy %s: %w", project, err)
+		}
+	}
+
+	return policies, nil
+}
@@ -0,0 +1,70 @@
+package retention
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+)
+
+func writePolicies(t *testing.T, content string) string {
+	file := filepath.Join(t.TempDir(), "retention.json")
+	if err := ioutil.WriteFile(file, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestLoadPolicies(t *testing.T) {
+	file := writePolicies(t, `{
+		"default": {"grace_period_days": 60},
+		"kenya": {"grace_period_days": 7, "action": "anonymise"},
+		"empty": null
+	}`)
+
+	policies, err := LoadPolicies(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := policies.Policy("kenya"); got.GracePeriodDays != 7 || got.Action != ActionAnonymise {
+		t.Errorf("unexpected project policy %+v", got)
+	}
+	if got := policies.Policy("other"); got.GracePeriodDays != 60 || got.Action != ActionDelete {
+		t.Errorf("unexpected default policy %+v", got)
+	}
+
+	projects := policies.Projects()
+	sort.Strings(projects)
+	if len(projects) != 1 || projects[0] != "kenya" {
+		t.Errorf("unexpected projects %v", projects)
+	}
+}
+
+func TestLoadInvalidPolicies(t *testing.T) {
+	for _, content := range []string{
+		`{"default": {"action": "archive"}}`,
+		`{"default": {"grace_period_days": -1}}`,
+		`[]`,
+	} {
+		if _, err := LoadPolicies(writePolicies(t, content)); err == nil {
+			t.Errorf("expected error for %s", content)
+		}
+	}
+}
+
+func TestPolicyWithoutPolicies(t *testing.T) {
+	var policies Policies
+	if got := policies.Policy("any"); got.GracePeriodDays != DefaultGracePeriodDays || got.Action != ActionDelete {
+		t.Errorf("unexpected policy %+v", got)
+	}
+}
+
+func TestCutoff(t *testing.T) {
+	now := time.Date(2021, 3, 10, 12, 0, 0, 0, time.UTC)
+	p := &Policy{GracePeriodDays: 9}
+	if got, want := p.Cutoff(now), time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("cutoff = %v; want %v", got, want)
+	}
+}
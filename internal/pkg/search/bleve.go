@@ -0,0 +1,196 @@
+package search
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search/query"
+)
+
+const (
+	scopeField     = "_scope"
+	fragmentsField = "_fragments"
+	// Terms shorter than this are not matched by close spellings
+	minFuzzyTermLen = 4
+)
+
+// Boosts rank exact words above prefixes, prefixes above close spellings and those above fragments
+const (
+	matchBoost    = 4
+	prefixBoost   = 2
+	fuzzyBoost    = 1
+	fragmentBoost = 1
+)
+
+type bleveIndex struct {
+	mu     sync.RWMutex
+	index  bleve.Index
+	closed bool
+}
+
+// OpenBleve opens the bleve index at path, creating it when it does not exist
+func OpenBleve(path string) (Index, error) {
+	index, err := bleve.Open(path)
+	if errors.Is(err, bleve.ErrorIndexPathDoesNotExist) {
+		index, err = bleve.New(path, bleveMapping())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &bleveIndex{index: index}, nil
+}
+
+func bleveMapping() mapping.IndexMapping {
+	keywordField := bleve.NewTextFieldMapping()
+	keywordField.Analyzer = keyword.Name
+	keywordField.IncludeInAll = false
+	keywordField.Store = false
+
+	docMapping := bleve.NewDocumentMapping()
+	docMapping.AddFieldMappingsAt(scopeField, keywordField)
+	docMapping.AddFieldMappingsAt(fragmentsField, keywordField)
+
+	indexMapping := bleve.NewIndexMapping()
+	indexMapping.DefaultMapping = docMapping
+	indexMapping.StoreDynamic = false
+	return indexMapping
+}
+
+func (b *bleveIndex) Index(doc *Document) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return ErrClosed
+	}
+
+	data := make(map[string]interface{}, len(doc.Fields)+2)
+	for name, val := range doc.Fields {
+		if val != "" {
+			data[name] = val
+		}
+	}
+	data[scopeField] = doc.Scope
+
+	fragments := make([]string, 0, len(doc.Fragments))
+	for _, fragment := range doc.Fragments {
+		if fragment != "" {
+			fragments = append(fragments, strings.ToLower(fragment))
+		}
+	}
+	data[fragmentsField] = fragments
+
+	return b.index.Index(doc.ID, data)
+}
+
+func (b *bleveIndex) Delete(id string) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return ErrClosed
+	}
+	return b.index.Delete(id)
+}
+
+// returns the part of a term that is searched for within fragments
+func fragment(term string) string {
+	term = strings.Map(func(r rune) rune {
+		switch r {
+		case '*', '?', '\\', '[', ']':
+			return -1
+		}
+		return r
+	}, term)
+
+	// Phone numbers are matched without their trunk prefix or plus sign
+	if strings.IndexFunc(term, func(r rune) bool { return !unicode.IsDigit(r) && r != '+' }) == -1 {
+		term = strings.TrimLeft(term, "+0")
+	}
+	return term
+}
+
+func termQuery(term string) query.Query {
+	match := bleve.NewMatchQuery(term)
+	match.SetBoost(matchBoost)
+
+	prefix := bleve.NewPrefixQuery(term)
+	prefix.SetBoost(prefixBoost)
+
+	alternatives := []query.Query{match, prefix}
+
+	if utf8.RuneCountInString(term) >= minFuzzyTermLen {
+		fuzzy := bleve.NewFuzzyQuery(term)
+		fuzzy.SetFuzziness(1)
+		fuzzy.SetBoost(fuzzyBoost)
+		alternatives = append(alternatives, fuzzy)
+	}
+
+	if part := fragment(term); part != "" {
+		wildcard := bleve.NewWildcardQuery("*" + part + "*")
+		wildcard.SetField(fragmentsField)
+		wildcard.SetBoost(fragmentBoost)
+		alternatives = append(alternatives, wildcard)
+	}
+
+	return bleve.NewDisjunctionQuery(alternatives...)
+}
+
+func (b *bleveIndex) Search(q *Query) (*Result, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.closed {
+		return nil, ErrClosed
+	}
+
+	terms := strings.Fields(strings.ToLower(q.Text))
+	if len(terms) == 0 {
+		return &Result{Hits: []*Hit{}}, nil
+	}
+
+	// Every term must match
+	conjuncts := make([]query.Query, 0, len(terms)+1)
+	for _, term := range terms {
+		conjuncts = append(conjuncts, termQuery(term))
+	}
+	if q.Scope != "" {
+		scope := bleve.NewTermQuery(q.Scope)
+		scope.SetField(scopeField)
+		conjuncts = append(conjuncts, scope)
+	}
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	req := bleve.NewSearchRequestOptions(bleve.NewConjunctionQuery(conjuncts...), limit, q.Offset, false)
+	// Ties are ordered by id so that pages do not overlap
+	req.SortBy([]string{"-_score", "_id"})
+
+	res, err := b.index.Search(req)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]*Hit, 0, len(res.Hits))
+	for _, hit := range res.Hits {
+		hits = append(hits, &Hit{ID: hit.ID, Score: hit.Score})
+	}
+
+	return &Result{Hits: hits, Total: res.Total}, nil
+}
+
+func (b *bleveIndex) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return ErrClosed
+	}
+	b.closed = true
+	return b.index.Close()
+}
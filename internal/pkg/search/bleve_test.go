@@ -0,0 +1,134 @@
+package search
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func openTestIndex(t *testing.T) Index {
+	index, err := Open(BackendBleve, t.TempDir(), "accounts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { index.Close() })
+
+	for _, doc := range []*Document{
+		{ID: "1", Scope: "a", Fields: map[string]string{"names": "Jonathan Kamau", "email": "jkamau@example.com"}, Fragments: []string{"+254712345678"}},
+		{ID: "2", Scope: "a", Fields: map[string]string{"names": "Jane Wanjiru"}, Fragments: []string{"+254798765432"}},
+		{ID: "3", Scope: "b", Fields: map[string]string{"names": "Jonathan Otieno"}},
+		{ID: "4", Scope: "a", Fields: map[string]string{"names": "Jonathan Jonathan"}},
+	} {
+		if err := index.Index(doc); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return index
+}
+
+func hitIDs(t *testing.T, index Index, q *Query) []string {
+	res, err := index.Search(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make([]string, 0, len(res.Hits))
+	for _, hit := range res.Hits {
+		ids = append(ids, hit.ID)
+	}
+	return ids
+}
+
+func contains(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSearch(t *testing.T) {
+	index := openTestIndex(t)
+
+	for _, tc := range []struct {
+		name  string
+		query *Query
+		want  []string
+	}{
+		{"word", &Query{Text: "kamau"}, []string{"1"}},
+		{"prefix", &Query{Text: "wanj"}, []string{"2"}},
+		{"typo", &Query{Text: "wanjuru"}, []string{"2"}},
+		{"phone fragment", &Query{Text: "345678"}, []string{"1"}},
+		{"national phone", &Query{Text: "0798765432"}, []string{"2"}},
+		{"all terms", &Query{Text: "jonathan otieno"}, []string{"3"}},
+		{"scope", &Query{Text: "jonathan", Scope: "b"}, []string{"3"}},
+	} {
+		ids := hitIDs(t, index, tc.query)
+		if len(ids) != len(tc.want) {
+			t.Errorf("%s: got %v, want %v", tc.name, ids, tc.want)
+			continue
+		}
+		for _, id := range tc.want {
+			if !contains(ids, id) {
+				t.Errorf("%s: got %v, want %v", tc.name, ids, tc.want)
+			}
+		}
+	}
+}
+
+func TestSearchRanking(t *testing.T) {
+	index := openTestIndex(t)
+
+	ids := hitIDs(t, index, &Query{Text: "jonathan", Scope: "a"})
+	if len(ids) != 2 || ids[0] != "4" {
+		t.Errorf("expected the closest match first, got %v", ids)
+	}
+
+	ids = hitIDs(t, index, &Query{Text: "jonathan", Limit: 1, Offset: 1})
+	if len(ids) != 1 {
+		t.Errorf("expected a page of one hit, got %v", ids)
+	}
+}
+
+func TestDeleteAndReopen(t *testing.T) {
+	dir := t.TempDir()
+
+	index, err := OpenBleve(filepath.Join(dir, "channels.bleve"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = index.Index(&Document{ID: "1", Fields: map[string]string{"title": "Weather alerts"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err = index.Index(&Document{ID: "2", Fields: map[string]string{"title": "Weather news"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err = index.Delete("1"); err != nil {
+		t.Fatal(err)
+	}
+	if err = index.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = index.Search(&Query{Text: "weather"}); err != ErrClosed {
+		t.Errorf("expected ErrClosed, got %v", err)
+	}
+
+	index, err = OpenBleve(filepath.Join(dir, "channels.bleve"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer index.Close()
+
+	if ids := hitIDs(t, index, &Query{Text: "weather"}); len(ids) != 1 || ids[0] != "2" {
+		t.Errorf("unexpected hits after reopening %v", ids)
+	}
+}
+
+func TestOpenFullText(t *testing.T) {
+	index, err := Open(BackendFullText, "", "accounts")
+	if err != nil || index != nil {
+		t.Errorf("expected no index for full text backend, got %v, %v", index, err)
+	}
+	if _, err = Open("elastic", "", "accounts"); err == nil {
+		t.Error("expected error for unknown backend")
+	}
+}
@@ -0,0 +1,78 @@
+// Package search maintains full text search indexes outside of the database.
+package search
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+)
+
+// Backends of search
+const (
+	// BackendFullText searches with FULLTEXT indexes of the database
+	BackendFullText = "fulltext"
+	// BackendBleve searches with a bleve index on local disk
+	BackendBleve = "bleve"
+)
+
+// ErrClosed is returned when using a closed index
+var ErrClosed = errors.New("search index is closed")
+
+// Document is the searchable content of a record
+type Document struct {
+	// ID of the record
+	ID string
+	// Scope such as a project that searches may be restricted to
+	Scope string
+	// Text fields searched by words, prefixes and close spellings
+	Fields map[string]string
+	// Values such as phone numbers that are also searched by any part of them
+	Fragments []string
+}
+
+// Query is a search for documents
+type Query struct {
+	Text string
+	// Restricts matches to documents of the scope when set
+	Scope  string
+	Offset int
+	Limit  int
+}
+
+// Hit is a document that matches a query
+type Hit struct {
+	ID    string
+	Score float64
+}
+
+// Result contains the hits of a query ordered by relevance
+type Result struct {
+	Hits  []*Hit
+	Total uint64
+}
+
+// Index is a search index of records of one kind
+type Index interface {
+	// Index adds or replaces the document
+	Index(doc *Document) error
+	// Delete removes the document with the id
+	Delete(id string) error
+	// Search returns documents matching the query with the most relevant first
+	Search(query *Query) (*Result, error)
+	// Close releases the index
+	Close() error
+}
+
+// Open opens the index of backend named name in dir. The full text backend has no index and returns nil.
+func Open(backend, dir, name string) (Index, error) {
+	switch backend {
+	case "", BackendFullText:
+		return nil, nil
+	case BackendBleve:
+		if dir == "" {
+			return nil, errors.New("missing search index directory")
+		}
+		return OpenBleve(filepath.Join(dir, name+".bleve"))
+	}
+	return nil, fmt.Errorf("unknown search backend %q", backend)
+}
@@ -0,0 +1,168 @@
+package sqldb
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gidyon/micro/v2/utils/dbutil"
+	"gorm.io/gorm"
+	"gorm.io/hints"
+)
+
+// UnixTime returns an expression of the column as seconds since the unix epoch
+func UnixTime(db *gorm.DB, column string) string {
+	switch Dialect(db) {
+	case Postgres:
+		return fmt.Sprintf("EXTRACT(EPOCH FROM %s)", column)
+	case SQLite:
+		return fmt.Sprintf("CAST(strftime('%%s', %s) AS INTEGER)", column)
+	}
+	return fmt.Sprintf("UNIX_TIMESTAMP(%s)", column)
+}
+
+// Units of DateBucket
+const (
+	Day   = "day"
+	Week  = "week"
+	Month = "month"
+)
+
+// DateBucket returns an expression of the start date of the day, week or month of the column as YYYY-MM-DD in UTC.
+// Weeks start on monday.
+func DateBucket(db *gorm.DB, column, unit string) string {
+	switch Dialect(db) {
+	case Postgres:
+		return fmt.Sprintf("to_char(date_trunc('%s', %s AT TIME ZONE 'UTC'), 'YYYY-MM-DD')", unit, column)
+	case SQLite:
+		switch unit {
+		case Week:
+			return fmt.Sprintf("date(%s, 'weekday 0', '-6 days')", column)
+		case Month:
+			return fmt.Sprintf("strftime('%%Y-%%m-01', %s)", column)
+		}
+		return fmt.Sprintf("strftime('%%Y-%%m-%%d', %s)", column)
+	}
+	switch unit {
+	case Week:
+		return fmt.Sprintf("DATE_FORMAT(DATE_SUB(%s, INTERVAL WEEKDAY(%s) DAY), '%%Y-%%m-%%d')", column, column)
+	case Month:
+		return fmt.Sprintf("DATE_FORMAT(%s, '%%Y-%%m-01')", column)
+	}
+	return fmt.Sprintf("DATE_FORMAT(%s, '%%Y-%%m-%%d')", column)
+}
+
+// JSONText returns an expression of the text value of key in the JSON object of column along with its argument
+func JSONText(db *gorm.DB, column, key string) (string, interface{}) {
+	switch Dialect(db) {
+	case Postgres:
+		return fmt.Sprintf("(%s->>?)", column), key
+	case SQLite:
+		// JSON functions reject blobs
+		return fmt.Sprintf("json_extract(CAST(%s AS TEXT), ?)", column), "$." + strconv.Quote(key)
+	}
+	return fmt.Sprintf("JSON_UNQUOTE(JSON_EXTRACT(%s, ?))", column), "$." + strconv.Quote(key)
+}
+
+// JSONEqual returns an expression comparing the JSON value of column with a JSON text argument
+func JSONEqual(db *gorm.DB, column string) string {
+	switch Dialect(db) {
+	case Postgres:
+		return fmt.Sprintf("%s::jsonb = ?::jsonb", column)
+	case SQLite:
+		return fmt.Sprintf("json(CAST(%s AS TEXT)) = json(?)", column)
+	}
+	return fmt.Sprintf("%s = CAST(? AS JSON)", column)
+}
+
+// ForceIndexForOrderBy hints MySQL to order rows using the index. Other dialects choose indexes themselves.
+func ForceIndexForOrderBy(db *gorm.DB, index string) *gorm.DB {
+	if Dialect(db) != MySQL {
+		return db
+	}
+	return db.Clauses(hints.ForceIndex(index).ForOrderBy())
+}
+
+func fullTextIndexName(table string) string {
+	return table + "_" + dbutil.FullTextIndex
+}
+
+// concatenates columns into the document searched in Postgres
+func tsDocument(columns []string) string {
+	parts := make([]string, 0, len(columns))
+	for _, column := range columns {
+		parts = append(parts, fmt.Sprintf("coalesce(%s, '')", column))
+	}
+	return fmt.Sprintf("to_tsvector('simple', %s)", strings.Join(parts, " || ' ' || "))
+}
+
+// CreateFullTextIndex creates the index used by MatchFullText on the columns when it does not exist.
+// SQLite matches without an index.
+func CreateFullTextIndex(db *gorm.DB, table string, columns ...string) error {
+	switch Dialect(db) {
+	case Postgres:
+		return db.Exec(fmt.Sprintf(
+			"CREATE INDEX IF NOT EXISTS %s ON %s USING GIN (%s)", fullTextIndexName(table), table, tsDocument(columns),
+		)).Error
+	case SQLite:
+		return nil
+	}
+	if db.Migrator().HasIndex(table, dbutil.FullTextIndex) {
+		return nil
+	}
+	return dbutil.CreateFullTextIndex(db, table, columns...)
+}
+
+// returns the words of the query without characters that have a meaning in search syntax
+func queryWords(query string) []string {
+	fields := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("@.+-_", r)
+	})
+	words := make([]string, 0, len(fields))
+	for _, field := range fields {
+		// Boolean operators of MySQL full text search
+		if word := strings.Trim(field, "+-"); word != "" {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// MatchFullText filters rows whose columns contain every word of the query or words starting with them
+func MatchFullText(db *gorm.DB, query string, columns ...string) *gorm.DB {
+	dialect := Dialect(db)
+	if dialect == MySQL {
+		return db.Where(
+			fmt.Sprintf("MATCH(%s) AGAINST(? IN BOOLEAN MODE)", strings.Join(columns, ", ")), dbutil.ParseQuery(query),
+		)
+	}
+
+	words := queryWords(query)
+	if len(words) == 0 {
+		return db.Where("1 = 0")
+	}
+
+	if dialect == Postgres {
+		terms := make([]string, 0, len(words))
+		for _, word := range words {
+			terms = append(terms, "'"+word+"':*")
+		}
+		return db.Where(
+			fmt.Sprintf("%s @@ to_tsquery('simple', ?)", tsDocument(columns)), strings.Join(terms, " & "),
+		)
+	}
+
+	for _, word := range words {
+		conds := make([]string, 0, len(columns))
+		args := make([]interface{}, 0, len(columns))
+		for _, column := range columns {
+			conds = append(conds, column+` LIKE ? ESCAPE '\'`)
+			args = append(args, "%"+likeEscaper.Replace(word)+"%")
+		}
+		db = db.Where("("+strings.Join(conds, " OR ")+")", args...)
+	}
+	return db
+}
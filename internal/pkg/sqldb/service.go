@@ -0,0 +1,30 @@
+package sqldb
+
+import (
+	"fmt"
+
+	"github.com/gidyon/micro/v2"
+	"github.com/gidyon/micro/v2/pkg/config"
+	"gorm.io/gorm"
+)
+
+// ServiceDB returns the database named name in the service config.
+// The service only opens MySQL databases so databases of other dialects are declared with required false and opened here.
+func ServiceDB(app *micro.Service, cfg *config.Config, name string) (*gorm.DB, error) {
+	if db := app.GormDBByName(name); db != nil {
+		return db, nil
+	}
+
+	info := cfg.SQLDatabaseByName(name)
+	if info == nil {
+		return nil, fmt.Errorf("sql database %q not found in config", name)
+	}
+
+	return Open(&Options{
+		Dialect:  info.SQLDatabaseDialect(),
+		Address:  info.Address(),
+		User:     info.User(),
+		Password: info.Password(),
+		Schema:   info.Schema(),
+	})
+}
@@ -0,0 +1,134 @@
+// Package sqldb opens SQL databases of the supported dialects and abstracts the SQL that differs between them.
+package sqldb
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/gidyon/micro/v2/pkg/conn"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// Supported dialects
+const (
+	MySQL    = "mysql"
+	Postgres = "postgres"
+	SQLite   = "sqlite"
+)
+
+// Memory is the schema of an in-memory SQLite database
+const Memory = ":memory:"
+
+// Options contains parameters for opening a database. The schema of SQLite is the path of the database file.
+type Options struct {
+	Dialect  string
+	Address  string
+	User     string
+	Password string
+	Schema   string
+	ConnPool *conn.DBConnPoolOptions
+}
+
+// normalises the dialect names accepted in configuration
+func dialectName(dialect string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(dialect)) {
+	case "", MySQL:
+		return MySQL, nil
+	case Postgres, "postgresql", "pgx":
+		return Postgres, nil
+	case SQLite, "sqlite3":
+		return SQLite, nil
+	}
+	return "", fmt.Errorf("unsupported sql dialect %q", dialect)
+}
+
+func dialector(dialect string, opt *Options) gorm.Dialector {
+	switch dialect {
+	case Postgres:
+		host, port, err := net.SplitHostPort(opt.Address)
+		if err != nil {
+			host, port = opt.Address, "5432"
+		}
+		return postgres.Open(fmt.Sprintf(
+			"host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+			host, port, opt.User, opt.Password, opt.Schema,
+		))
+	case SQLite:
+		path := opt.Schema
+		if path == "" || path == Memory {
+			// Connections share one in-memory database
+			path = "file::memory:?cache=shared"
+		}
+		sep := "?"
+		if strings.Contains(path, "?") {
+			sep = "&"
+		}
+		return sqlite.Open(path + sep + "_busy_timeout=5000&_foreign_keys=1")
+	default:
+		return mysql.Open(fmt.Sprintf(
+			"%s:%s@tcp(%s)/%s?charset=utf8&parseTime=true", opt.User, opt.Password, opt.Address, opt.Schema,
+		))
+	}
+}
+
+// Open opens a connection to the database of the options dialect
+func Open(opt *Options) (*gorm.DB, error) {
+	if opt == nil {
+		return nil, fmt.Errorf("nil db options not allowed")
+	}
+
+	dialect, err := dialectName(opt.Dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := gorm.Open(dialector(dialect, opt), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open connection to %s database [address: %s]: %w", dialect, opt.Address, err)
+	}
+
+	if opt.ConnPool != nil {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return nil, err
+		}
+		if opt.ConnPool.MaxIdleConns != 0 {
+			sqlDB.SetMaxIdleConns(opt.ConnPool.MaxIdleConns)
+		}
+		if opt.ConnPool.MaxOpenConns != 0 {
+			sqlDB.SetMaxOpenConns(opt.ConnPool.MaxOpenConns)
+		}
+		if opt.ConnPool.MaxLifetime != 0 {
+			sqlDB.SetConnMaxLifetime(opt.ConnPool.MaxLifetime)
+		}
+	}
+
+	return db, nil
+}
+
+// Dialect returns the dialect of the database
+func Dialect(db *gorm.DB) string {
+	dialect, err := dialectName(db.Dialector.Name())
+	if err != nil {
+		return db.Dialector.Name()
+	}
+	return dialect
+}
+
+// IsDuplicate checks whether the error is a violation of a unique constraint
+func IsDuplicate(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "duplicate entry") ||
+		strings.Contains(msg, "duplicate key value") ||
+		strings.Contains(msg, "unique constraint failed")
+}
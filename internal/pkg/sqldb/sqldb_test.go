@@ -0,0 +1,184 @@
+package sqldb
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type testRecord struct {
+	ID        uint `gorm:"primaryKey;autoIncrement"`
+	Names     string
+	Email     string `gorm:"uniqueIndex;type:varchar(50)"`
+	Data      []byte `gorm:"type:json"`
+	CreatedAt time.Time
+}
+
+func openTestDB(t *testing.T) *gorm.DB {
+	db, err := Open(&Options{
+		Dialect: "sqlite3",
+		Schema:  filepath.Join(t.TempDir(), "test.db"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = db.AutoMigrate(&testRecord{}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, rec := range []*testRecord{
+		{Names: "Jonathan Kamau", Email: "jkamau@example.com", Data: []byte(`{"county":"Nairobi"}`), CreatedAt: time.Unix(1000, 0)},
+		{Names: "Jane Wanjiru", Email: "jane_w@example.com", Data: []byte(`{"county":"Nakuru"}`), CreatedAt: time.Unix(2000, 0)},
+		{Names: "Jonathan Otieno", Email: "otieno%@example.com", CreatedAt: time.Unix(3000, 0)},
+	} {
+		if err = db.Create(rec).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	return db
+}
+
+func recordIDs(t *testing.T, db *gorm.DB) []uint {
+	ids := make([]uint, 0)
+	if err := db.Model(&testRecord{}).Order("id").Pluck("id", &ids).Error; err != nil {
+		t.Fatal(err)
+	}
+	return ids
+}
+
+func equalIDs(got, want []uint) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestDialect(t *testing.T) {
+	for _, tc := range []struct {
+		dialect string
+		want    string
+	}{
+		{"", MySQL},
+		{"MySQL", MySQL},
+		{"postgresql", Postgres},
+		{"pgx", Postgres},
+		{"sqlite3", SQLite},
+	} {
+		got, err := dialectName(tc.dialect)
+		if err != nil || got != tc.want {
+			t.Errorf("dialectName(%q) = %q, %v; want %q", tc.dialect, got, err, tc.want)
+		}
+	}
+
+	if _, err := Open(&Options{Dialect: "oracle"}); err == nil {
+		t.Error("expected error for unsupported dialect")
+	}
+
+	if got := Dialect(openTestDB(t)); got != SQLite {
+		t.Errorf("Dialect() = %q; want %q", got, SQLite)
+	}
+}
+
+func TestUnixTime(t *testing.T) {
+	db := openTestDB(t)
+
+	got := recordIDs(t, db.Where(UnixTime(db, "created_at")+" BETWEEN ? AND ?", 1500, 3000))
+	if want := []uint{2, 3}; !equalIDs(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+}
+
+func TestDateBucket(t *testing.T) {
+	db := openTestDB(t)
+
+	// Sunday evening in UTC
+	createdAt := time.Date(2021, 3, 7, 23, 30, 0, 0, time.FixedZone("EAT", 3*60*60))
+	rec := &testRecord{Email: "bucket@example.com", CreatedAt: createdAt}
+	if err := db.Create(rec).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	for unit, want := range map[string]string{
+		Day:   "2021-03-07",
+		Week:  "2021-03-01",
+		Month: "2021-03-01",
+	} {
+		var got string
+		err := db.Model(&testRecord{}).Where("id=?", rec.ID).Pluck(DateBucket(db, "created_at", unit), &got).Error
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("DateBucket(%s) = %q; want %q", unit, got, want)
+		}
+	}
+}
+
+func TestJSONText(t *testing.T) {
+	db := openTestDB(t)
+
+	expr, arg := JSONText(db, "data", "county")
+	got := recordIDs(t, db.Where(expr+" = ?", arg, "Nakuru"))
+	if want := []uint{2}; !equalIDs(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+}
+
+func TestJSONEqual(t *testing.T) {
+	db := openTestDB(t)
+
+	got := recordIDs(t, db.Where(JSONEqual(db, "data"), `{ "county": "Nakuru" }`))
+	if want := []uint{2}; !equalIDs(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+}
+
+func TestMatchFullText(t *testing.T) {
+	db := openTestDB(t)
+
+	if err := CreateFullTextIndex(db, "test_records", "names", "email"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name  string
+		query string
+		want  []uint
+	}{
+		{"word", "kamau", []uint{1}},
+		{"every word", "jonathan otieno", []uint{3}},
+		{"prefix", "jon", []uint{1, 3}},
+		{"across columns", "jane example.com", []uint{2}},
+		{"wildcards are literal", "%", []uint{}},
+		{"underscore is literal", "e_w", []uint{2}},
+		{"search syntax", "+jane*", []uint{2}},
+		{"empty", "  ", []uint{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := recordIDs(t, MatchFullText(db, tc.query, "names", "email"))
+			if !equalIDs(got, tc.want) {
+				t.Errorf("got %v; want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsDuplicate(t *testing.T) {
+	db := openTestDB(t)
+
+	err := db.Create(&testRecord{Email: "jkamau@example.com"}).Error
+	if !IsDuplicate(err) {
+		t.Errorf("IsDuplicate(%v) = false; want true", err)
+	}
+	if IsDuplicate(nil) || IsDuplicate(errors.New("connection refused")) {
+		t.Error("IsDuplicate() = true for other errors")
+	}
+}
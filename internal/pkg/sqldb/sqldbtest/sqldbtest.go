@@ -0,0 +1,58 @@
+// Package sqldbtest opens the databases used by test suites
+package sqldbtest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/gidyon/services/internal/pkg/sqldb"
+	"gorm.io/gorm"
+)
+
+// Environment variables that select the test database
+const (
+	// EnvDialect is the dialect of the test database; mysql when empty
+	EnvDialect = "TEST_SQL_DIALECT"
+	// EnvAddress is the address of the MySQL or PostgreSQL server
+	EnvAddress = "TEST_SQL_ADDRESS"
+)
+
+func getenv(key, fallback string) string {
+	if val := os.Getenv(key); val != "" {
+		return val
+	}
+	return fallback
+}
+
+// Open opens the schema in the database selected by the environment.
+// SQLite databases are created in a new temporary directory so suites need no database server.
+func Open(schema string) (*gorm.DB, error) {
+	switch dialect := os.Getenv(EnvDialect); dialect {
+	case sqldb.SQLite:
+		dir, err := ioutil.TempDir("", "sqldbtest")
+		if err != nil {
+			return nil, err
+		}
+		return sqldb.Open(&sqldb.Options{
+			Dialect: sqldb.SQLite,
+			Schema:  filepath.Join(dir, schema+".db"),
+		})
+	case sqldb.Postgres:
+		return sqldb.Open(&sqldb.Options{
+			Dialect:  sqldb.Postgres,
+			Address:  getenv(EnvAddress, "localhost:5432"),
+			User:     "postgres",
+			Password: "hakty11",
+			Schema:   schema,
+		})
+	default:
+		return sqldb.Open(&sqldb.Options{
+			Dialect:  dialect,
+			Address:  getenv(EnvAddress, "localhost:3306"),
+			User:     "root",
+			Password: "hakty11",
+			Schema:   schema,
+		})
+	}
+}
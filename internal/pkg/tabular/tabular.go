@@ -0,0 +1,135 @@
+// Package tabular reads spreadsheet uploads in CSV or XLSX format into records keyed by field name.
+package tabular
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/360EntSecGroup-Skylar/excelize"
+)
+
+// Format is the encoding of a tabular file
+type Format string
+
+// Supported formats
+const (
+	CSV  Format = "csv"
+	XLSX Format = "xlsx"
+)
+
+// ErrUnknownFormat is returned for formats other than CSV and XLSX
+var ErrUnknownFormat = errors.New("unknown tabular format")
+
+// ErrNoHeader is returned when a file does not have a header row
+var ErrNoHeader = errors.New("file has no header row")
+
+// ParseFormat parses the format name or file extension of a tabular file
+func ParseFormat(format string) (Format, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if i := strings.LastIndex(format, "."); i >= 0 {
+		format = format[i+1:]
+	}
+	switch format {
+	case "csv":
+		return CSV, nil
+	case "xlsx", "excel":
+		return XLSX, nil
+	}
+	return "", ErrUnknownFormat
+}
+
+// Table is a header row followed by data rows
+type Table struct {
+	Header []string
+	Rows   [][]string
+}
+
+// Record is a data row keyed by field name
+type Record struct {
+	// Row is the 1-based row number in the file, counting the header
+	Row    int
+	Fields map[string]string
+}
+
+// Read reads a table from r. XLSX files are read from their active sheet.
+func Read(r io.Reader, format Format) (*Table, error) {
+	var (
+		rows [][]string
+		err  error
+	)
+
+	switch format {
+	case CSV:
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+		rows, err = reader.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read csv: %w", err)
+		}
+	case XLSX:
+		xlsxFile, err := excelize.OpenReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read xlsx: %w", err)
+		}
+		rows = xlsxFile.GetRows(xlsxFile.GetSheetName(xlsxFile.GetActiveSheetIndex()))
+	default:
+		return nil, ErrUnknownFormat
+	}
+
+	if len(rows) == 0 {
+		return nil, ErrNoHeader
+	}
+
+	header := make([]string, 0, len(rows[0]))
+	for _, col := range rows[0] {
+		header = append(header, strings.TrimSpace(strings.TrimPrefix(col, "\ufeff")))
+	}
+
+	return &Table{Header: header, Rows: rows[1:]}, nil
+}
+
+// Normalize converts a column name to the snake case form used for field names
+func Normalize(col string) string {
+	return strings.ToLower(strings.Join(strings.Fields(strings.ReplaceAll(col, "-", " ")), "_"))
+}
+
+// Records maps every non-blank row to its fields.
+//
+// mapping maps column names to field names. When mapping is empty, the normalized column names are the field names.
+// Columns without a mapping are ignored.
+func (t *Table) Records(mapping map[string]string) []*Record {
+	fields := make([]string, len(t.Header))
+	for i, col := range t.Header {
+		switch {
+		case len(mapping) == 0:
+			fields[i] = Normalize(col)
+		case mapping[col] != "":
+			fields[i] = mapping[col]
+		}
+	}
+
+	records := make([]*Record, 0, len(t.Rows))
+	for i, row := range t.Rows {
+		record := &Record{Row: i + 2, Fields: make(map[string]string, len(fields))}
+		blank := true
+		for j, val := range row {
+			if j >= len(fields) || fields[j] == "" {
+				continue
+			}
+			val = strings.TrimSpace(val)
+			if val != "" {
+				blank = false
+			}
+			record.Fields[fields[j]] = val
+		}
+		if !blank {
+			records = append(records, record)
+		}
+	}
+
+	return records
+}
@@ -0,0 +1,91 @@
+package tabular
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/360EntSecGroup-Skylar/excelize"
+)
+
+func TestParseFormat(t *testing.T) {
+	for in, want := range map[string]Format{"csv": CSV, "accounts.CSV": CSV, "xlsx": XLSX, "Excel": XLSX, "a.b.xlsx": XLSX} {
+		got, err := ParseFormat(in)
+		if err != nil || got != want {
+			t.Errorf("ParseFormat(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseFormat("pdf"); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("expected unknown format error got %v", err)
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	data := "\ufeffFull Name, E-mail ,Phone\nJane Doe, jane@example.com ,0712345678\n,,\nJohn Doe,john@example.com\n"
+
+	table, err := Read(strings.NewReader(data), CSV)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(table.Header, "|") != "Full Name|E-mail|Phone" {
+		t.Fatalf("unexpected header %q", table.Header)
+	}
+
+	records := table.Records(nil)
+	if len(records) != 2 {
+		t.Fatalf("expected blank rows to be skipped got %d records", len(records))
+	}
+	if records[0].Row != 2 || records[1].Row != 4 {
+		t.Errorf("unexpected row numbers %d and %d", records[0].Row, records[1].Row)
+	}
+	if records[0].Fields["full_name"] != "Jane Doe" || records[0].Fields["e_mail"] != "jane@example.com" {
+		t.Errorf("unexpected fields %v", records[0].Fields)
+	}
+	if _, ok := records[1].Fields["phone"]; ok {
+		t.Errorf("missing trailing column should not be set: %v", records[1].Fields)
+	}
+}
+
+func TestRecordsWithMapping(t *testing.T) {
+	table := &Table{
+		Header: []string{"Jina", "Simu", "Ignored"},
+		Rows:   [][]string{{"Jane Doe", "0712345678", "x"}},
+	}
+
+	records := table.Records(map[string]string{"Jina": "names", "Simu": "phone"})
+	if len(records) != 1 {
+		t.Fatalf("expected one record got %d", len(records))
+	}
+	fields := records[0].Fields
+	if len(fields) != 2 || fields["names"] != "Jane Doe" || fields["phone"] != "0712345678" {
+		t.Errorf("unexpected fields %v", fields)
+	}
+}
+
+func TestReadXLSX(t *testing.T) {
+	xlsxFile := excelize.NewFile()
+	sheetName := xlsxFile.GetSheetName(xlsxFile.GetActiveSheetIndex())
+	xlsxFile.SetSheetRow(sheetName, "A1", &[]interface{}{"Names", "Email"})
+	xlsxFile.SetSheetRow(sheetName, "A2", &[]interface{}{"Jane Doe", "jane@example.com"})
+
+	buf := &bytes.Buffer{}
+	if err := xlsxFile.Write(buf); err != nil {
+		t.Fatal(err)
+	}
+
+	table, err := Read(buf, XLSX)
+	if err != nil {
+		t.Fatal(err)
+	}
+	records := table.Records(nil)
+	if len(records) != 1 || records[0].Fields["email"] != "jane@example.com" {
+		t.Errorf("unexpected records %+v", records)
+	}
+}
+
+func TestReadEmpty(t *testing.T) {
+	if _, err := Read(strings.NewReader(""), CSV); !errors.Is(err, ErrNoHeader) {
+		t.Errorf("expected no header error got %v", err)
+	}
+}
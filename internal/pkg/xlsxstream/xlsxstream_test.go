@@ -0,0 +1,78 @@
+package xlsxstream
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/360EntSecGroup-Skylar/excelize"
+)
+
+func TestWriteRows(t *testing.T) {
+	rows := [][]string{
+		{"AccountId", "Names", "Email"},
+		{"1", "Jane <Doe> & Co", " padded "},
+		{"2", "John Doe", ""},
+	}
+
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, "Accounts & Users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range rows {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	xlsxFile, err := excelize.OpenReader(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sheetName := xlsxFile.GetSheetName(1)
+	if sheetName != "Accounts & Users" {
+		t.Errorf("unexpected sheet name %q", sheetName)
+	}
+
+	got := xlsxFile.GetRows(sheetName)
+	if len(got) != len(rows) {
+		t.Fatalf("expected %d rows got %d", len(rows), len(got))
+	}
+	for i := range rows {
+		// Trailing empty cells are trimmed by the reader
+		want := rows[i]
+		if want[len(want)-1] == "" {
+			want = want[:len(want)-1]
+		}
+		if !reflect.DeepEqual(got[i][:len(want)], want) {
+			t.Errorf("row %d: expected %q got %q", i, want, got[i])
+		}
+	}
+}
+
+func TestWriteAfterClose(t *testing.T) {
+	w, err := NewWriter(&bytes.Buffer{}, "Sheet1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRow([]string{"a"}); !errors.Is(err, ErrClosed) {
+		t.Errorf("expected closed error got %v", err)
+	}
+}
+
+func TestColumn(t *testing.T) {
+	for i, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"} {
+		if got := column(i); got != want {
+			t.Errorf("column(%d) = %s; want %s", i, got, want)
+		}
+	}
+}
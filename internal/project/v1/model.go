@@ -23,8 +23,8 @@ type Project struct {
 	Description string    `gorm:"type:varchar(150);"`
 	Status      string    `gorm:"index;type:varchar(50)"`
 	Scopes      []byte    `gorm:"type:json"`
-	CreatedAt   time.Time `gorm:"autoCreateTime;->;<-:create;not null;type:datetime(6)"`
-	UpdatedAt   time.Time `gorm:"autoUpdateTime;<-;type:datetime(6)"`
+	CreatedAt   time.Time `gorm:"autoCreateTime;->;<-:create;not null;precision:6"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime;<-;precision:6"`
 	DeletedAt   gorm.DeletedAt
 }
 
@@ -83,8 +83,8 @@ type ProjectMember struct {
 	ProjectId string    `gorm:"index;type:varchar(50);not null"`
 	Status    string    `gorm:"index;type:varchar(50)"`
 	Scopes    []byte    `gorm:"type:json"`
-	CreatedAt time.Time `gorm:"autoCreateTime;->;<-:create;not null;type:datetime(6)"`
-	UpdatedAt time.Time `gorm:"autoUpdateTime;<-;type:datetime(6)"`
+	CreatedAt time.Time `gorm:"autoCreateTime;->;<-:create;not null;precision:6"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;<-;precision:6"`
 	DeletedAt gorm.DeletedAt
 }
 
@@ -0,0 +1,77 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/services/pkg/api/settings"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Erasing user settings", func() {
+	var (
+		eraseReq *settings.EraseSettingsRequest
+		ctx      context.Context
+		ownerID  = randomdata.Number(10000, 99999)
+	)
+
+	BeforeEach(func() {
+		eraseReq = &settings.EraseSettingsRequest{
+			OwnerId: fmt.Sprint(ownerID),
+		}
+		ctx = context.Background()
+	})
+
+	Describe("Erasing settings with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			eraseReq = nil
+			eraseRes, err := SettingsAPI.EraseSettings(ctx, eraseReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(eraseRes).Should(BeNil())
+		})
+		It("should fail when owner id is missing", func() {
+			eraseReq.OwnerId = ""
+			eraseRes, err := SettingsAPI.EraseSettings(ctx, eraseReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(eraseRes).Should(BeNil())
+		})
+		It("should fail when owner id is incorrect", func() {
+			eraseReq.OwnerId = randomdata.RandStringRunes(10)
+			eraseRes, err := SettingsAPI.EraseSettings(ctx, eraseReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(eraseRes).Should(BeNil())
+		})
+	})
+
+	Describe("Erasing settings with correct request", func() {
+		It("should succeed in creating settings", func() {
+			settingsDB, err := GetSettingsDB(&settings.Settings{
+				Settings: map[string]*settings.Setting{
+					"email": {Key: "email", Value: randomdata.Email()},
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			settingsDB.OwnerID = uint(ownerID)
+			Expect(SettingsAPIServer.SQLDB.Create(settingsDB).Error).ShouldNot(HaveOccurred())
+		})
+
+		It("should succeed in erasing settings", func() {
+			eraseRes, err := SettingsAPI.EraseSettings(ctx, eraseReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(eraseRes).ShouldNot(BeNil())
+		})
+
+		It("should have no settings left", func() {
+			getRes, err := SettingsAPI.GetSettings(ctx, &settings.GetSettingsRequest{
+				OwnerId: eraseReq.OwnerId,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getRes.Settings).Should(BeEmpty())
+		})
+	})
+})
@@ -10,6 +10,7 @@ import (
 	"github.com/gidyon/micro/v2/utils/encryption"
 	"github.com/gidyon/micro/v2/utils/errs"
 	"github.com/gidyon/services/pkg/api/settings"
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/speps/go-hashids"
 	"google.golang.org/grpc/grpclog"
 	"gorm.io/gorm"
@@ -59,6 +60,7 @@ func NewSettingsAPI(ctx context.Context, opt *Options) (settings.SettingsAPIServ
 
 	settingsAPI := &settingsAPIServer{
 		hasher:  hasher,
+		authAPI: opt.API,
 		Options: opt,
 	}
 
@@ -212,3 +214,35 @@ func (settingsAPI *settingsAPIServer) GetSettings(
 		Settings: settingsPB,
 	}, nil
 }
+
+func (settingsAPI *settingsAPIServer) EraseSettings(
+	ctx context.Context, eraseReq *settings.EraseSettingsRequest,
+) (*empty.Empty, error) {
+	// Authentication
+	_, err := settingsAPI.authAPI.AuthorizeActorOrGroup(ctx, eraseReq.GetOwnerId(), settingsAPI.authAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case eraseReq == nil:
+		return nil, errs.NilObject("EraseSettingsRequest")
+	case eraseReq.OwnerId == "":
+		return nil, errs.MissingField("owner id")
+	default:
+		_, err = strconv.Atoi(eraseReq.OwnerId)
+		if err != nil {
+			return nil, errs.IncorrectVal("owner id")
+		}
+	}
+
+	// The row is kept while its settings are cleared
+	err = settingsAPI.SQLDB.Unscoped().Model(&Model{}).Where("owner_id=?", eraseReq.OwnerId).
+		Update("settings", []byte("{}")).Error
+	if err != nil {
+		return nil, errs.FailedToUpdate("settings", err)
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,75 @@
+package subscriber
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/services/pkg/api/subscriber"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Deleting A Subscriber @delete", func() {
+
+	var subscriberID = fmt.Sprint(randomdata.Number(2000000, 2999999))
+
+	var (
+		delReq *subscriber.DeleteSubscriberRequest
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		delReq = &subscriber.DeleteSubscriberRequest{
+			SubscriberId: subscriberID,
+		}
+		ctx = context.Background()
+	})
+
+	Describe("Deleting a subscriber with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			delReq = nil
+			delRes, err := SubsriberAPI.DeleteSubscriber(ctx, delReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(delRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+		It("should fail when subscriber id is is missing", func() {
+			delReq.SubscriberId = ""
+			delRes, err := SubsriberAPI.DeleteSubscriber(ctx, delReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(delRes).Should(BeNil())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("Deleting a subscriber with well-formed request", func() {
+		It("should subscribe user to channels first", func() {
+			subscribeRes, err := SubsriberAPI.Subscribe(ctx, &subscriber.SubscriberRequest{
+				SubscriberId: subscriberID,
+				Channels:     []string{randomdata.Month(), randomdata.Day()},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(subscribeRes).ShouldNot(BeNil())
+		})
+
+		It("should delete the subscriber", func() {
+			delRes, err := SubsriberAPI.DeleteSubscriber(ctx, delReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(delRes).ShouldNot(BeNil())
+		})
+
+		It("should have no channels for the subscriber", func() {
+			var count int64
+			err := SubsriberAPIServer.SQLDB.Model(&Subscriber{}).Where("user_id = ?", subscriberID).Count(&count).Error
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(count).Should(BeZero())
+		})
+
+		It("should succeed for a subscriber without channels", func() {
+			delRes, err := SubsriberAPI.DeleteSubscriber(ctx, delReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(delRes).ShouldNot(BeNil())
+		})
+	})
+})
@@ -217,16 +217,18 @@ func (subscriberAPI *subscriberAPIServer) ListSubscribers(
 		ID = uint(v)
 	}
 
-	db := subscriberAPI.SQLDB.Model(&Subscriber{}).Limit(int(pageSize) + 1).Order("id DESC")
+	db := subscriberAPI.SQLDB.Model(&Subscriber{}).Limit(int(pageSize) + 1)
 	if ID != 0 {
 		db = db.Where("id<?", ID)
 	}
 
 	// Apply filters
-	if req.Filter != nil {
-		if len(req.Filter.Channels) > 0 {
-			db = db.Group("user_id").Where("channel IN (?)", req.Filter.Channels)
-		}
+	if len(req.GetFilter().GetChannels()) > 0 {
+		// One row for each subscriber ordered by their latest subscription
+		db = db.Select("MAX(id) AS id, user_id").Where("channel IN (?)", req.Filter.Channels).
+			Group("user_id").Order("MAX(id) DESC")
+	} else {
+		db = db.Order("id DESC")
 	}
 
 	var collectionCount int64
@@ -338,3 +340,51 @@ func (subscriberAPI *subscriberAPIServer) GetSubscriber(
 
 	return GetSubscriberPB(pb, channels)
 }
+
+func (subscriberAPI *subscriberAPIServer) DeleteSubscriber(
+	ctx context.Context, delReq *subscriber.DeleteSubscriberRequest,
+) (*empty.Empty, error) {
+	// Validation
+	switch {
+	case delReq == nil:
+		return nil, errs.NilObject("request")
+	case delReq.SubscriberId == "":
+		return nil, errs.MissingField("subscriber id")
+	}
+
+	// Authorize the request
+	_, err := subscriberAPI.AuthAPI.AuthorizeActorOrGroup(ctx, delReq.SubscriberId, subscriberAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	channels := make([]string, 0, 5)
+
+	err = subscriberAPI.SQLDB.Model(&Subscriber{}).Where("user_id = ?", delReq.SubscriberId).Select("channel").Distinct("channel").Scan(&channels).Error
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to get subcriber channels")
+	}
+
+	// Unlike GetSubscriber the account is not required so that subscribers of purged accounts can be removed
+	err = subscriberAPI.SQLDB.Delete(&Subscriber{}, "user_id = ?", delReq.SubscriberId).Error
+	if err != nil {
+		return nil, errs.FailedToDelete("subscriber", err)
+	}
+
+	if len(channels) == 0 {
+		return &empty.Empty{}, nil
+	}
+
+	ctx2, cancel := context.WithTimeout(mdutil.AddFromCtx(ctx), 10*time.Second)
+	defer cancel()
+
+	// Decrement channel subscribers
+	_, err = subscriberAPI.ChannelClient.DecrementSubscribers(ctx2, &channel.SubscribersRequest{
+		ChannelNames: channels,
+	})
+	if err != nil {
+		subscriberAPI.Logger.Errorln("failed to decrement channel subscribers: ", err)
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -5,6 +5,7 @@ package account
 import (
 	context "context"
 	empty "github.com/golang/protobuf/ptypes/empty"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -22,12 +23,46 @@ type AccountAPIClient interface {
 	RequestSignInOTP(ctx context.Context, in *RequestSignInOTPRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Sign in using provided OTPs
 	SignInOTP(ctx context.Context, in *SignInOTPRequest, opts ...grpc.CallOption) (*SignInResponse, error)
+	// Emails a single use link for signing in without a password
+	RequestMagicLink(ctx context.Context, in *RequestMagicLinkRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Sign in using the token of a magic link
+	SignInMagicLink(ctx context.Context, in *SignInMagicLinkRequest, opts ...grpc.CallOption) (*SignInResponse, error)
 	// Signs in a user into their account
 	SignIn(ctx context.Context, in *SignInRequest, opts ...grpc.CallOption) (*SignInResponse, error)
+	// Completes a sign in that requires a second factor
+	SignInMFA(ctx context.Context, in *SignInMFARequest, opts ...grpc.CallOption) (*SignInResponse, error)
+	// Starts enrolling an account to TOTP two-factor authentication
+	EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPResponse, error)
+	// Confirms TOTP enrollment using a code from the authenticator app
+	ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*BackupCodes, error)
+	// Turns off TOTP two-factor authentication for an account
+	DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Replaces the one-time backup codes for an account
+	RegenerateBackupCodes(ctx context.Context, in *RegenerateBackupCodesRequest, opts ...grpc.CallOption) (*BackupCodes, error)
 	// Signs in a user using third parties like Google, Facebook, Twitter etc
 	SignInExternal(ctx context.Context, in *SignInExternalRequest, opts ...grpc.CallOption) (*SignInResponse, error)
 	// Fetch new JWT using refresh token and updates session
 	RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*SignInResponse, error)
+	// Lists active sessions of an account
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// Revokes a single session of an account
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Revokes all sessions of an account
+	RevokeAllSessions(ctx context.Context, in *RevokeAllSessionsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Registers an OpenID Connect client of a project
+	CreateOIDCClient(ctx context.Context, in *CreateOIDCClientRequest, opts ...grpc.CallOption) (*OIDCClient, error)
+	// Lists OpenID Connect clients of a project
+	ListOIDCClients(ctx context.Context, in *ListOIDCClientsRequest, opts ...grpc.CallOption) (*ListOIDCClientsResponse, error)
+	// Removes an OpenID Connect client
+	DeleteOIDCClient(ctx context.Context, in *DeleteOIDCClientRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Invites a user to create an account in a project and group
+	CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*Invitation, error)
+	// Lists invitations of a project
+	ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error)
+	// Revokes an invitation so that it can no longer be accepted
+	RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Creates the account of an invited user and signs them in
+	AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*SignInResponse, error)
 	// Creates an account for a new user
 	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
 	// Activates an account to being active
@@ -53,16 +88,48 @@ type AccountAPIClient interface {
 	BatchGetAccounts(ctx context.Context, in *BatchGetAccountsRequest, opts ...grpc.CallOption) (*BatchGetAccountsResponse, error)
 	//  Retrieves deeply linked accounts
 	GetLinkedAccounts(ctx context.Context, in *GetLinkedAccountsRequest, opts ...grpc.CallOption) (*GetLinkedAccountsResponse, error)
+	// Links an identity of an external provider to an account
+	LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...grpc.CallOption) (*LinkedIdentity, error)
+	// Unlinks an identity from an account unless it is the last way to sign in
+	UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Lists identities linked to an account
+	ListIdentities(ctx context.Context, in *ListIdentitiesRequest, opts ...grpc.CallOption) (*ListIdentitiesResponse, error)
+	// Builds an archive of all personal data of an account as a long running
+	// operation
+	RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*PersonalDataOperation, error)
+	// Downloads the archive built by a personal data export
+	GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// Anonymises personal data of an account in every service as a long running
+	// operation
+	RequestErasure(ctx context.Context, in *RequestErasureRequest, opts ...grpc.CallOption) (*PersonalDataOperation, error)
 	// Checks if an account exists
 	ExistAccount(ctx context.Context, in *ExistAccountRequest, opts ...grpc.CallOption) (*ExistAccountResponse, error)
 	// Updates account
 	AdminUpdateAccount(ctx context.Context, in *AdminUpdateAccountRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Issues a short lived token for an administrator to act as an account
+	ImpersonateAccount(ctx context.Context, in *ImpersonateAccountRequest, opts ...grpc.CallOption) (*SignInResponse, error)
+	// Lists the audit trail of administrative account operations
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// Imports accounts from a CSV or XLSX file as a long running operation
+	ImportAccounts(ctx context.Context, in *ImportAccountsRequest, opts ...grpc.CallOption) (*ImportAccountsResponse, error)
+	// Retrieves the per row report of an account import
+	GetImportReport(ctx context.Context, in *GetImportReportRequest, opts ...grpc.CallOption) (*ImportReport, error)
 	// Fetches collection of accounts
 	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*Accounts, error)
 	// Searches accounts and linked accounts
 	SearchAccounts(ctx context.Context, in *SearchAccountsRequest, opts ...grpc.CallOption) (*Accounts, error)
+	// Streams accounts matching a criteria as a CSV, JSON Lines or XLSX file
+	ExportAccounts(ctx context.Context, in *ExportAccountsRequest, opts ...grpc.CallOption) (AccountAPI_ExportAccountsClient, error)
 	// Request to get daily users stats
 	DailyRegisteredUsers(ctx context.Context, in *DailyRegisteredUsersRequest, opts ...grpc.CallOption) (*CountStats, error)
+	// Counts accounts registered in each time bucket
+	GetRegistrationStats(ctx context.Context, in *AnalyticsRequest, opts ...grpc.CallOption) (*TimeSeries, error)
+	// Counts distinct accounts active in each time bucket
+	GetActiveUsersStats(ctx context.Context, in *AnalyticsRequest, opts ...grpc.CallOption) (*TimeSeries, error)
+	// Counts accounts by state, gender and group
+	GetAccountBreakdown(ctx context.Context, in *AnalyticsRequest, opts ...grpc.CallOption) (*AccountBreakdown, error)
+	// Retrieves the share of accounts registered in a time bucket that were active in later buckets
+	GetRetentionCohorts(ctx context.Context, in *AnalyticsRequest, opts ...grpc.CallOption) (*RetentionCohorts, error)
 }
 
 type accountAPIClient struct {
@@ -91,6 +158,24 @@ func (c *accountAPIClient) SignInOTP(ctx context.Context, in *SignInOTPRequest,
 	return out, nil
 }
 
+func (c *accountAPIClient) RequestMagicLink(ctx context.Context, in *RequestMagicLinkRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/RequestMagicLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) SignInMagicLink(ctx context.Context, in *SignInMagicLinkRequest, opts ...grpc.CallOption) (*SignInResponse, error) {
+	out := new(SignInResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/SignInMagicLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountAPIClient) SignIn(ctx context.Context, in *SignInRequest, opts ...grpc.CallOption) (*SignInResponse, error) {
 	out := new(SignInResponse)
 	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/SignIn", in, out, opts...)
@@ -100,6 +185,51 @@ func (c *accountAPIClient) SignIn(ctx context.Context, in *SignInRequest, opts .
 	return out, nil
 }
 
+func (c *accountAPIClient) SignInMFA(ctx context.Context, in *SignInMFARequest, opts ...grpc.CallOption) (*SignInResponse, error) {
+	out := new(SignInResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/SignInMFA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPResponse, error) {
+	out := new(EnrollTOTPResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/EnrollTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*BackupCodes, error) {
+	out := new(BackupCodes)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/ConfirmTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/DisableTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) RegenerateBackupCodes(ctx context.Context, in *RegenerateBackupCodesRequest, opts ...grpc.CallOption) (*BackupCodes, error) {
+	out := new(BackupCodes)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/RegenerateBackupCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountAPIClient) SignInExternal(ctx context.Context, in *SignInExternalRequest, opts ...grpc.CallOption) (*SignInResponse, error) {
 	out := new(SignInResponse)
 	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/SignInExternal", in, out, opts...)
@@ -118,6 +248,96 @@ func (c *accountAPIClient) RefreshSession(ctx context.Context, in *RefreshSessio
 	return out, nil
 }
 
+func (c *accountAPIClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) RevokeAllSessions(ctx context.Context, in *RevokeAllSessionsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/RevokeAllSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) CreateOIDCClient(ctx context.Context, in *CreateOIDCClientRequest, opts ...grpc.CallOption) (*OIDCClient, error) {
+	out := new(OIDCClient)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/CreateOIDCClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) ListOIDCClients(ctx context.Context, in *ListOIDCClientsRequest, opts ...grpc.CallOption) (*ListOIDCClientsResponse, error) {
+	out := new(ListOIDCClientsResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/ListOIDCClients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) DeleteOIDCClient(ctx context.Context, in *DeleteOIDCClientRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/DeleteOIDCClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*Invitation, error) {
+	out := new(Invitation)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/CreateInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error) {
+	out := new(ListInvitationsResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/ListInvitations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/RevokeInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*SignInResponse, error) {
+	out := new(SignInResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/AcceptInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountAPIClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
 	out := new(CreateAccountResponse)
 	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/CreateAccount", in, out, opts...)
@@ -226,6 +446,60 @@ func (c *accountAPIClient) GetLinkedAccounts(ctx context.Context, in *GetLinkedA
 	return out, nil
 }
 
+func (c *accountAPIClient) LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...grpc.CallOption) (*LinkedIdentity, error) {
+	out := new(LinkedIdentity)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/LinkIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/UnlinkIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) ListIdentities(ctx context.Context, in *ListIdentitiesRequest, opts ...grpc.CallOption) (*ListIdentitiesResponse, error) {
+	out := new(ListIdentitiesResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/ListIdentities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*PersonalDataOperation, error) {
+	out := new(PersonalDataOperation)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/RequestDataExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/GetDataExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) RequestErasure(ctx context.Context, in *RequestErasureRequest, opts ...grpc.CallOption) (*PersonalDataOperation, error) {
+	out := new(PersonalDataOperation)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/RequestErasure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountAPIClient) ExistAccount(ctx context.Context, in *ExistAccountRequest, opts ...grpc.CallOption) (*ExistAccountResponse, error) {
 	out := new(ExistAccountResponse)
 	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/ExistAccount", in, out, opts...)
@@ -244,6 +518,42 @@ func (c *accountAPIClient) AdminUpdateAccount(ctx context.Context, in *AdminUpda
 	return out, nil
 }
 
+func (c *accountAPIClient) ImpersonateAccount(ctx context.Context, in *ImpersonateAccountRequest, opts ...grpc.CallOption) (*SignInResponse, error) {
+	out := new(SignInResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/ImpersonateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) ImportAccounts(ctx context.Context, in *ImportAccountsRequest, opts ...grpc.CallOption) (*ImportAccountsResponse, error) {
+	out := new(ImportAccountsResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/ImportAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) GetImportReport(ctx context.Context, in *GetImportReportRequest, opts ...grpc.CallOption) (*ImportReport, error) {
+	out := new(ImportReport)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/GetImportReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountAPIClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*Accounts, error) {
 	out := new(Accounts)
 	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/ListAccounts", in, out, opts...)
@@ -262,6 +572,38 @@ func (c *accountAPIClient) SearchAccounts(ctx context.Context, in *SearchAccount
 	return out, nil
 }
 
+func (c *accountAPIClient) ExportAccounts(ctx context.Context, in *ExportAccountsRequest, opts ...grpc.CallOption) (AccountAPI_ExportAccountsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AccountAPI_serviceDesc.Streams[0], "/gidyon.apis.AccountAPI/ExportAccounts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &accountAPIExportAccountsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AccountAPI_ExportAccountsClient interface {
+	Recv() (*httpbody.HttpBody, error)
+	grpc.ClientStream
+}
+
+type accountAPIExportAccountsClient struct {
+	grpc.ClientStream
+}
+
+func (x *accountAPIExportAccountsClient) Recv() (*httpbody.HttpBody, error) {
+	m := new(httpbody.HttpBody)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *accountAPIClient) DailyRegisteredUsers(ctx context.Context, in *DailyRegisteredUsersRequest, opts ...grpc.CallOption) (*CountStats, error) {
 	out := new(CountStats)
 	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/DailyRegisteredUsers", in, out, opts...)
@@ -271,6 +613,42 @@ func (c *accountAPIClient) DailyRegisteredUsers(ctx context.Context, in *DailyRe
 	return out, nil
 }
 
+func (c *accountAPIClient) GetRegistrationStats(ctx context.Context, in *AnalyticsRequest, opts ...grpc.CallOption) (*TimeSeries, error) {
+	out := new(TimeSeries)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/GetRegistrationStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) GetActiveUsersStats(ctx context.Context, in *AnalyticsRequest, opts ...grpc.CallOption) (*TimeSeries, error) {
+	out := new(TimeSeries)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/GetActiveUsersStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) GetAccountBreakdown(ctx context.Context, in *AnalyticsRequest, opts ...grpc.CallOption) (*AccountBreakdown, error) {
+	out := new(AccountBreakdown)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/GetAccountBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) GetRetentionCohorts(ctx context.Context, in *AnalyticsRequest, opts ...grpc.CallOption) (*RetentionCohorts, error) {
+	out := new(RetentionCohorts)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.AccountAPI/GetRetentionCohorts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountAPIServer is the server API for AccountAPI service.
 // All implementations must embed UnimplementedAccountAPIServer
 // for forward compatibility
@@ -279,12 +657,46 @@ type AccountAPIServer interface {
 	RequestSignInOTP(context.Context, *RequestSignInOTPRequest) (*empty.Empty, error)
 	// Sign in using provided OTPs
 	SignInOTP(context.Context, *SignInOTPRequest) (*SignInResponse, error)
+	// Emails a single use link for signing in without a password
+	RequestMagicLink(context.Context, *RequestMagicLinkRequest) (*empty.Empty, error)
+	// Sign in using the token of a magic link
+	SignInMagicLink(context.Context, *SignInMagicLinkRequest) (*SignInResponse, error)
 	// Signs in a user into their account
 	SignIn(context.Context, *SignInRequest) (*SignInResponse, error)
+	// Completes a sign in that requires a second factor
+	SignInMFA(context.Context, *SignInMFARequest) (*SignInResponse, error)
+	// Starts enrolling an account to TOTP two-factor authentication
+	EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPResponse, error)
+	// Confirms TOTP enrollment using a code from the authenticator app
+	ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*BackupCodes, error)
+	// Turns off TOTP two-factor authentication for an account
+	DisableTOTP(context.Context, *DisableTOTPRequest) (*empty.Empty, error)
+	// Replaces the one-time backup codes for an account
+	RegenerateBackupCodes(context.Context, *RegenerateBackupCodesRequest) (*BackupCodes, error)
 	// Signs in a user using third parties like Google, Facebook, Twitter etc
 	SignInExternal(context.Context, *SignInExternalRequest) (*SignInResponse, error)
 	// Fetch new JWT using refresh token and updates session
 	RefreshSession(context.Context, *RefreshSessionRequest) (*SignInResponse, error)
+	// Lists active sessions of an account
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// Revokes a single session of an account
+	RevokeSession(context.Context, *RevokeSessionRequest) (*empty.Empty, error)
+	// Revokes all sessions of an account
+	RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*empty.Empty, error)
+	// Registers an OpenID Connect client of a project
+	CreateOIDCClient(context.Context, *CreateOIDCClientRequest) (*OIDCClient, error)
+	// Lists OpenID Connect clients of a project
+	ListOIDCClients(context.Context, *ListOIDCClientsRequest) (*ListOIDCClientsResponse, error)
+	// Removes an OpenID Connect client
+	DeleteOIDCClient(context.Context, *DeleteOIDCClientRequest) (*empty.Empty, error)
+	// Invites a user to create an account in a project and group
+	CreateInvitation(context.Context, *CreateInvitationRequest) (*Invitation, error)
+	// Lists invitations of a project
+	ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error)
+	// Revokes an invitation so that it can no longer be accepted
+	RevokeInvitation(context.Context, *RevokeInvitationRequest) (*empty.Empty, error)
+	// Creates the account of an invited user and signs them in
+	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*SignInResponse, error)
 	// Creates an account for a new user
 	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
 	// Activates an account to being active
@@ -310,16 +722,48 @@ type AccountAPIServer interface {
 	BatchGetAccounts(context.Context, *BatchGetAccountsRequest) (*BatchGetAccountsResponse, error)
 	//  Retrieves deeply linked accounts
 	GetLinkedAccounts(context.Context, *GetLinkedAccountsRequest) (*GetLinkedAccountsResponse, error)
+	// Links an identity of an external provider to an account
+	LinkIdentity(context.Context, *LinkIdentityRequest) (*LinkedIdentity, error)
+	// Unlinks an identity from an account unless it is the last way to sign in
+	UnlinkIdentity(context.Context, *UnlinkIdentityRequest) (*empty.Empty, error)
+	// Lists identities linked to an account
+	ListIdentities(context.Context, *ListIdentitiesRequest) (*ListIdentitiesResponse, error)
+	// Builds an archive of all personal data of an account as a long running
+	// operation
+	RequestDataExport(context.Context, *RequestDataExportRequest) (*PersonalDataOperation, error)
+	// Downloads the archive built by a personal data export
+	GetDataExport(context.Context, *GetDataExportRequest) (*httpbody.HttpBody, error)
+	// Anonymises personal data of an account in every service as a long running
+	// operation
+	RequestErasure(context.Context, *RequestErasureRequest) (*PersonalDataOperation, error)
 	// Checks if an account exists
 	ExistAccount(context.Context, *ExistAccountRequest) (*ExistAccountResponse, error)
 	// Updates account
 	AdminUpdateAccount(context.Context, *AdminUpdateAccountRequest) (*empty.Empty, error)
+	// Issues a short lived token for an administrator to act as an account
+	ImpersonateAccount(context.Context, *ImpersonateAccountRequest) (*SignInResponse, error)
+	// Lists the audit trail of administrative account operations
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// Imports accounts from a CSV or XLSX file as a long running operation
+	ImportAccounts(context.Context, *ImportAccountsRequest) (*ImportAccountsResponse, error)
+	// Retrieves the per row report of an account import
+	GetImportReport(context.Context, *GetImportReportRequest) (*ImportReport, error)
 	// Fetches collection of accounts
 	ListAccounts(context.Context, *ListAccountsRequest) (*Accounts, error)
 	// Searches accounts and linked accounts
 	SearchAccounts(context.Context, *SearchAccountsRequest) (*Accounts, error)
+	// Streams accounts matching a criteria as a CSV, JSON Lines or XLSX file
+	ExportAccounts(*ExportAccountsRequest, AccountAPI_ExportAccountsServer) error
 	// Request to get daily users stats
 	DailyRegisteredUsers(context.Context, *DailyRegisteredUsersRequest) (*CountStats, error)
+	// Counts accounts registered in each time bucket
+	GetRegistrationStats(context.Context, *AnalyticsRequest) (*TimeSeries, error)
+	// Counts distinct accounts active in each time bucket
+	GetActiveUsersStats(context.Context, *AnalyticsRequest) (*TimeSeries, error)
+	// Counts accounts by state, gender and group
+	GetAccountBreakdown(context.Context, *AnalyticsRequest) (*AccountBreakdown, error)
+	// Retrieves the share of accounts registered in a time bucket that were active in later buckets
+	GetRetentionCohorts(context.Context, *AnalyticsRequest) (*RetentionCohorts, error)
 	mustEmbedUnimplementedAccountAPIServer()
 }
 
@@ -333,15 +777,66 @@ func (UnimplementedAccountAPIServer) RequestSignInOTP(context.Context, *RequestS
 func (UnimplementedAccountAPIServer) SignInOTP(context.Context, *SignInOTPRequest) (*SignInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignInOTP not implemented")
 }
+func (UnimplementedAccountAPIServer) RequestMagicLink(context.Context, *RequestMagicLinkRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestMagicLink not implemented")
+}
+func (UnimplementedAccountAPIServer) SignInMagicLink(context.Context, *SignInMagicLinkRequest) (*SignInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignInMagicLink not implemented")
+}
 func (UnimplementedAccountAPIServer) SignIn(context.Context, *SignInRequest) (*SignInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignIn not implemented")
 }
+func (UnimplementedAccountAPIServer) SignInMFA(context.Context, *SignInMFARequest) (*SignInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignInMFA not implemented")
+}
+func (UnimplementedAccountAPIServer) EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (UnimplementedAccountAPIServer) ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*BackupCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (UnimplementedAccountAPIServer) DisableTOTP(context.Context, *DisableTOTPRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (UnimplementedAccountAPIServer) RegenerateBackupCodes(context.Context, *RegenerateBackupCodesRequest) (*BackupCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateBackupCodes not implemented")
+}
 func (UnimplementedAccountAPIServer) SignInExternal(context.Context, *SignInExternalRequest) (*SignInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignInExternal not implemented")
 }
 func (UnimplementedAccountAPIServer) RefreshSession(context.Context, *RefreshSessionRequest) (*SignInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshSession not implemented")
 }
+func (UnimplementedAccountAPIServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedAccountAPIServer) RevokeSession(context.Context, *RevokeSessionRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedAccountAPIServer) RevokeAllSessions(context.Context, *RevokeAllSessionsRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllSessions not implemented")
+}
+func (UnimplementedAccountAPIServer) CreateOIDCClient(context.Context, *CreateOIDCClientRequest) (*OIDCClient, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOIDCClient not implemented")
+}
+func (UnimplementedAccountAPIServer) ListOIDCClients(context.Context, *ListOIDCClientsRequest) (*ListOIDCClientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOIDCClients not implemented")
+}
+func (UnimplementedAccountAPIServer) DeleteOIDCClient(context.Context, *DeleteOIDCClientRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOIDCClient not implemented")
+}
+func (UnimplementedAccountAPIServer) CreateInvitation(context.Context, *CreateInvitationRequest) (*Invitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvitation not implemented")
+}
+func (UnimplementedAccountAPIServer) ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvitations not implemented")
+}
+func (UnimplementedAccountAPIServer) RevokeInvitation(context.Context, *RevokeInvitationRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvitation not implemented")
+}
+func (UnimplementedAccountAPIServer) AcceptInvitation(context.Context, *AcceptInvitationRequest) (*SignInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvitation not implemented")
+}
 func (UnimplementedAccountAPIServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
 }
@@ -378,21 +873,66 @@ func (UnimplementedAccountAPIServer) BatchGetAccounts(context.Context, *BatchGet
 func (UnimplementedAccountAPIServer) GetLinkedAccounts(context.Context, *GetLinkedAccountsRequest) (*GetLinkedAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLinkedAccounts not implemented")
 }
+func (UnimplementedAccountAPIServer) LinkIdentity(context.Context, *LinkIdentityRequest) (*LinkedIdentity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkIdentity not implemented")
+}
+func (UnimplementedAccountAPIServer) UnlinkIdentity(context.Context, *UnlinkIdentityRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkIdentity not implemented")
+}
+func (UnimplementedAccountAPIServer) ListIdentities(context.Context, *ListIdentitiesRequest) (*ListIdentitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIdentities not implemented")
+}
+func (UnimplementedAccountAPIServer) RequestDataExport(context.Context, *RequestDataExportRequest) (*PersonalDataOperation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDataExport not implemented")
+}
+func (UnimplementedAccountAPIServer) GetDataExport(context.Context, *GetDataExportRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataExport not implemented")
+}
+func (UnimplementedAccountAPIServer) RequestErasure(context.Context, *RequestErasureRequest) (*PersonalDataOperation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestErasure not implemented")
+}
 func (UnimplementedAccountAPIServer) ExistAccount(context.Context, *ExistAccountRequest) (*ExistAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExistAccount not implemented")
 }
 func (UnimplementedAccountAPIServer) AdminUpdateAccount(context.Context, *AdminUpdateAccountRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminUpdateAccount not implemented")
 }
+func (UnimplementedAccountAPIServer) ImpersonateAccount(context.Context, *ImpersonateAccountRequest) (*SignInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImpersonateAccount not implemented")
+}
+func (UnimplementedAccountAPIServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedAccountAPIServer) ImportAccounts(context.Context, *ImportAccountsRequest) (*ImportAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportAccounts not implemented")
+}
+func (UnimplementedAccountAPIServer) GetImportReport(context.Context, *GetImportReportRequest) (*ImportReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportReport not implemented")
+}
 func (UnimplementedAccountAPIServer) ListAccounts(context.Context, *ListAccountsRequest) (*Accounts, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
 }
 func (UnimplementedAccountAPIServer) SearchAccounts(context.Context, *SearchAccountsRequest) (*Accounts, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchAccounts not implemented")
 }
+func (UnimplementedAccountAPIServer) ExportAccounts(*ExportAccountsRequest, AccountAPI_ExportAccountsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportAccounts not implemented")
+}
 func (UnimplementedAccountAPIServer) DailyRegisteredUsers(context.Context, *DailyRegisteredUsersRequest) (*CountStats, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DailyRegisteredUsers not implemented")
 }
+func (UnimplementedAccountAPIServer) GetRegistrationStats(context.Context, *AnalyticsRequest) (*TimeSeries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegistrationStats not implemented")
+}
+func (UnimplementedAccountAPIServer) GetActiveUsersStats(context.Context, *AnalyticsRequest) (*TimeSeries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveUsersStats not implemented")
+}
+func (UnimplementedAccountAPIServer) GetAccountBreakdown(context.Context, *AnalyticsRequest) (*AccountBreakdown, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountBreakdown not implemented")
+}
+func (UnimplementedAccountAPIServer) GetRetentionCohorts(context.Context, *AnalyticsRequest) (*RetentionCohorts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRetentionCohorts not implemented")
+}
 func (UnimplementedAccountAPIServer) mustEmbedUnimplementedAccountAPIServer() {}
 
 // UnsafeAccountAPIServer may be embedded to opt out of forward compatibility for this service.
@@ -442,6 +982,42 @@ func _AccountAPI_SignInOTP_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountAPI_RequestMagicLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestMagicLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).RequestMagicLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/RequestMagicLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).RequestMagicLink(ctx, req.(*RequestMagicLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_SignInMagicLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignInMagicLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).SignInMagicLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/SignInMagicLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).SignInMagicLink(ctx, req.(*SignInMagicLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AccountAPI_SignIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SignInRequest)
 	if err := dec(in); err != nil {
@@ -460,6 +1036,96 @@ func _AccountAPI_SignIn_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountAPI_SignInMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignInMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).SignInMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/SignInMFA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).SignInMFA(ctx, req.(*SignInMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/EnrollTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).EnrollTOTP(ctx, req.(*EnrollTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/ConfirmTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).ConfirmTOTP(ctx, req.(*ConfirmTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/DisableTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).DisableTOTP(ctx, req.(*DisableTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_RegenerateBackupCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateBackupCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).RegenerateBackupCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/RegenerateBackupCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).RegenerateBackupCodes(ctx, req.(*RegenerateBackupCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AccountAPI_SignInExternal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SignInExternalRequest)
 	if err := dec(in); err != nil {
@@ -496,6 +1162,186 @@ func _AccountAPI_RefreshSession_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountAPI_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/RevokeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_RevokeAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).RevokeAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/RevokeAllSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).RevokeAllSessions(ctx, req.(*RevokeAllSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_CreateOIDCClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOIDCClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).CreateOIDCClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/CreateOIDCClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).CreateOIDCClient(ctx, req.(*CreateOIDCClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_ListOIDCClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOIDCClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).ListOIDCClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/ListOIDCClients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).ListOIDCClients(ctx, req.(*ListOIDCClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_DeleteOIDCClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOIDCClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).DeleteOIDCClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/DeleteOIDCClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).DeleteOIDCClient(ctx, req.(*DeleteOIDCClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_CreateInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).CreateInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/CreateInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).CreateInvitation(ctx, req.(*CreateInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_ListInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).ListInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/ListInvitations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).ListInvitations(ctx, req.(*ListInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_RevokeInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).RevokeInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/RevokeInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).RevokeInvitation(ctx, req.(*RevokeInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).AcceptInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/AcceptInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).AcceptInvitation(ctx, req.(*AcceptInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AccountAPI_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateAccountRequest)
 	if err := dec(in); err != nil {
@@ -712,6 +1558,114 @@ func _AccountAPI_GetLinkedAccounts_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountAPI_LinkIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).LinkIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/LinkIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).LinkIdentity(ctx, req.(*LinkIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_UnlinkIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).UnlinkIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/UnlinkIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).UnlinkIdentity(ctx, req.(*UnlinkIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_ListIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIdentitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).ListIdentities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/ListIdentities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).ListIdentities(ctx, req.(*ListIdentitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_RequestDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).RequestDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/RequestDataExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).RequestDataExport(ctx, req.(*RequestDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_GetDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).GetDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/GetDataExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).GetDataExport(ctx, req.(*GetDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_RequestErasure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestErasureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).RequestErasure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/RequestErasure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).RequestErasure(ctx, req.(*RequestErasureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AccountAPI_ExistAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExistAccountRequest)
 	if err := dec(in); err != nil {
@@ -748,6 +1702,78 @@ func _AccountAPI_AdminUpdateAccount_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountAPI_ImpersonateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).ImpersonateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/ImpersonateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).ImpersonateAccount(ctx, req.(*ImpersonateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_ImportAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).ImportAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/ImportAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).ImportAccounts(ctx, req.(*ImportAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_GetImportReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).GetImportReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/GetImportReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).GetImportReport(ctx, req.(*GetImportReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AccountAPI_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAccountsRequest)
 	if err := dec(in); err != nil {
@@ -784,6 +1810,27 @@ func _AccountAPI_SearchAccounts_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountAPI_ExportAccounts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportAccountsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AccountAPIServer).ExportAccounts(m, &accountAPIExportAccountsServer{stream})
+}
+
+type AccountAPI_ExportAccountsServer interface {
+	Send(*httpbody.HttpBody) error
+	grpc.ServerStream
+}
+
+type accountAPIExportAccountsServer struct {
+	grpc.ServerStream
+}
+
+func (x *accountAPIExportAccountsServer) Send(m *httpbody.HttpBody) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _AccountAPI_DailyRegisteredUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DailyRegisteredUsersRequest)
 	if err := dec(in); err != nil {
@@ -802,6 +1849,78 @@ func _AccountAPI_DailyRegisteredUsers_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountAPI_GetRegistrationStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).GetRegistrationStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/GetRegistrationStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).GetRegistrationStats(ctx, req.(*AnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_GetActiveUsersStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).GetActiveUsersStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/GetActiveUsersStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).GetActiveUsersStats(ctx, req.(*AnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_GetAccountBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).GetAccountBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/GetAccountBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).GetAccountBreakdown(ctx, req.(*AnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_GetRetentionCohorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).GetRetentionCohorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.AccountAPI/GetRetentionCohorts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).GetRetentionCohorts(ctx, req.(*AnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AccountAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gidyon.apis.AccountAPI",
 	HandlerType: (*AccountAPIServer)(nil),
@@ -814,10 +1933,38 @@ var _AccountAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SignInOTP",
 			Handler:    _AccountAPI_SignInOTP_Handler,
 		},
+		{
+			MethodName: "RequestMagicLink",
+			Handler:    _AccountAPI_RequestMagicLink_Handler,
+		},
+		{
+			MethodName: "SignInMagicLink",
+			Handler:    _AccountAPI_SignInMagicLink_Handler,
+		},
 		{
 			MethodName: "SignIn",
 			Handler:    _AccountAPI_SignIn_Handler,
 		},
+		{
+			MethodName: "SignInMFA",
+			Handler:    _AccountAPI_SignInMFA_Handler,
+		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _AccountAPI_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _AccountAPI_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _AccountAPI_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "RegenerateBackupCodes",
+			Handler:    _AccountAPI_RegenerateBackupCodes_Handler,
+		},
 		{
 			MethodName: "SignInExternal",
 			Handler:    _AccountAPI_SignInExternal_Handler,
@@ -826,6 +1973,46 @@ var _AccountAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshSession",
 			Handler:    _AccountAPI_RefreshSession_Handler,
 		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _AccountAPI_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _AccountAPI_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllSessions",
+			Handler:    _AccountAPI_RevokeAllSessions_Handler,
+		},
+		{
+			MethodName: "CreateOIDCClient",
+			Handler:    _AccountAPI_CreateOIDCClient_Handler,
+		},
+		{
+			MethodName: "ListOIDCClients",
+			Handler:    _AccountAPI_ListOIDCClients_Handler,
+		},
+		{
+			MethodName: "DeleteOIDCClient",
+			Handler:    _AccountAPI_DeleteOIDCClient_Handler,
+		},
+		{
+			MethodName: "CreateInvitation",
+			Handler:    _AccountAPI_CreateInvitation_Handler,
+		},
+		{
+			MethodName: "ListInvitations",
+			Handler:    _AccountAPI_ListInvitations_Handler,
+		},
+		{
+			MethodName: "RevokeInvitation",
+			Handler:    _AccountAPI_RevokeInvitation_Handler,
+		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _AccountAPI_AcceptInvitation_Handler,
+		},
 		{
 			MethodName: "CreateAccount",
 			Handler:    _AccountAPI_CreateAccount_Handler,
@@ -874,6 +2061,30 @@ var _AccountAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetLinkedAccounts",
 			Handler:    _AccountAPI_GetLinkedAccounts_Handler,
 		},
+		{
+			MethodName: "LinkIdentity",
+			Handler:    _AccountAPI_LinkIdentity_Handler,
+		},
+		{
+			MethodName: "UnlinkIdentity",
+			Handler:    _AccountAPI_UnlinkIdentity_Handler,
+		},
+		{
+			MethodName: "ListIdentities",
+			Handler:    _AccountAPI_ListIdentities_Handler,
+		},
+		{
+			MethodName: "RequestDataExport",
+			Handler:    _AccountAPI_RequestDataExport_Handler,
+		},
+		{
+			MethodName: "GetDataExport",
+			Handler:    _AccountAPI_GetDataExport_Handler,
+		},
+		{
+			MethodName: "RequestErasure",
+			Handler:    _AccountAPI_RequestErasure_Handler,
+		},
 		{
 			MethodName: "ExistAccount",
 			Handler:    _AccountAPI_ExistAccount_Handler,
@@ -882,6 +2093,22 @@ var _AccountAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AdminUpdateAccount",
 			Handler:    _AccountAPI_AdminUpdateAccount_Handler,
 		},
+		{
+			MethodName: "ImpersonateAccount",
+			Handler:    _AccountAPI_ImpersonateAccount_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _AccountAPI_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "ImportAccounts",
+			Handler:    _AccountAPI_ImportAccounts_Handler,
+		},
+		{
+			MethodName: "GetImportReport",
+			Handler:    _AccountAPI_GetImportReport_Handler,
+		},
 		{
 			MethodName: "ListAccounts",
 			Handler:    _AccountAPI_ListAccounts_Handler,
@@ -894,7 +2121,29 @@ var _AccountAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DailyRegisteredUsers",
 			Handler:    _AccountAPI_DailyRegisteredUsers_Handler,
 		},
+		{
+			MethodName: "GetRegistrationStats",
+			Handler:    _AccountAPI_GetRegistrationStats_Handler,
+		},
+		{
+			MethodName: "GetActiveUsersStats",
+			Handler:    _AccountAPI_GetActiveUsersStats_Handler,
+		},
+		{
+			MethodName: "GetAccountBreakdown",
+			Handler:    _AccountAPI_GetAccountBreakdown_Handler,
+		},
+		{
+			MethodName: "GetRetentionCohorts",
+			Handler:    _AccountAPI_GetRetentionCohorts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportAccounts",
+			Handler:       _AccountAPI_ExportAccounts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "account.proto",
 }
@@ -28,6 +28,10 @@ type MessagingClient interface {
 	ReadAll(ctx context.Context, in *MessageRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Fetches count of new messages
 	GetNewMessagesCount(ctx context.Context, in *MessageRequest, opts ...grpc.CallOption) (*NewMessagesCount, error)
+	// Anonymises the content of messages saved for a user
+	EraseMessages(ctx context.Context, in *MessageRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Permanently deletes messages saved for a user
+	DeleteMessages(ctx context.Context, in *MessageRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type messagingClient struct {
@@ -83,6 +87,24 @@ func (c *messagingClient) GetNewMessagesCount(ctx context.Context, in *MessageRe
 	return out, nil
 }
 
+func (c *messagingClient) EraseMessages(ctx context.Context, in *MessageRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.Messaging/EraseMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messagingClient) DeleteMessages(ctx context.Context, in *MessageRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.Messaging/DeleteMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessagingServer is the server API for Messaging service.
 // All implementations must embed UnimplementedMessagingServer
 // for forward compatibility
@@ -97,6 +119,10 @@ type MessagingServer interface {
 	ReadAll(context.Context, *MessageRequest) (*empty.Empty, error)
 	// Fetches count of new messages
 	GetNewMessagesCount(context.Context, *MessageRequest) (*NewMessagesCount, error)
+	// Anonymises the content of messages saved for a user
+	EraseMessages(context.Context, *MessageRequest) (*empty.Empty, error)
+	// Permanently deletes messages saved for a user
+	DeleteMessages(context.Context, *MessageRequest) (*empty.Empty, error)
 	mustEmbedUnimplementedMessagingServer()
 }
 
@@ -119,6 +145,12 @@ func (UnimplementedMessagingServer) ReadAll(context.Context, *MessageRequest) (*
 func (UnimplementedMessagingServer) GetNewMessagesCount(context.Context, *MessageRequest) (*NewMessagesCount, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNewMessagesCount not implemented")
 }
+func (UnimplementedMessagingServer) EraseMessages(context.Context, *MessageRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseMessages not implemented")
+}
+func (UnimplementedMessagingServer) DeleteMessages(context.Context, *MessageRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMessages not implemented")
+}
 func (UnimplementedMessagingServer) mustEmbedUnimplementedMessagingServer() {}
 
 // UnsafeMessagingServer may be embedded to opt out of forward compatibility for this service.
@@ -222,6 +254,42 @@ func _Messaging_GetNewMessagesCount_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Messaging_EraseMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServer).EraseMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.Messaging/EraseMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServer).EraseMessages(ctx, req.(*MessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Messaging_DeleteMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessagingServer).DeleteMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.Messaging/DeleteMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessagingServer).DeleteMessages(ctx, req.(*MessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Messaging_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gidyon.apis.Messaging",
 	HandlerType: (*MessagingServer)(nil),
@@ -246,6 +314,14 @@ var _Messaging_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetNewMessagesCount",
 			Handler:    _Messaging_GetNewMessagesCount_Handler,
 		},
+		{
+			MethodName: "EraseMessages",
+			Handler:    _Messaging_EraseMessages_Handler,
+		},
+		{
+			MethodName: "DeleteMessages",
+			Handler:    _Messaging_DeleteMessages_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "messaging.proto",
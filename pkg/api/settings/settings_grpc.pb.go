@@ -4,6 +4,7 @@ package settings
 
 import (
 	context "context"
+	empty "github.com/golang/protobuf/ptypes/empty"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -21,6 +22,8 @@ type SettingsAPIClient interface {
 	UpdateSetting(ctx context.Context, in *UpdateSettingRequest, opts ...grpc.CallOption) (*UpdateSettingResponse, error)
 	// Retrieves a collection of settings resource
 	GetSettings(ctx context.Context, in *GetSettingsRequest, opts ...grpc.CallOption) (*GetSettingsResponse, error)
+	// Erases all settings of a party
+	EraseSettings(ctx context.Context, in *EraseSettingsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type settingsAPIClient struct {
@@ -49,6 +52,15 @@ func (c *settingsAPIClient) GetSettings(ctx context.Context, in *GetSettingsRequ
 	return out, nil
 }
 
+func (c *settingsAPIClient) EraseSettings(ctx context.Context, in *EraseSettingsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.SettingsAPI/EraseSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SettingsAPIServer is the server API for SettingsAPI service.
 // All implementations must embed UnimplementedSettingsAPIServer
 // for forward compatibility
@@ -57,6 +69,8 @@ type SettingsAPIServer interface {
 	UpdateSetting(context.Context, *UpdateSettingRequest) (*UpdateSettingResponse, error)
 	// Retrieves a collection of settings resource
 	GetSettings(context.Context, *GetSettingsRequest) (*GetSettingsResponse, error)
+	// Erases all settings of a party
+	EraseSettings(context.Context, *EraseSettingsRequest) (*empty.Empty, error)
 	mustEmbedUnimplementedSettingsAPIServer()
 }
 
@@ -70,6 +84,9 @@ func (UnimplementedSettingsAPIServer) UpdateSetting(context.Context, *UpdateSett
 func (UnimplementedSettingsAPIServer) GetSettings(context.Context, *GetSettingsRequest) (*GetSettingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSettings not implemented")
 }
+func (UnimplementedSettingsAPIServer) EraseSettings(context.Context, *EraseSettingsRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseSettings not implemented")
+}
 func (UnimplementedSettingsAPIServer) mustEmbedUnimplementedSettingsAPIServer() {}
 
 // UnsafeSettingsAPIServer may be embedded to opt out of forward compatibility for this service.
@@ -119,6 +136,24 @@ func _SettingsAPI_GetSettings_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SettingsAPI_EraseSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsAPIServer).EraseSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.SettingsAPI/EraseSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsAPIServer).EraseSettings(ctx, req.(*EraseSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SettingsAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gidyon.apis.SettingsAPI",
 	HandlerType: (*SettingsAPIServer)(nil),
@@ -131,6 +166,10 @@ var _SettingsAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSettings",
 			Handler:    _SettingsAPI_GetSettings_Handler,
 		},
+		{
+			MethodName: "EraseSettings",
+			Handler:    _SettingsAPI_EraseSettings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "settings.proto",
@@ -26,6 +26,8 @@ type SubscriberAPIClient interface {
 	ListSubscribers(ctx context.Context, in *ListSubscribersRequest, opts ...grpc.CallOption) (*ListSubscribersResponse, error)
 	// GetSubscriber retrieves information about a single subscriber
 	GetSubscriber(ctx context.Context, in *GetSubscriberRequest, opts ...grpc.CallOption) (*Subscriber, error)
+	// Removes a subscriber from all channels, including subscribers without an account
+	DeleteSubscriber(ctx context.Context, in *DeleteSubscriberRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type subscriberAPIClient struct {
@@ -72,6 +74,15 @@ func (c *subscriberAPIClient) GetSubscriber(ctx context.Context, in *GetSubscrib
 	return out, nil
 }
 
+func (c *subscriberAPIClient) DeleteSubscriber(ctx context.Context, in *DeleteSubscriberRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/gidyon.apis.SubscriberAPI/DeleteSubscriber", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SubscriberAPIServer is the server API for SubscriberAPI service.
 // All implementations must embed UnimplementedSubscriberAPIServer
 // for forward compatibility
@@ -84,6 +95,8 @@ type SubscriberAPIServer interface {
 	ListSubscribers(context.Context, *ListSubscribersRequest) (*ListSubscribersResponse, error)
 	// GetSubscriber retrieves information about a single subscriber
 	GetSubscriber(context.Context, *GetSubscriberRequest) (*Subscriber, error)
+	// Removes a subscriber from all channels, including subscribers without an account
+	DeleteSubscriber(context.Context, *DeleteSubscriberRequest) (*empty.Empty, error)
 	mustEmbedUnimplementedSubscriberAPIServer()
 }
 
@@ -103,6 +116,9 @@ func (UnimplementedSubscriberAPIServer) ListSubscribers(context.Context, *ListSu
 func (UnimplementedSubscriberAPIServer) GetSubscriber(context.Context, *GetSubscriberRequest) (*Subscriber, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSubscriber not implemented")
 }
+func (UnimplementedSubscriberAPIServer) DeleteSubscriber(context.Context, *DeleteSubscriberRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubscriber not implemented")
+}
 func (UnimplementedSubscriberAPIServer) mustEmbedUnimplementedSubscriberAPIServer() {}
 
 // UnsafeSubscriberAPIServer may be embedded to opt out of forward compatibility for this service.
@@ -188,6 +204,24 @@ func _SubscriberAPI_GetSubscriber_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubscriberAPI_DeleteSubscriber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubscriberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriberAPIServer).DeleteSubscriber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.apis.SubscriberAPI/DeleteSubscriber",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriberAPIServer).DeleteSubscriber(ctx, req.(*DeleteSubscriberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SubscriberAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gidyon.apis.SubscriberAPI",
 	HandlerType: (*SubscriberAPIServer)(nil),
@@ -208,6 +242,10 @@ var _SubscriberAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSubscriber",
 			Handler:    _SubscriberAPI_GetSubscriber_Handler,
 		},
+		{
+			MethodName: "DeleteSubscriber",
+			Handler:    _SubscriberAPI_DeleteSubscriber_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "subscriber.proto",
@@ -27,4 +27,8 @@ func init() {
 		Return(&empty.Empty{}, nil)
 	MessagingAPI.On("GetNewMessagesCount", mock.Anything, mock.Anything, mock.Anything).
 		Return(&messaging.NewMessagesCount{Count: 5}, nil)
+	MessagingAPI.On("EraseMessages", mock.Anything, mock.Anything, mock.Anything).
+		Return(&empty.Empty{}, nil)
+	MessagingAPI.On("DeleteMessages", mock.Anything, mock.Anything, mock.Anything).
+		Return(&empty.Empty{}, nil)
 }
@@ -11,6 +11,8 @@ import (
 
 	grpc "google.golang.org/grpc"
 
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -19,6 +21,36 @@ type AccountAPIMock struct {
 	mock.Mock
 }
 
+// AcceptInvitation provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) AcceptInvitation(ctx context.Context, in *account.AcceptInvitationRequest, opts ...grpc.CallOption) (*account.SignInResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.SignInResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *account.AcceptInvitationRequest, ...grpc.CallOption) *account.SignInResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.SignInResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.AcceptInvitationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ActivateAccount provides a mock function with given fields: ctx, in, opts
 func (_m *AccountAPIMock) ActivateAccount(ctx context.Context, in *account.ActivateAccountRequest, opts ...grpc.CallOption) (*account.ActivateAccountResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -139,6 +171,36 @@ func (_m *AccountAPIMock) BatchGetAccounts(ctx context.Context, in *account.Batc
 	return r0, r1
 }
 
+// ConfirmTOTP provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) ConfirmTOTP(ctx context.Context, in *account.ConfirmTOTPRequest, opts ...grpc.CallOption) (*account.BackupCodes, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.BackupCodes
+	if rf, ok := ret.Get(0).(func(context.Context, *account.ConfirmTOTPRequest, ...grpc.CallOption) *account.BackupCodes); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.BackupCodes)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.ConfirmTOTPRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateAccount provides a mock function with given fields: ctx, in, opts
 func (_m *AccountAPIMock) CreateAccount(ctx context.Context, in *account.CreateAccountRequest, opts ...grpc.CallOption) (*account.CreateAccountResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -169,6 +231,66 @@ func (_m *AccountAPIMock) CreateAccount(ctx context.Context, in *account.CreateA
 	return r0, r1
 }
 
+// CreateInvitation provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) CreateInvitation(ctx context.Context, in *account.CreateInvitationRequest, opts ...grpc.CallOption) (*account.Invitation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.Invitation
+	if rf, ok := ret.Get(0).(func(context.Context, *account.CreateInvitationRequest, ...grpc.CallOption) *account.Invitation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.Invitation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.CreateInvitationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateOIDCClient provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) CreateOIDCClient(ctx context.Context, in *account.CreateOIDCClientRequest, opts ...grpc.CallOption) (*account.OIDCClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.OIDCClient
+	if rf, ok := ret.Get(0).(func(context.Context, *account.CreateOIDCClientRequest, ...grpc.CallOption) *account.OIDCClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.OIDCClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.CreateOIDCClientRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DailyRegisteredUsers provides a mock function with given fields: ctx, in, opts
 func (_m *AccountAPIMock) DailyRegisteredUsers(ctx context.Context, in *account.DailyRegisteredUsersRequest, opts ...grpc.CallOption) (*account.CountStats, error) {
 	_va := make([]interface{}, len(opts))
@@ -229,6 +351,96 @@ func (_m *AccountAPIMock) DeleteAccount(ctx context.Context, in *account.DeleteA
 	return r0, r1
 }
 
+// DeleteOIDCClient provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) DeleteOIDCClient(ctx context.Context, in *account.DeleteOIDCClientRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *account.DeleteOIDCClientRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.DeleteOIDCClientRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableTOTP provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) DisableTOTP(ctx context.Context, in *account.DisableTOTPRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *account.DisableTOTPRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.DisableTOTPRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnrollTOTP provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) EnrollTOTP(ctx context.Context, in *account.EnrollTOTPRequest, opts ...grpc.CallOption) (*account.EnrollTOTPResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.EnrollTOTPResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *account.EnrollTOTPRequest, ...grpc.CallOption) *account.EnrollTOTPResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.EnrollTOTPResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.EnrollTOTPRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ExistAccount provides a mock function with given fields: ctx, in, opts
 func (_m *AccountAPIMock) ExistAccount(ctx context.Context, in *account.ExistAccountRequest, opts ...grpc.CallOption) (*account.ExistAccountResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -259,8 +471,8 @@ func (_m *AccountAPIMock) ExistAccount(ctx context.Context, in *account.ExistAcc
 	return r0, r1
 }
 
-// GetAccount provides a mock function with given fields: ctx, in, opts
-func (_m *AccountAPIMock) GetAccount(ctx context.Context, in *account.GetAccountRequest, opts ...grpc.CallOption) (*account.Account, error) {
+// ExportAccounts provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) ExportAccounts(ctx context.Context, in *account.ExportAccountsRequest, opts ...grpc.CallOption) (account.AccountAPI_ExportAccountsClient, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -270,17 +482,17 @@ func (_m *AccountAPIMock) GetAccount(ctx context.Context, in *account.GetAccount
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *account.Account
-	if rf, ok := ret.Get(0).(func(context.Context, *account.GetAccountRequest, ...grpc.CallOption) *account.Account); ok {
+	var r0 account.AccountAPI_ExportAccountsClient
+	if rf, ok := ret.Get(0).(func(context.Context, *account.ExportAccountsRequest, ...grpc.CallOption) account.AccountAPI_ExportAccountsClient); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*account.Account)
+			r0 = ret.Get(0).(account.AccountAPI_ExportAccountsClient)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *account.GetAccountRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *account.ExportAccountsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -289,8 +501,8 @@ func (_m *AccountAPIMock) GetAccount(ctx context.Context, in *account.GetAccount
 	return r0, r1
 }
 
-// GetLinkedAccounts provides a mock function with given fields: ctx, in, opts
-func (_m *AccountAPIMock) GetLinkedAccounts(ctx context.Context, in *account.GetLinkedAccountsRequest, opts ...grpc.CallOption) (*account.GetLinkedAccountsResponse, error) {
+// GetAccount provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) GetAccount(ctx context.Context, in *account.GetAccountRequest, opts ...grpc.CallOption) (*account.Account, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -300,17 +512,17 @@ func (_m *AccountAPIMock) GetLinkedAccounts(ctx context.Context, in *account.Get
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *account.GetLinkedAccountsResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *account.GetLinkedAccountsRequest, ...grpc.CallOption) *account.GetLinkedAccountsResponse); ok {
+	var r0 *account.Account
+	if rf, ok := ret.Get(0).(func(context.Context, *account.GetAccountRequest, ...grpc.CallOption) *account.Account); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*account.GetLinkedAccountsResponse)
+			r0 = ret.Get(0).(*account.Account)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *account.GetLinkedAccountsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *account.GetAccountRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -319,8 +531,8 @@ func (_m *AccountAPIMock) GetLinkedAccounts(ctx context.Context, in *account.Get
 	return r0, r1
 }
 
-// ListAccounts provides a mock function with given fields: ctx, in, opts
-func (_m *AccountAPIMock) ListAccounts(ctx context.Context, in *account.ListAccountsRequest, opts ...grpc.CallOption) (*account.Accounts, error) {
+// GetAccountBreakdown provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) GetAccountBreakdown(ctx context.Context, in *account.AnalyticsRequest, opts ...grpc.CallOption) (*account.AccountBreakdown, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -330,17 +542,17 @@ func (_m *AccountAPIMock) ListAccounts(ctx context.Context, in *account.ListAcco
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *account.Accounts
-	if rf, ok := ret.Get(0).(func(context.Context, *account.ListAccountsRequest, ...grpc.CallOption) *account.Accounts); ok {
+	var r0 *account.AccountBreakdown
+	if rf, ok := ret.Get(0).(func(context.Context, *account.AnalyticsRequest, ...grpc.CallOption) *account.AccountBreakdown); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*account.Accounts)
+			r0 = ret.Get(0).(*account.AccountBreakdown)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *account.ListAccountsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *account.AnalyticsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -349,8 +561,8 @@ func (_m *AccountAPIMock) ListAccounts(ctx context.Context, in *account.ListAcco
 	return r0, r1
 }
 
-// RefreshSession provides a mock function with given fields: ctx, in, opts
-func (_m *AccountAPIMock) RefreshSession(ctx context.Context, in *account.RefreshSessionRequest, opts ...grpc.CallOption) (*account.SignInResponse, error) {
+// GetActiveUsersStats provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) GetActiveUsersStats(ctx context.Context, in *account.AnalyticsRequest, opts ...grpc.CallOption) (*account.TimeSeries, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -360,17 +572,17 @@ func (_m *AccountAPIMock) RefreshSession(ctx context.Context, in *account.Refres
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *account.SignInResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *account.RefreshSessionRequest, ...grpc.CallOption) *account.SignInResponse); ok {
+	var r0 *account.TimeSeries
+	if rf, ok := ret.Get(0).(func(context.Context, *account.AnalyticsRequest, ...grpc.CallOption) *account.TimeSeries); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*account.SignInResponse)
+			r0 = ret.Get(0).(*account.TimeSeries)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *account.RefreshSessionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *account.AnalyticsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -379,8 +591,8 @@ func (_m *AccountAPIMock) RefreshSession(ctx context.Context, in *account.Refres
 	return r0, r1
 }
 
-// RequestActivateAccountOTP provides a mock function with given fields: ctx, in, opts
-func (_m *AccountAPIMock) RequestActivateAccountOTP(ctx context.Context, in *account.RequestActivateAccountOTPRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+// GetDataExport provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) GetDataExport(ctx context.Context, in *account.GetDataExportRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -390,17 +602,17 @@ func (_m *AccountAPIMock) RequestActivateAccountOTP(ctx context.Context, in *acc
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *emptypb.Empty
-	if rf, ok := ret.Get(0).(func(context.Context, *account.RequestActivateAccountOTPRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+	var r0 *httpbody.HttpBody
+	if rf, ok := ret.Get(0).(func(context.Context, *account.GetDataExportRequest, ...grpc.CallOption) *httpbody.HttpBody); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*emptypb.Empty)
+			r0 = ret.Get(0).(*httpbody.HttpBody)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *account.RequestActivateAccountOTPRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *account.GetDataExportRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -409,8 +621,8 @@ func (_m *AccountAPIMock) RequestActivateAccountOTP(ctx context.Context, in *acc
 	return r0, r1
 }
 
-// RequestChangePrivateAccount provides a mock function with given fields: ctx, in, opts
-func (_m *AccountAPIMock) RequestChangePrivateAccount(ctx context.Context, in *account.RequestChangePrivateAccountRequest, opts ...grpc.CallOption) (*account.RequestChangePrivateAccountResponse, error) {
+// GetImportReport provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) GetImportReport(ctx context.Context, in *account.GetImportReportRequest, opts ...grpc.CallOption) (*account.ImportReport, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -420,17 +632,17 @@ func (_m *AccountAPIMock) RequestChangePrivateAccount(ctx context.Context, in *a
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *account.RequestChangePrivateAccountResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *account.RequestChangePrivateAccountRequest, ...grpc.CallOption) *account.RequestChangePrivateAccountResponse); ok {
+	var r0 *account.ImportReport
+	if rf, ok := ret.Get(0).(func(context.Context, *account.GetImportReportRequest, ...grpc.CallOption) *account.ImportReport); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*account.RequestChangePrivateAccountResponse)
+			r0 = ret.Get(0).(*account.ImportReport)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *account.RequestChangePrivateAccountRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *account.GetImportReportRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -439,8 +651,8 @@ func (_m *AccountAPIMock) RequestChangePrivateAccount(ctx context.Context, in *a
 	return r0, r1
 }
 
-// RequestSignInOTP provides a mock function with given fields: ctx, in, opts
-func (_m *AccountAPIMock) RequestSignInOTP(ctx context.Context, in *account.RequestSignInOTPRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+// GetLinkedAccounts provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) GetLinkedAccounts(ctx context.Context, in *account.GetLinkedAccountsRequest, opts ...grpc.CallOption) (*account.GetLinkedAccountsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -450,17 +662,17 @@ func (_m *AccountAPIMock) RequestSignInOTP(ctx context.Context, in *account.Requ
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *emptypb.Empty
-	if rf, ok := ret.Get(0).(func(context.Context, *account.RequestSignInOTPRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+	var r0 *account.GetLinkedAccountsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *account.GetLinkedAccountsRequest, ...grpc.CallOption) *account.GetLinkedAccountsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*emptypb.Empty)
+			r0 = ret.Get(0).(*account.GetLinkedAccountsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *account.RequestSignInOTPRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *account.GetLinkedAccountsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -469,8 +681,8 @@ func (_m *AccountAPIMock) RequestSignInOTP(ctx context.Context, in *account.Requ
 	return r0, r1
 }
 
-// SearchAccounts provides a mock function with given fields: ctx, in, opts
-func (_m *AccountAPIMock) SearchAccounts(ctx context.Context, in *account.SearchAccountsRequest, opts ...grpc.CallOption) (*account.Accounts, error) {
+// GetRegistrationStats provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) GetRegistrationStats(ctx context.Context, in *account.AnalyticsRequest, opts ...grpc.CallOption) (*account.TimeSeries, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -480,17 +692,17 @@ func (_m *AccountAPIMock) SearchAccounts(ctx context.Context, in *account.Search
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *account.Accounts
-	if rf, ok := ret.Get(0).(func(context.Context, *account.SearchAccountsRequest, ...grpc.CallOption) *account.Accounts); ok {
+	var r0 *account.TimeSeries
+	if rf, ok := ret.Get(0).(func(context.Context, *account.AnalyticsRequest, ...grpc.CallOption) *account.TimeSeries); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*account.Accounts)
+			r0 = ret.Get(0).(*account.TimeSeries)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *account.SearchAccountsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *account.AnalyticsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -499,8 +711,8 @@ func (_m *AccountAPIMock) SearchAccounts(ctx context.Context, in *account.Search
 	return r0, r1
 }
 
-// SignIn provides a mock function with given fields: ctx, in, opts
-func (_m *AccountAPIMock) SignIn(ctx context.Context, in *account.SignInRequest, opts ...grpc.CallOption) (*account.SignInResponse, error) {
+// GetRetentionCohorts provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) GetRetentionCohorts(ctx context.Context, in *account.AnalyticsRequest, opts ...grpc.CallOption) (*account.RetentionCohorts, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -510,17 +722,17 @@ func (_m *AccountAPIMock) SignIn(ctx context.Context, in *account.SignInRequest,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *account.SignInResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *account.SignInRequest, ...grpc.CallOption) *account.SignInResponse); ok {
+	var r0 *account.RetentionCohorts
+	if rf, ok := ret.Get(0).(func(context.Context, *account.AnalyticsRequest, ...grpc.CallOption) *account.RetentionCohorts); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*account.SignInResponse)
+			r0 = ret.Get(0).(*account.RetentionCohorts)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *account.SignInRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *account.AnalyticsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -529,8 +741,8 @@ func (_m *AccountAPIMock) SignIn(ctx context.Context, in *account.SignInRequest,
 	return r0, r1
 }
 
-// SignInExternal provides a mock function with given fields: ctx, in, opts
-func (_m *AccountAPIMock) SignInExternal(ctx context.Context, in *account.SignInExternalRequest, opts ...grpc.CallOption) (*account.SignInResponse, error) {
+// ImpersonateAccount provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) ImpersonateAccount(ctx context.Context, in *account.ImpersonateAccountRequest, opts ...grpc.CallOption) (*account.SignInResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -541,7 +753,7 @@ func (_m *AccountAPIMock) SignInExternal(ctx context.Context, in *account.SignIn
 	ret := _m.Called(_ca...)
 
 	var r0 *account.SignInResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *account.SignInExternalRequest, ...grpc.CallOption) *account.SignInResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *account.ImpersonateAccountRequest, ...grpc.CallOption) *account.SignInResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -550,7 +762,7 @@ func (_m *AccountAPIMock) SignInExternal(ctx context.Context, in *account.SignIn
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *account.SignInExternalRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *account.ImpersonateAccountRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -559,8 +771,8 @@ func (_m *AccountAPIMock) SignInExternal(ctx context.Context, in *account.SignIn
 	return r0, r1
 }
 
-// SignInOTP provides a mock function with given fields: ctx, in, opts
-func (_m *AccountAPIMock) SignInOTP(ctx context.Context, in *account.SignInOTPRequest, opts ...grpc.CallOption) (*account.SignInResponse, error) {
+// ImportAccounts provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) ImportAccounts(ctx context.Context, in *account.ImportAccountsRequest, opts ...grpc.CallOption) (*account.ImportAccountsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -570,17 +782,767 @@ func (_m *AccountAPIMock) SignInOTP(ctx context.Context, in *account.SignInOTPRe
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *account.SignInResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *account.SignInOTPRequest, ...grpc.CallOption) *account.SignInResponse); ok {
+	var r0 *account.ImportAccountsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *account.ImportAccountsRequest, ...grpc.CallOption) *account.ImportAccountsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*account.SignInResponse)
+			r0 = ret.Get(0).(*account.ImportAccountsResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *account.SignInOTPRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *account.ImportAccountsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkIdentity provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) LinkIdentity(ctx context.Context, in *account.LinkIdentityRequest, opts ...grpc.CallOption) (*account.LinkedIdentity, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.LinkedIdentity
+	if rf, ok := ret.Get(0).(func(context.Context, *account.LinkIdentityRequest, ...grpc.CallOption) *account.LinkedIdentity); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.LinkedIdentity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.LinkIdentityRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAccounts provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) ListAccounts(ctx context.Context, in *account.ListAccountsRequest, opts ...grpc.CallOption) (*account.Accounts, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.Accounts
+	if rf, ok := ret.Get(0).(func(context.Context, *account.ListAccountsRequest, ...grpc.CallOption) *account.Accounts); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.Accounts)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.ListAccountsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAuditEvents provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) ListAuditEvents(ctx context.Context, in *account.ListAuditEventsRequest, opts ...grpc.CallOption) (*account.ListAuditEventsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.ListAuditEventsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *account.ListAuditEventsRequest, ...grpc.CallOption) *account.ListAuditEventsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.ListAuditEventsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.ListAuditEventsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListIdentities provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) ListIdentities(ctx context.Context, in *account.ListIdentitiesRequest, opts ...grpc.CallOption) (*account.ListIdentitiesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.ListIdentitiesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *account.ListIdentitiesRequest, ...grpc.CallOption) *account.ListIdentitiesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.ListIdentitiesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.ListIdentitiesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListInvitations provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) ListInvitations(ctx context.Context, in *account.ListInvitationsRequest, opts ...grpc.CallOption) (*account.ListInvitationsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.ListInvitationsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *account.ListInvitationsRequest, ...grpc.CallOption) *account.ListInvitationsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.ListInvitationsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.ListInvitationsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOIDCClients provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) ListOIDCClients(ctx context.Context, in *account.ListOIDCClientsRequest, opts ...grpc.CallOption) (*account.ListOIDCClientsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.ListOIDCClientsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *account.ListOIDCClientsRequest, ...grpc.CallOption) *account.ListOIDCClientsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.ListOIDCClientsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.ListOIDCClientsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSessions provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) ListSessions(ctx context.Context, in *account.ListSessionsRequest, opts ...grpc.CallOption) (*account.ListSessionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.ListSessionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *account.ListSessionsRequest, ...grpc.CallOption) *account.ListSessionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.ListSessionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.ListSessionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshSession provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) RefreshSession(ctx context.Context, in *account.RefreshSessionRequest, opts ...grpc.CallOption) (*account.SignInResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.SignInResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *account.RefreshSessionRequest, ...grpc.CallOption) *account.SignInResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.SignInResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.RefreshSessionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegenerateBackupCodes provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) RegenerateBackupCodes(ctx context.Context, in *account.RegenerateBackupCodesRequest, opts ...grpc.CallOption) (*account.BackupCodes, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.BackupCodes
+	if rf, ok := ret.Get(0).(func(context.Context, *account.RegenerateBackupCodesRequest, ...grpc.CallOption) *account.BackupCodes); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.BackupCodes)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.RegenerateBackupCodesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestActivateAccountOTP provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) RequestActivateAccountOTP(ctx context.Context, in *account.RequestActivateAccountOTPRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *account.RequestActivateAccountOTPRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.RequestActivateAccountOTPRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestChangePrivateAccount provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) RequestChangePrivateAccount(ctx context.Context, in *account.RequestChangePrivateAccountRequest, opts ...grpc.CallOption) (*account.RequestChangePrivateAccountResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.RequestChangePrivateAccountResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *account.RequestChangePrivateAccountRequest, ...grpc.CallOption) *account.RequestChangePrivateAccountResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.RequestChangePrivateAccountResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.RequestChangePrivateAccountRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestDataExport provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) RequestDataExport(ctx context.Context, in *account.RequestDataExportRequest, opts ...grpc.CallOption) (*account.PersonalDataOperation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.PersonalDataOperation
+	if rf, ok := ret.Get(0).(func(context.Context, *account.RequestDataExportRequest, ...grpc.CallOption) *account.PersonalDataOperation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.PersonalDataOperation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.RequestDataExportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestErasure provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) RequestErasure(ctx context.Context, in *account.RequestErasureRequest, opts ...grpc.CallOption) (*account.PersonalDataOperation, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.PersonalDataOperation
+	if rf, ok := ret.Get(0).(func(context.Context, *account.RequestErasureRequest, ...grpc.CallOption) *account.PersonalDataOperation); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.PersonalDataOperation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.RequestErasureRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestMagicLink provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) RequestMagicLink(ctx context.Context, in *account.RequestMagicLinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *account.RequestMagicLinkRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.RequestMagicLinkRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestSignInOTP provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) RequestSignInOTP(ctx context.Context, in *account.RequestSignInOTPRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *account.RequestSignInOTPRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.RequestSignInOTPRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeAllSessions provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) RevokeAllSessions(ctx context.Context, in *account.RevokeAllSessionsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *account.RevokeAllSessionsRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.RevokeAllSessionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeInvitation provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) RevokeInvitation(ctx context.Context, in *account.RevokeInvitationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *account.RevokeInvitationRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.RevokeInvitationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeSession provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) RevokeSession(ctx context.Context, in *account.RevokeSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *account.RevokeSessionRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.RevokeSessionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchAccounts provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) SearchAccounts(ctx context.Context, in *account.SearchAccountsRequest, opts ...grpc.CallOption) (*account.Accounts, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.Accounts
+	if rf, ok := ret.Get(0).(func(context.Context, *account.SearchAccountsRequest, ...grpc.CallOption) *account.Accounts); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.Accounts)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.SearchAccountsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SignIn provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) SignIn(ctx context.Context, in *account.SignInRequest, opts ...grpc.CallOption) (*account.SignInResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.SignInResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *account.SignInRequest, ...grpc.CallOption) *account.SignInResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.SignInResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.SignInRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SignInExternal provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) SignInExternal(ctx context.Context, in *account.SignInExternalRequest, opts ...grpc.CallOption) (*account.SignInResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.SignInResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *account.SignInExternalRequest, ...grpc.CallOption) *account.SignInResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.SignInResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.SignInExternalRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SignInMFA provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) SignInMFA(ctx context.Context, in *account.SignInMFARequest, opts ...grpc.CallOption) (*account.SignInResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.SignInResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *account.SignInMFARequest, ...grpc.CallOption) *account.SignInResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.SignInResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.SignInMFARequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SignInMagicLink provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) SignInMagicLink(ctx context.Context, in *account.SignInMagicLinkRequest, opts ...grpc.CallOption) (*account.SignInResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.SignInResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *account.SignInMagicLinkRequest, ...grpc.CallOption) *account.SignInResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.SignInResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.SignInMagicLinkRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SignInOTP provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) SignInOTP(ctx context.Context, in *account.SignInOTPRequest, opts ...grpc.CallOption) (*account.SignInResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *account.SignInResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *account.SignInOTPRequest, ...grpc.CallOption) *account.SignInResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*account.SignInResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.SignInOTPRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnlinkIdentity provides a mock function with given fields: ctx, in, opts
+func (_m *AccountAPIMock) UnlinkIdentity(ctx context.Context, in *account.UnlinkIdentityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *account.UnlinkIdentityRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *account.UnlinkIdentityRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -18,6 +18,36 @@ type SubscriberAPIClientMock struct {
 	mock.Mock
 }
 
+// DeleteSubscriber provides a mock function with given fields: ctx, in, opts
+func (_m *SubscriberAPIClientMock) DeleteSubscriber(ctx context.Context, in *subscriber.DeleteSubscriberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *emptypb.Empty
+	if rf, ok := ret.Get(0).(func(context.Context, *subscriber.DeleteSubscriberRequest, ...grpc.CallOption) *emptypb.Empty); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*emptypb.Empty)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *subscriber.DeleteSubscriberRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSubscriber provides a mock function with given fields: ctx, in, opts
 func (_m *SubscriberAPIClientMock) GetSubscriber(ctx context.Context, in *subscriber.GetSubscriberRequest, opts ...grpc.CallOption) (*subscriber.Subscriber, error) {
 	_va := make([]interface{}, len(opts))
@@ -0,0 +1,29 @@
+package mocks
+
+import (
+	"github.com/gidyon/services/pkg/api/settings"
+	"github.com/gidyon/services/pkg/mocks/mocks"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/mock"
+)
+
+// SettingsAPIClientMock is a mock for settings API client
+type SettingsAPIClientMock interface {
+	settings.SettingsAPIClient
+}
+
+// SettingsAPI is a fake settings API
+var SettingsAPI = &mocks.SettingsAPIClientMock{}
+
+func init() {
+	SettingsAPI.On("UpdateSetting", mock.Anything, mock.Anything, mock.Anything).
+		Return(&settings.UpdateSettingResponse{}, nil)
+	SettingsAPI.On("GetSettings", mock.Anything, mock.Anything, mock.Anything).
+		Return(&settings.GetSettingsResponse{
+			Settings: map[string]*settings.Setting{
+				"language": {Key: "language", Value: "en"},
+			},
+		}, nil)
+	SettingsAPI.On("EraseSettings", mock.Anything, mock.Anything, mock.Anything).
+		Return(&empty.Empty{}, nil)
+}
@@ -31,6 +31,8 @@ func init() {
 		}, nil)
 	SubscriberAPI.On("GetSubscriber", mock.Anything, mock.Anything, mock.Anything).
 		Return(&subscriber.Subscriber{}, nil)
+	SubscriberAPI.On("DeleteSubscriber", mock.Anything, mock.Anything, mock.Anything).
+		Return(&empty.Empty{}, nil)
 }
 
 func fakeSubscriber() *subscriber.Subscriber {